@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"text/tabwriter"
+	"time"
+
+	"github.com/morinokami/go-chip8/chip8"
+	"github.com/morinokami/go-chip8/games"
+	"github.com/urfave/cli/v2"
+)
+
+func benchCommand() *cli.Command {
+	var game string
+	var frames int
+	var histogram bool
+	return &cli.Command{
+		Name:  "bench",
+		Usage: "run the bundled games headlessly at unlimited speed and report their performance",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "game",
+				Aliases:     []string{"g"},
+				Usage:       "benchmark only this game: a name or one of the following numbers: " + games.AvailableGames(),
+				Destination: &game,
+			},
+			&cli.IntFlag{
+				Name:        "frames",
+				Aliases:     []string{"n"},
+				Value:       3000,
+				Usage:       "number of frames to run each game for",
+				Destination: &frames,
+			},
+			&cli.BoolFlag{
+				Name:        "histogram",
+				Usage:       "also print the most executed instructions",
+				Destination: &histogram,
+			},
+		},
+		Action: func(c *cli.Context) error {
+			list := games.Games
+			if game != "" {
+				g, err := games.Find(game)
+				if err != nil {
+					return err
+				}
+				list = []games.Game{g}
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+			fmt.Fprintln(w, "GAME\tINSTR/S\tFRAMES/S\tALLOCS/FRAME\tBYTES/FRAME\t")
+			var total chip8.Stats
+			var elapsed time.Duration
+			for _, g := range list {
+				s, d, allocs, bytes, err := bench(g, frames)
+				if err != nil {
+					return fmt.Errorf("%s: %v", g.Name, err)
+				}
+				total.Add(s)
+				elapsed += d
+				fmt.Fprintf(w, "%s\t%.0f\t%.0f\t%.2f\t%.0f\t\n", g.Name,
+					float64(s.Instructions)/d.Seconds(), float64(s.Frames)/d.Seconds(),
+					float64(allocs)/float64(s.Frames), float64(bytes)/float64(s.Frames))
+			}
+			fmt.Fprintf(w, "TOTAL\t%.0f\t%.0f\t\t\t\n",
+				float64(total.Instructions)/elapsed.Seconds(), float64(total.Frames)/elapsed.Seconds())
+			w.Flush()
+
+			if histogram {
+				fmt.Println()
+				fmt.Print(total.HistogramString(10))
+			}
+			return nil
+		},
+	}
+}
+
+// bench runs g for the given number of frames, pressing every key in turn,
+// and returns its counters, the time taken and the number and size of heap
+// allocations.
+func bench(g games.Game, frames int) (chip8.Stats, time.Duration, uint64, uint64, error) {
+	h := chip8.NewHeadless()
+	e := chip8.New(h, chip8.WithSeed(1))
+	if err := e.Load(g.Binary); err != nil {
+		return chip8.Stats{}, 0, 0, 0, err
+	}
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	for i := 0; i < frames; i++ {
+		var keys chip8.Keypad
+		if i/5%2 == 0 {
+			keys = 1 << (i / 10 % 16)
+		}
+		h.SetKeypad(keys)
+		if err := e.Frame(); err != nil {
+			return chip8.Stats{}, 0, 0, 0, err
+		}
+	}
+	d := time.Since(start)
+	runtime.ReadMemStats(&after)
+	return e.Stats(), d, after.Mallocs - before.Mallocs, after.TotalAlloc - before.TotalAlloc, nil
+}
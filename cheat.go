@@ -0,0 +1,289 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/morinokami/go-chip8/cheat"
+	"github.com/morinokami/go-chip8/chip8"
+	"github.com/morinokami/go-chip8/games"
+	"github.com/urfave/cli/v2"
+)
+
+func cheatCommand() *cli.Command {
+	var game string
+	var file string
+	// settings of the game, set by edit
+	var settings chip8.Settings
+	flags := []cli.Flag{
+		&cli.StringFlag{
+			Name:        "game",
+			Aliases:     []string{"g"},
+			Value:       "0",
+			Usage:       "enter a ROM file, an Octo .8o or .gif file, a name or one of the following numbers: " + games.AvailableGames(),
+			Destination: &game,
+		},
+		&cli.StringFlag{
+			Name:        "file",
+			Usage:       "cheats file (default: go-chip8/cheats.json in the user's configuration directory)",
+			Destination: &file,
+		},
+	}
+	// edit loads the cheats file, passes the cheats of the game to f and
+	// saves them if f returns no error
+	edit := func(f func(g games.Game, cheats []cheat.Cheat) ([]cheat.Cheat, error)) error {
+		g, s, _, err := openGame(game, "")
+		if err != nil {
+			return err
+		}
+		settings = s
+		if file == "" {
+			if file, err = cheat.DefaultPath(); err != nil {
+				return err
+			}
+		}
+		cf, err := cheat.Load(file)
+		if err != nil {
+			return err
+		}
+		hash := chip8.ROMHash(g.Binary)
+		cheats, err := f(g, cf[hash])
+		if err != nil || cheats == nil {
+			return err
+		}
+		cf[hash] = cheats
+		return cf.Save(file)
+	}
+	// find returns the index of the cheat name
+	find := func(cheats []cheat.Cheat, name string) (int, error) {
+		for i, c := range cheats {
+			if c.Name == name {
+				return i, nil
+			}
+		}
+		return 0, fmt.Errorf("no cheat named %q", name)
+	}
+	setEnabled := func(enabled bool) func(c *cli.Context) error {
+		return func(c *cli.Context) error {
+			return edit(func(g games.Game, cheats []cheat.Cheat) ([]cheat.Cheat, error) {
+				i, err := find(cheats, c.Args().First())
+				if err != nil {
+					return nil, err
+				}
+				cheats[i].Enabled = enabled
+				return cheats, nil
+			})
+		}
+	}
+
+	return &cli.Command{
+		Name:  "cheat",
+		Usage: "search memory and manage the cheats applied by go-chip8 run --cheats",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "search",
+				Usage: "find variables in memory with an interactive search",
+				Flags: flags,
+				Action: func(c *cli.Context) error {
+					return edit(func(g games.Game, cheats []cheat.Cheat) ([]cheat.Cheat, error) {
+						return searchShell(g, settings, cheats, os.Stdin, os.Stdout)
+					})
+				},
+			},
+			{
+				Name:  "list",
+				Usage: "list the cheats of a game",
+				Flags: flags,
+				Action: func(c *cli.Context) error {
+					return edit(func(g games.Game, cheats []cheat.Cheat) ([]cheat.Cheat, error) {
+						for _, ch := range cheats {
+							fmt.Println(ch)
+						}
+						return nil, nil
+					})
+				},
+			},
+			{
+				Name:      "add",
+				Usage:     "add an enabled cheat that writes VALUE, hexadecimal bytes, at ADDR every frame",
+				ArgsUsage: "NAME ADDR=VALUE",
+				Flags:     flags,
+				Action: func(c *cli.Context) error {
+					if c.NArg() != 2 {
+						return errors.New("usage: go-chip8 cheat add NAME ADDR=VALUE")
+					}
+					ch, err := cheat.Parse(c.Args().Get(0), c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+					return edit(func(g games.Game, cheats []cheat.Cheat) ([]cheat.Cheat, error) {
+						if _, err := find(cheats, ch.Name); err == nil {
+							return nil, fmt.Errorf("a cheat named %q exists", ch.Name)
+						}
+						return append(cheats, ch), nil
+					})
+				},
+			},
+			{
+				Name:      "remove",
+				Usage:     "remove a cheat",
+				ArgsUsage: "NAME",
+				Flags:     flags,
+				Action: func(c *cli.Context) error {
+					return edit(func(g games.Game, cheats []cheat.Cheat) ([]cheat.Cheat, error) {
+						i, err := find(cheats, c.Args().First())
+						if err != nil {
+							return nil, err
+						}
+						return append(cheats[:i], cheats[i+1:]...), nil
+					})
+				},
+			},
+			{
+				Name:      "enable",
+				Usage:     "enable a cheat",
+				ArgsUsage: "NAME",
+				Flags:     flags,
+				Action:    setEnabled(true),
+			},
+			{
+				Name:      "disable",
+				Usage:     "disable a cheat",
+				ArgsUsage: "NAME",
+				Flags:     flags,
+				Action:    setEnabled(false),
+			},
+		},
+	}
+}
+
+const searchHelp = `run N          run N frames
+hold KEYS      hold keys, hexadecimal digits such as 14, or - for none
+eq V, ne V     keep the addresses holding V, or not
+changed, unchanged, inc, dec
+               keep the addresses whose value did so since the last filter
+list           show the addresses left and their values
+new            start over with all addresses
+save NAME ADDR=VALUE
+               add a cheat, enabled
+quit           save the cheats and quit
+`
+
+// searchShell runs the commands read from in to search the memory of g run
+// with settings, and returns cheats with the ones saved.
+func searchShell(g games.Game, settings chip8.Settings, cheats []cheat.Cheat, in io.Reader, out io.Writer) ([]cheat.Cheat, error) {
+	ui := chip8.NewHeadless()
+	e := chip8.New(ui, chip8.WithSettings(settings))
+	if err := e.Load(g.Binary); err != nil {
+		return nil, err
+	}
+	s := cheat.NewSearch(e.State().Memory)
+	fmt.Fprintf(out, "Searching the memory of %s; type help for the commands.\n", g.Name)
+
+	sc := bufio.NewScanner(in)
+	for {
+		fmt.Fprintf(out, "[frame %d, %d addresses] > ", e.Frames(), len(s.Candidates()))
+		if !sc.Scan() {
+			fmt.Fprintln(out)
+			return cheats, sc.Err()
+		}
+		args := strings.Fields(sc.Text())
+		if len(args) == 0 {
+			continue
+		}
+		err := func() error {
+			value := func() (byte, error) {
+				if len(args) != 2 {
+					return 0, errors.New("usage: " + args[0] + " V")
+				}
+				v, err := strconv.ParseUint(args[1], 0, 8)
+				return byte(v), err
+			}
+			switch args[0] {
+			case "help":
+				fmt.Fprint(out, searchHelp)
+			case "run":
+				n := 1
+				if len(args) > 1 {
+					var err error
+					if n, err = strconv.Atoi(args[1]); err != nil {
+						return err
+					}
+				}
+				for i := 0; i < n; i++ {
+					if err := e.Frame(); err != nil {
+						return err
+					}
+				}
+			case "hold":
+				var keys chip8.Keypad
+				if len(args) > 1 && args[1] != "-" {
+					for _, c := range args[1] {
+						k, err := strconv.ParseUint(string(c), 16, 8)
+						if err != nil {
+							return fmt.Errorf("invalid key: %q", c)
+						}
+						keys |= 1 << k
+					}
+				}
+				ui.SetKeypad(keys)
+			case "eq", "ne":
+				v, err := value()
+				if err != nil {
+					return err
+				}
+				if args[0] == "eq" {
+					s.Equal(e.State().Memory, v)
+				} else {
+					s.NotEqual(e.State().Memory, v)
+				}
+			case "changed":
+				s.Changed(e.State().Memory)
+			case "unchanged":
+				s.Unchanged(e.State().Memory)
+			case "inc":
+				s.Increased(e.State().Memory)
+			case "dec":
+				s.Decreased(e.State().Memory)
+			case "list":
+				addrs := s.Candidates()
+				mem := e.State().Memory
+				for i, addr := range addrs {
+					if i == 64 {
+						fmt.Fprintf(out, "... and %d more\n", len(addrs)-i)
+						break
+					}
+					fmt.Fprintf(out, "0x%03x: %d (was %d)\n", addr, mem[addr], s.Value(addr))
+				}
+			case "new":
+				s = cheat.NewSearch(e.State().Memory)
+			case "save":
+				if len(args) != 3 {
+					return errors.New("usage: save NAME ADDR=VALUE")
+				}
+				ch, err := cheat.Parse(args[1], args[2])
+				if err != nil {
+					return err
+				}
+				cheats = append(cheats, ch)
+				fmt.Fprintln(out, "saved", ch)
+			case "quit", "exit":
+				return io.EOF
+			default:
+				return fmt.Errorf("unknown command: %s", args[0])
+			}
+			return nil
+		}()
+		if err == io.EOF {
+			return cheats, nil
+		}
+		if err != nil {
+			fmt.Fprintln(out, "error:", err)
+		}
+	}
+}
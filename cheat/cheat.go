@@ -0,0 +1,172 @@
+package cheat
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/morinokami/go-chip8/chip8"
+)
+
+// Cheat writes Value to memory at Addr at the end of every frame while it
+// is enabled. A one-byte value freezes a variable such as the number of
+// lives; a longer one may patch code.
+type Cheat struct {
+	Name    string `json:"name"`
+	Addr    uint16 `json:"addr"`
+	Value   Bytes  `json:"value"`
+	Enabled bool   `json:"enabled"`
+}
+
+// Bytes are encoded in JSON as hexadecimal strings, such as "6003".
+type Bytes []byte
+
+func (b Bytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hex.EncodeToString(b))
+}
+
+func (b *Bytes) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, err := hex.DecodeString(s)
+	if err != nil {
+		return fmt.Errorf("invalid cheat value: %q", s)
+	}
+	*b = v
+	return nil
+}
+
+// Parse parses a cheat written as ADDR=VALUE, such as 0x2F3=09, where ADDR
+// is a number and VALUE hexadecimal bytes. The cheat is enabled.
+func Parse(name, s string) (Cheat, error) {
+	i := strings.Index(s, "=")
+	if i < 0 {
+		return Cheat{}, fmt.Errorf("invalid cheat, want ADDR=VALUE: %q", s)
+	}
+	addr, err := strconv.ParseUint(s[:i], 0, 16)
+	if err != nil || addr >= chip8.MemorySize {
+		return Cheat{}, fmt.Errorf("invalid address: %q", s[:i])
+	}
+	v, err := hex.DecodeString(s[i+1:])
+	if err != nil || len(v) == 0 || int(addr)+len(v) > chip8.MemorySize {
+		return Cheat{}, fmt.Errorf("invalid value: %q", s[i+1:])
+	}
+	return Cheat{Name: name, Addr: uint16(addr), Value: v, Enabled: true}, nil
+}
+
+func (c Cheat) String() string {
+	state := "off"
+	if c.Enabled {
+		state = "on"
+	}
+	return fmt.Sprintf("%s 0x%03x=%s (%s)", c.Name, c.Addr, hex.EncodeToString(c.Value), state)
+}
+
+// File holds the cheats of every ROM, by the hash returned by
+// chip8.ROMHash.
+type File map[string][]Cheat
+
+// DefaultPath returns the path of the cheats file in the user's
+// configuration directory.
+func DefaultPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "go-chip8", "cheats.json"), nil
+}
+
+// Load reads the cheats file at path; a missing file holds no cheats.
+func Load(path string) (File, error) {
+	data, err := ioutil.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return File{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	f := File{}
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return f, nil
+}
+
+// Save writes f to path, creating its directory if needed.
+func (f File) Save(path string) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// Set applies cheats to a running game. Its methods may be called from any
+// goroutine.
+type Set struct {
+	mu     sync.Mutex
+	cheats []Cheat
+	off    bool
+	// frames left showing whether cheats are on
+	notice int
+}
+
+// NewSet returns a set applying the enabled cheats among cheats.
+func NewSet(cheats []Cheat) *Set {
+	return &Set{cheats: append([]Cheat(nil), cheats...)}
+}
+
+// Toggle turns all cheats off, or back on, and reports whether they are on.
+// Values already written stay until the game changes them, or until it is
+// reset for patched code.
+func (s *Set) Toggle() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.off = !s.off
+	s.notice = 60
+	return !s.off
+}
+
+// Hooks returns the hooks that apply the cheats, for chip8.WithHooks.
+func (s *Set) Hooks() *chip8.Hooks {
+	return &chip8.Hooks{Frame: s.apply}
+}
+
+func (s *Set) apply(c *chip8.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.notice > 0 {
+		s.notice--
+		if s.off {
+			c.Print(1, 1, "CHEATS OFF")
+		} else {
+			c.Print(1, 1, "CHEATS ON")
+		}
+	}
+	if s.off {
+		return
+	}
+	for _, ch := range s.cheats {
+		if !ch.Enabled {
+			continue
+		}
+		for i, b := range ch.Value {
+			// writes drop the cached instructions at addr
+			if addr := ch.Addr + uint16(i); c.Read(addr) != b {
+				c.Write(addr, b)
+			}
+		}
+	}
+}
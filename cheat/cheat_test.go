@@ -0,0 +1,102 @@
+package cheat
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/morinokami/go-chip8/chip8"
+	"github.com/morinokami/go-chip8/games"
+)
+
+func TestSearch(t *testing.T) {
+	// lives at 0x300 go 3, 3, 2, 2, 1; a timer at 0x301 goes down every
+	// frame
+	var mem Memory
+	snapshot := func(lives, timer byte) Memory {
+		mem[0x300], mem[0x301] = lives, timer
+		mem[0x302]++
+		return mem
+	}
+	s := NewSearch(snapshot(3, 60))
+	s.Equal(snapshot(3, 59), 3)
+	s.Decreased(snapshot(2, 58))
+	s.Unchanged(snapshot(2, 57))
+	if got, want := s.Candidates(), []uint16{0x300}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %x, want %x", got, want)
+	}
+	s = NewSearch(snapshot(2, 56))
+	s.Changed(snapshot(1, 55))
+	s.NotEqual(snapshot(1, 54), 1)
+	if got, want := s.Candidates(), []uint16{0x301, 0x302}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %x, want %x", got, want)
+	}
+	if s.Value(0x301) != 54 {
+		t.Errorf("got %d, want 54", s.Value(0x301))
+	}
+	s.Increased(snapshot(1, 53))
+	if got, want := s.Candidates(), []uint16{0x302}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %x, want %x", got, want)
+	}
+}
+
+func TestSet(t *testing.T) {
+	g, _ := games.Find("BRIX")
+	// infinite lives, by turning ADD VE, -1 into ADD VE, 0
+	lives, err := Parse("lives", "0x2d0=7e00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := NewSet([]Cheat{lives, {Name: "disabled", Addr: 0x300, Value: Bytes{1}}})
+	e := chip8.New(chip8.NewHeadless(), chip8.WithSeed(1), chip8.WithHooks(set.Hooks()))
+	e.Load(g.Binary)
+	for i := 0; i < 3000; i++ {
+		if err := e.Frame(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the game is over after 1752 frames without the cheat
+	if s := e.State(); s.PC == 0x2DE || s.V[0xE] != 5 || s.Memory[0x300] == 1 {
+		t.Errorf("got PC=0x%03x, VE=%d, [0x300]=%d", s.PC, s.V[0xE], s.Memory[0x300])
+	}
+
+	if set.Toggle() {
+		t.Error("cheats on after toggling them off")
+	}
+	// patched code stays until the ROM is reloaded
+	e.Reset()
+	for i := 0; i < 3000 && e.State().PC != 0x2DE; i++ {
+		e.Frame()
+	}
+	if e.State().PC != 0x2DE {
+		t.Error("the game never ended with cheats off")
+	}
+}
+
+func TestFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dir", "cheats.json")
+	f, err := Load(path)
+	if err != nil || len(f) != 0 {
+		t.Fatalf("got %v, %v for a missing file", f, err)
+	}
+	c, _ := Parse("score", "788=0909")
+	f["hash"] = []Cheat{c}
+	if err := f.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, f) {
+		t.Errorf("got %v, want %v", got, f)
+	}
+}
+
+func TestParse(t *testing.T) {
+	for _, s := range []string{"0x2d0", "0x1000=00", "0x2d0=7", "0x2d0=", "x=00", "0xfff=0000"} {
+		if _, err := Parse("", s); err == nil {
+			t.Errorf("no error for %q", s)
+		}
+	}
+}
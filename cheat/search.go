@@ -0,0 +1,82 @@
+// Package cheat finds the variables of a game in memory, such as its number
+// of lives, and keeps them at the values of cheats while it runs.
+package cheat
+
+import (
+	"sort"
+
+	"github.com/morinokami/go-chip8/chip8"
+)
+
+// Memory is a snapshot of memory, as in chip8.State.
+type Memory = [chip8.MemorySize]byte
+
+// Search narrows down the addresses of a variable by comparing snapshots
+// of memory taken as the game runs: each filter keeps the candidates whose
+// values pass it, between the previous snapshot and the new one.
+type Search struct {
+	prev       Memory
+	candidates []uint16
+}
+
+// NewSearch starts a search with all addresses as candidates, and mem as
+// the first snapshot.
+func NewSearch(mem Memory) *Search {
+	s := &Search{prev: mem}
+	for addr := range mem {
+		s.candidates = append(s.candidates, uint16(addr))
+	}
+	return s
+}
+
+// Filter keeps the candidates for which keep returns true, given their
+// values in the previous snapshot and in mem, which becomes the previous
+// snapshot.
+func (s *Search) Filter(mem Memory, keep func(old, new byte) bool) {
+	kept := s.candidates[:0]
+	for _, addr := range s.candidates {
+		if keep(s.prev[addr], mem[addr]) {
+			kept = append(kept, addr)
+		}
+	}
+	s.candidates = kept
+	s.prev = mem
+}
+
+// Equal keeps the candidates holding v.
+func (s *Search) Equal(mem Memory, v byte) {
+	s.Filter(mem, func(old, new byte) bool { return new == v })
+}
+
+// NotEqual keeps the candidates not holding v.
+func (s *Search) NotEqual(mem Memory, v byte) {
+	s.Filter(mem, func(old, new byte) bool { return new != v })
+}
+
+func (s *Search) Changed(mem Memory) {
+	s.Filter(mem, func(old, new byte) bool { return new != old })
+}
+
+func (s *Search) Unchanged(mem Memory) {
+	s.Filter(mem, func(old, new byte) bool { return new == old })
+}
+
+func (s *Search) Increased(mem Memory) {
+	s.Filter(mem, func(old, new byte) bool { return new > old })
+}
+
+func (s *Search) Decreased(mem Memory) {
+	s.Filter(mem, func(old, new byte) bool { return new < old })
+}
+
+// Candidates returns the remaining addresses in increasing order.
+func (s *Search) Candidates() []uint16 {
+	addrs := append([]uint16(nil), s.candidates...)
+	sort.Slice(addrs, func(i, j int) bool { return addrs[i] < addrs[j] })
+	return addrs
+}
+
+// Value returns the value of addr in the last snapshot.
+func (s *Search) Value(addr uint16) byte {
+	return s.prev[addr%chip8.MemorySize]
+}
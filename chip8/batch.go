@@ -0,0 +1,170 @@
+package chip8
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// BatchEmulator runs many independent emulators in lockstep, one frame at a
+// time, spread over a pool of workers. It is meant for training agents and
+// fuzzing, where thousands of machines run as fast as possible.
+//
+// The emulators are stored in one contiguous slice, and each worker steps a
+// contiguous range of them. They share nothing, so a batch gives the same
+// results whatever the number of workers.
+type BatchEmulator struct {
+	emulators []Emulator
+	uis       []Headless
+	errs      []error
+	// frame buffers of all emulators, one after the other
+	screens []byte
+	workers int
+}
+
+// NewBatch creates n emulators with headless UIs, each configured with opts.
+// Emulator i is seeded with the seed given by opts, or the current time,
+// plus i, so that they do not all play the same game.
+func NewBatch(n int, opts ...Option) *BatchEmulator {
+	b := &BatchEmulator{
+		emulators: make([]Emulator, n),
+		uis:       make([]Headless, n),
+		errs:      make([]error, n),
+		screens:   make([]byte, n*BufferSize),
+		workers:   runtime.GOMAXPROCS(0),
+	}
+	for i := range b.emulators {
+		i := int64(i)
+		offset := func(e *Emulator) {
+			e.seed += i
+		}
+		b.emulators[i].init(&b.uis[i], append(opts[:len(opts):len(opts)], offset))
+	}
+	return b
+}
+
+// SetWorkers sets the number of goroutines Step uses. The default is
+// GOMAXPROCS.
+func (b *BatchEmulator) SetWorkers(n int) {
+	if n < 1 {
+		n = 1
+	}
+	b.workers = n
+}
+
+// Len returns the number of emulators.
+func (b *BatchEmulator) Len() int {
+	return len(b.emulators)
+}
+
+// Emulator returns emulator i, for example to load a different ROM into it
+// or to read its state. It must not be used while Step is running.
+func (b *BatchEmulator) Emulator(i int) *Emulator {
+	return &b.emulators[i]
+}
+
+// Load loads rom into every emulator and resets them.
+func (b *BatchEmulator) Load(rom []byte) error {
+	for i := range b.emulators {
+		if err := b.emulators[i].Load(rom); err != nil {
+			return err
+		}
+		b.errs[i] = nil
+	}
+	b.copyScreens(0, len(b.emulators))
+	return nil
+}
+
+// Reset resets emulator i, which makes it run again if it failed.
+func (b *BatchEmulator) Reset(i int) {
+	b.emulators[i].Reset()
+	b.errs[i] = nil
+	b.copyScreens(i, i+1)
+}
+
+// Step runs one frame of every emulator, with the keypad state of emulator
+// i set to keys[i]. If keys is nil, no keys are pressed.
+//
+// An emulator whose CPU fails stops until it is reset, and Step returns the
+// error of the first emulator that failed in this frame. Screens are not
+// presented, so recorders are not used.
+func (b *BatchEmulator) Step(keys []Keypad) error {
+	if keys != nil && len(keys) != len(b.emulators) {
+		return fmt.Errorf("got %d keypad states for %d emulators", len(keys), len(b.emulators))
+	}
+
+	n := len(b.emulators)
+	workers := b.workers
+	if workers > n {
+		workers = n
+	}
+	// index of the first emulator that failed, per worker
+	failed := make([]int, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		start, end := w*n/workers, (w+1)*n/workers
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			failed[w] = b.step(start, end, keys)
+		}(w)
+	}
+	wg.Wait()
+
+	for _, i := range failed {
+		if i >= 0 {
+			return fmt.Errorf("emulator %d: %w", i, b.errs[i])
+		}
+	}
+	return nil
+}
+
+// step runs one frame of emulators start to end, and returns the index of
+// the first one that failed, or -1.
+func (b *BatchEmulator) step(start, end int, keys []Keypad) int {
+	failed := -1
+	for i := start; i < end; i++ {
+		if b.errs[i] != nil {
+			continue
+		}
+		var k Keypad
+		if keys != nil {
+			k = keys[i]
+		}
+		b.uis[i].SetKeypad(k)
+		e := &b.emulators[i]
+		e.mu.Lock()
+		err := e.frame()
+		copy(b.screen(i), e.frameBuffer[:])
+		e.mu.Unlock()
+		if err != nil && failed < 0 {
+			failed = i
+		}
+		b.errs[i] = err
+	}
+	return failed
+}
+
+// Err returns the error that stopped emulator i, if any.
+func (b *BatchEmulator) Err(i int) error {
+	return b.errs[i]
+}
+
+// Screens returns the frame buffers of all emulators after the last frame,
+// one after the other: pixel p of emulator i is at i*BufferSize+p. Step
+// updates the slice in place.
+func (b *BatchEmulator) Screens() []byte {
+	return b.screens
+}
+
+func (b *BatchEmulator) screen(i int) []byte {
+	return b.screens[i*BufferSize : (i+1)*BufferSize]
+}
+
+// copyScreens copies the frame buffers of emulators start to end.
+func (b *BatchEmulator) copyScreens(start, end int) {
+	for i := start; i < end; i++ {
+		screen := b.emulators[i].Screen()
+		copy(b.screen(i), screen[:])
+	}
+}
@@ -0,0 +1,119 @@
+package chip8
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/morinokami/go-chip8/games"
+)
+
+func TestBatch(t *testing.T) {
+	const n = 8
+	var rom []byte
+	for _, g := range games.Games {
+		if g.Name == "BRIX" {
+			rom = g.Binary
+		}
+	}
+	run := func(workers int) *BatchEmulator {
+		b := NewBatch(n, WithSeed(1))
+		b.SetWorkers(workers)
+		if err := b.Load(rom); err != nil {
+			t.Fatal(err)
+		}
+		keys := make([]Keypad, n)
+		for f := 0; f < gameFrames; f++ {
+			for i := range keys {
+				keys[i] = gameInput(f + i)
+			}
+			if err := b.Step(keys); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return b
+	}
+
+	b := run(3)
+	for i := 0; i < n; i++ {
+		// the same game run on its own
+		h := NewHeadless()
+		e := New(h, WithSeed(int64(1+i)))
+		e.Load(rom)
+		for f := 0; f < gameFrames; f++ {
+			h.SetKeypad(gameInput(f + i))
+			if err := e.Frame(); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if got, want := b.Emulator(i).Hash(), e.Hash(); got != want {
+			t.Errorf("emulator %d: hash got=%s, want=%s", i, got, want)
+		}
+		screen := e.Screen()
+		if !bytes.Equal(b.Screens()[i*BufferSize:(i+1)*BufferSize], screen[:]) {
+			t.Errorf("emulator %d: screens differ", i)
+		}
+	}
+	if !bytes.Equal(run(1).Screens(), b.Screens()) {
+		t.Error("screens depend on the number of workers")
+	}
+}
+
+func TestBatchError(t *testing.T) {
+	b := NewBatch(3)
+	b.Load([]byte{0x12, 0x00}) // JP 0x200
+	b.Emulator(1).Load([]byte{0x00, 0xEE})
+
+	err := b.Step(nil)
+	if err == nil || b.Err(1) == nil || b.Err(0) != nil || b.Err(2) != nil {
+		t.Fatalf("got err=%v, errs=%v", err, b.errs)
+	}
+	if err := b.Step(nil); err != nil {
+		t.Errorf("failed emulator ran again: %v", err)
+	}
+	if f := b.Emulator(0).Frames(); f != 2 {
+		t.Errorf("frames: got=%d, want=%d", f, 2)
+	}
+
+	b.Reset(1)
+	if b.Err(1) != nil {
+		t.Errorf("error not cleared by Reset: %v", b.Err(1))
+	}
+}
+
+// BenchmarkBatch runs the bundled games in a batch of 1024 emulators with
+// increasing numbers of workers, up to GOMAXPROCS, and reports frames per
+// second over the batch. The scaling it shows is only meaningful with at
+// least as many physical cores as workers.
+func BenchmarkBatch(b *testing.B) {
+	const n = 1024
+	for workers := 1; workers <= runtime.GOMAXPROCS(0); workers *= 2 {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			batch := NewBatch(n, WithSeed(1))
+			batch.SetWorkers(workers)
+			for i := 0; i < n; i++ {
+				batch.Emulator(i).Load(games.Games[i%len(games.Games)].Binary)
+			}
+			keys := make([]Keypad, n)
+
+			b.ResetTimer()
+			start := time.Now()
+			for f := 0; f < b.N; f++ {
+				for i := range keys {
+					keys[i] = gameInput(f + i)
+				}
+				if err := batch.Step(keys); err != nil {
+					// keep the benchmark going with the failed game restarted
+					for i := 0; i < n; i++ {
+						if batch.Err(i) != nil {
+							batch.Reset(i)
+						}
+					}
+				}
+			}
+			b.ReportMetric(float64(b.N*n)/time.Since(start).Seconds(), "frames/s")
+		})
+	}
+}
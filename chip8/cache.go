@@ -0,0 +1,55 @@
+package chip8
+
+// op is a decoded instruction with its operands extracted.
+type op struct {
+	inst   Instruction
+	opcode uint16
+	x      uint16
+	y      uint16
+	nnn    uint16
+	kk     uint16
+	n      uint16
+}
+
+func (e *Emulator) decodeOp(opcode uint16) op {
+	return op{
+		inst:   e.decode(opcode),
+		opcode: opcode,
+		x:      (opcode & 0x0F00) >> 8,
+		y:      (opcode & 0x00F0) >> 4,
+		nnn:    opcode & 0x0FFF,
+		kk:     opcode & 0x00FF,
+		n:      opcode & 0x000F,
+	}
+}
+
+// fetch returns the instruction at pc, decoding it only the first time it is
+// executed. Decoded instructions are cached by address until the memory they
+// were decoded from is written to.
+func (e *Emulator) fetch() *op {
+	return e.opAt(e.pc)
+}
+
+func (e *Emulator) opAt(addr uint16) *op {
+	if !e.decoded[addr] {
+		opcode := uint16(e.memory[addr])<<8 | uint16(e.memory[(addr+1)%MemorySize])
+		e.code[addr] = e.decodeOp(opcode)
+		e.decoded[addr] = true
+	}
+	return &e.code[addr]
+}
+
+// store writes b to memory at addr, invalidating the cached and compiled
+// instructions that include it.
+func (e *Emulator) store(addr uint16, b byte) {
+	addr %= MemorySize
+	e.memory[addr] = b
+	e.decoded[addr] = false
+	e.decoded[(addr+MemorySize-1)%MemorySize] = false
+	e.invalidateBlocks(addr)
+}
+
+// invalidate drops all cached instructions.
+func (e *Emulator) invalidate() {
+	e.decoded = [MemorySize]bool{}
+}
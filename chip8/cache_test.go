@@ -0,0 +1,92 @@
+package chip8
+
+import (
+	"testing"
+	"time"
+
+	"github.com/morinokami/go-chip8/games"
+)
+
+// benchmarkCycles runs the bundled games one after another through step,
+// which executes one instruction, and reports instructions per second.
+func benchmarkCycles(b *testing.B, step func(e *Emulator) error) {
+	var emulators []*Emulator
+	for _, g := range games.Games {
+		e := New(NewHeadless(), WithSeed(1))
+		e.Load(g.Binary)
+		emulators = append(emulators, e)
+	}
+
+	b.ResetTimer()
+	start := time.Now()
+	for i, n := 0, 0; n < b.N; i++ {
+		e := emulators[i%len(emulators)]
+		for j := 0; j < CyclesPerFrame && n < b.N; j, n = j+1, n+1 {
+			if err := step(e); err != nil {
+				e.Reset()
+			}
+		}
+	}
+	b.ReportMetric(float64(b.N)/time.Since(start).Seconds(), "instr/s")
+}
+
+func TestCache(t *testing.T) {
+	e := New(NewHeadless())
+	// LD V1, 0x01; JP 0x200
+	e.Load([]byte{0x61, 0x01, 0x12, 0x00})
+	run := func() {
+		e.pc = PCStart
+		if err := e.cycle(0); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	run()
+	if e.vReg[1] != 0x01 {
+		t.Fatalf("got=0x%02x, want=0x%02x", e.vReg[1], 0x01)
+	}
+
+	t.Run("LD [I], Vx", func(t *testing.T) {
+		// overwrite the low byte only, which belongs to the instruction
+		// starting at the previous address
+		e.iReg = PCStart + 1
+		e.vReg[0] = 0x02
+		e.execute(0xF055, 0)
+		run()
+		if e.vReg[1] != 0x02 {
+			t.Errorf("got=0x%02x, want=0x%02x", e.vReg[1], 0x02)
+		}
+	})
+
+	t.Run("LD B, Vx", func(t *testing.T) {
+		e.iReg = PCStart + 1
+		e.vReg[2] = 123
+		e.execute(0xF233, 0)
+		run()
+		if e.vReg[1] != 0x01 {
+			t.Errorf("got=0x%02x, want=0x%02x", e.vReg[1], 0x01)
+		}
+	})
+
+	t.Run("Load", func(t *testing.T) {
+		e.Load([]byte{0x61, 0x04})
+		run()
+		if e.vReg[1] != 0x04 {
+			t.Errorf("got=0x%02x, want=0x%02x", e.vReg[1], 0x04)
+		}
+	})
+}
+
+func BenchmarkCycle(b *testing.B) {
+	b.Run("cached", func(b *testing.B) {
+		benchmarkCycles(b, func(e *Emulator) error {
+			return e.cycle(0)
+		})
+	})
+	// decoding every instruction, as before the cache
+	b.Run("uncached", func(b *testing.B) {
+		benchmarkCycles(b, func(e *Emulator) error {
+			return e.execute(uint16(e.memory[e.pc])<<8|uint16(e.memory[(e.pc+1)%MemorySize]), 0)
+		})
+	})
+}
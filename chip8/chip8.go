@@ -1,13 +1,49 @@
 package chip8
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"math/rand"
+	"sync"
 	"time"
 )
 
+// UI is a front end that presents the screen and provides keypad input.
+type UI interface {
+	Init()
+	Run(f func())
+	Render(buf *[BufferSize]byte, dirty bool)
+	Keypad() Keypad
+	Beep()
+	Mode() DisplayMode
+	Close()
+}
+
+// Hotkey is an emulator control triggered from a UI.
+type Hotkey int
+
+const (
+	HotkeyPause Hotkey = iota
+	HotkeyReset
+	HotkeyQuit
+	HotkeyCheats
+)
+
+// Keypad is the state of the 16-key hexadecimal keypad, one bit per key.
+type Keypad uint16
+
+func (k Keypad) Pressed(key byte) bool {
+	return key < 16 && k&(1<<key) != 0
+}
+
 type Emulator struct {
-	display     *Display
+	// mu guards the machine state against the control methods, which may be
+	// called from other goroutines while Run is running
+	mu     sync.Mutex
+	paused bool
+
+	ui          UI
 	frameBuffer [BufferSize]byte
 	memory      [MemorySize]byte
 	vReg        [VRegisterSize]byte
@@ -16,13 +52,160 @@ type Emulator struct {
 	soundTimer  byte
 	pc          uint16
 	stack       []uint16
+
+	// instructions decoded so far, by address
+	code    [MemorySize]op
+	decoded [MemorySize]bool
+	// compiled blocks of the loaded ROM, by address
+	program *Program
+	blocks  *[MemorySize]*Block
+	ctx     Context
+
+	// frame buffer as of the last DRW that erased no pixels (ModeStable)
+	stableBuffer [BufferSize]byte
+	// whether the frame buffer changed since it was last rendered
+	dirty    bool
+	trace    io.Writer
+	recorder Recorder
+	// breakpoints by address, and whether execution stopped at the one at
+	// the program counter
+	breakpoints map[uint16]bool
+	stopped     bool
+
+	rom    []byte
+	seed   int64
+	rng    *rand.Rand
+	src    *source
+	frames uint64
+	// instructions run in the current frame, and its keypad state
+	inFrame int
+	keys    Keypad
+	stats   Stats
+	movie   *Movie
+	replay  *Movie
+	hook    FrameHook
+	hooks   []*Hooks
+	// instructions per frame and quirks
+	settings Settings
+	// whether any hooks are set at addresses
+	hookAt bool
+	// text printed by hooks in the current frame, and whether any was shown
+	// with the last frame presented
+	overlay  []label
+	overlaid bool
+}
+
+type Option func(*Emulator)
+
+// WithTrace makes the emulator write each executed instruction to w.
+func WithTrace(w io.Writer) Option {
+	return func(e *Emulator) {
+		e.trace = w
+	}
 }
 
-func New(d *Display) *Emulator {
-	return &Emulator{display: d, pc: PCStart}
+// WithRecorder makes the emulator pass each presented frame to r.
+func WithRecorder(r Recorder) Option {
+	return func(e *Emulator) {
+		e.recorder = r
+	}
 }
 
-func (e *Emulator) Load(rom []byte) {
+// WithSeed seeds the random number generator used by RND. By default it is
+// seeded with the current time.
+func WithSeed(seed int64) Option {
+	return func(e *Emulator) {
+		e.seed = seed
+	}
+}
+
+// WithInputRecording makes the emulator record the keypad state of every
+// frame, and its settings, into m.
+func WithInputRecording(m *Movie) Option {
+	return func(e *Emulator) {
+		e.movie = m
+	}
+}
+
+// WithReplay makes the emulator take the keypad state from m instead of the
+// UI until all of its frames have been played, and run with the settings
+// of m.
+func WithReplay(m *Movie) Option {
+	return func(e *Emulator) {
+		e.replay = m
+	}
+}
+
+// FrameHook is called at the end of every frame with the number of frames
+// run since the ROM was loaded and the keypad state of the frame. state
+// returns the machine state; it may only be called during the hook, which
+// must not call the methods of the emulator.
+type FrameHook func(frame uint64, keys Keypad, state func() State)
+
+// WithFrameHook makes the emulator call h at the end of every frame.
+func WithFrameHook(h FrameHook) Option {
+	return func(e *Emulator) {
+		e.hook = h
+	}
+}
+
+func New(ui UI, opts ...Option) *Emulator {
+	e := &Emulator{}
+	e.init(ui, opts)
+	return e
+}
+
+// init sets up the zero Emulator e, which lets BatchEmulator lay out its
+// emulators in one slice.
+func (e *Emulator) init(ui UI, opts []Option) {
+	e.ui = ui
+	e.pc = PCStart
+	e.seed = time.Now().UnixNano()
+	e.settings = DefaultSettings()
+	e.ctx = Context{V: &e.vReg, I: &e.iReg, PC: &e.pc, DT: &e.delayTimer, ST: &e.soundTimer, e: e}
+	for _, opt := range opts {
+		opt(e)
+	}
+	if e.replay != nil {
+		e.settings = e.replay.Settings
+	}
+	if e.movie != nil {
+		e.movie.Settings = e.settings
+	}
+	e.reseed(0)
+}
+
+func (e *Emulator) Seed() int64 {
+	return e.seed
+}
+
+// Load loads rom and resets the machine. It is safe to call while Run is
+// running.
+func (e *Emulator) Load(rom []byte) error {
+	if len(rom) > MaxROMSize {
+		return fmt.Errorf("ROM is too large: %d bytes, max %d", len(rom), MaxROMSize)
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.load(rom)
+	return nil
+}
+
+// Reset reloads the current ROM and resets the machine. It does nothing
+// while input is recorded with WithInputRecording, since a movie cannot
+// contain a reset.
+func (e *Emulator) Reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.movie != nil {
+		return
+	}
+	e.load(e.rom)
+}
+
+func (e *Emulator) load(rom []byte) {
+	e.rom = rom
+
 	// clear memory
 	for i := range e.memory {
 		e.memory[i] = 0
@@ -37,38 +220,222 @@ func (e *Emulator) Load(rom []byte) {
 	for i, b := range rom {
 		e.memory[PCStart+i] = b
 	}
+	e.invalidate()
+	e.loadProgram(rom)
+
+	// reset registers, stack, timers and screen
+	e.vReg = [VRegisterSize]byte{}
+	e.iReg = 0
+	e.pc = PCStart
+	e.stack = nil
+	e.delayTimer = 0
+	e.soundTimer = 0
+	e.frameBuffer = [BufferSize]byte{}
+	e.stableBuffer = [BufferSize]byte{}
+	e.dirty = true
+	e.frames = 0
+	e.inFrame = 0
+	e.stopped = false
+	e.stats = Stats{}
+	e.reseed(0)
+}
+
+func (e *Emulator) Pause() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.paused = true
 }
 
-func (e *Emulator) Run() {
-	rand.Seed(time.Now().UnixNano())
-	t0 := time.NewTicker(ClockSpeed)
+func (e *Emulator) Resume() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.paused = false
+}
+
+func (e *Emulator) Paused() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.paused
+}
+
+// Run runs the emulator in real time until ctx is done or the CPU fails.
+// Instructions are executed in bursts of CyclesPerFrame once per timer
+// period, so that the machine state only depends on the keypad state
+// sampled at the start of each frame.
+func (e *Emulator) Run(ctx context.Context) error {
 	t1 := time.NewTicker(FrameRate)
+	defer t1.Stop()
 	t2 := time.NewTicker(TimerSpeed)
+	defer t2.Stop()
 	for {
 		select {
-		case <-t0.C:
-			e.Cycle()
+		case <-ctx.Done():
+			return ctx.Err()
 		case <-t1.C:
-			e.display.Render(e.frameBuffer)
+			e.present(FrameRate)
 		case <-t2.C:
-			if e.delayTimer > 0 {
-				e.delayTimer--
+			e.mu.Lock()
+			var err error
+			if !e.paused {
+				err = e.frame()
 			}
-			if e.soundTimer > 0 {
-				if e.soundTimer == 1 {
-					e.display.Beep()
-				}
-				e.soundTimer--
+			e.mu.Unlock()
+			if err != nil {
+				return err
 			}
 		}
 	}
 }
 
-func (e *Emulator) Cycle() {
+// Frame runs the emulator for one timer period as fast as possible:
+// CyclesPerFrame instructions followed by a timer tick, after which the
+// screen is presented.
+func (e *Emulator) Frame() error {
+	e.mu.Lock()
+	err := e.frame()
+	e.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	e.present(TimerSpeed)
+	return nil
+}
+
+func (e *Emulator) frame() error {
+	keys := e.frameKeys()
+	for e.inFrame < e.settings.CyclesPerFrame {
+		if e.breakAt() {
+			return ErrBreakpoint
+		}
+		if e.hookAt {
+			e.hookPC()
+		}
+		if b := e.blockAt(e.settings.CyclesPerFrame - e.inFrame); b != nil {
+			e.runBlock(b, keys)
+			e.inFrame += len(b.Insts)
+			continue
+		}
+		if err := e.cycle(keys); err != nil {
+			return err
+		}
+		e.inFrame++
+	}
+	e.endFrame(keys)
+	return nil
+}
+
+// frameKeys returns the keypad state of the current frame, which is sampled
+// before its first instruction.
+func (e *Emulator) frameKeys() Keypad {
+	if e.inFrame > 0 {
+		return e.keys
+	}
+	e.overlay = nil
+	e.keys = e.hookInput(e.ui.Keypad())
+	if e.replay != nil && e.frames < uint64(len(e.replay.Keys)) {
+		e.keys = e.replay.Keys[e.frames]
+	}
+	return e.keys
+}
+
+// endFrame ticks the timers after the last instruction of a frame.
+func (e *Emulator) endFrame(keys Keypad) {
+	e.inFrame = 0
+	e.tick()
+	e.frames++
+	e.hookFrame()
+
+	if e.movie != nil {
+		e.movie.record(keys, e.hash())
+	}
+	if e.hook != nil {
+		e.hook(e.frames, keys, e.state)
+	}
+}
+
+// Frames returns the number of frames run since the ROM was loaded.
+func (e *Emulator) Frames() uint64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.frames
+}
+
+// Screen returns a copy of the frame buffer.
+func (e *Emulator) Screen() [BufferSize]byte {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.frameBuffer
+}
+
+// present renders the screen and passes it to the recorder, if any, to be
+// shown for d. The screen is copied so that the UI, which may call back into
+// the emulator, runs without holding the lock.
+func (e *Emulator) present(d time.Duration) {
+	e.mu.Lock()
+	buf := e.frameBuffer
+	if e.ui.Mode() == ModeStable {
+		buf = e.stableBuffer
+	}
+	// the screen under text that was shown may need to be redrawn
+	dirty := e.dirty || len(e.overlay) > 0 || e.overlaid
+	e.dirty = false
+	e.overlaid = len(e.overlay) > 0
+	for _, t := range e.overlay {
+		drawLabel(&buf, t)
+	}
+	e.mu.Unlock()
+
+	e.ui.Render(&buf, dirty)
+
+	if e.recorder != nil {
+		if err := e.recorder.Record(&buf, d); err != nil {
+			fmt.Println(err)
+			e.recorder = nil
+		}
+	}
+}
+
+func (e *Emulator) tick() {
+	if e.delayTimer > 0 {
+		e.delayTimer--
+	}
+	if e.soundTimer > 0 {
+		if e.soundTimer == 1 {
+			e.ui.Beep()
+		}
+		e.soundTimer--
+	}
+}
+
+// Cycle executes one instruction of the current frame, and ticks the timers
+// after the last one, so that CyclesPerFrame calls to Cycle are the same as a
+// call to Frame except that the screen is not presented.
+func (e *Emulator) Cycle() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.breakAt() {
+		return ErrBreakpoint
+	}
+	keys := e.frameKeys()
+	if e.hookAt {
+		e.hookPC()
+	}
+	if err := e.cycle(keys); err != nil {
+		return err
+	}
+	e.inFrame++
+	if e.inFrame == e.settings.CyclesPerFrame {
+		e.endFrame(keys)
+	}
+	return nil
+}
+
+func (e *Emulator) cycle(keys Keypad) error {
 	// fetch -> decode -> execute
-	key, pressed := e.display.Key()
-	opcode := uint16(e.memory[e.pc])<<8 | uint16(e.memory[e.pc+1])
-	e.execute(opcode, key, pressed)
+	op := e.fetch()
+	e.stats.Instructions++
+	e.stats.Histogram[op.inst]++
+	return e.exec(op, keys)
 }
 
 func (e *Emulator) decode(opcode uint16) Instruction {
@@ -169,37 +536,46 @@ func (e *Emulator) decode(opcode uint16) Instruction {
 	}
 }
 
-func (e *Emulator) execute(opcode uint16, key byte, pressed bool) {
-	e.descOpcode(opcode)
+func (e *Emulator) execute(opcode uint16, keys Keypad) error {
+	op := e.decodeOp(opcode)
+	return e.exec(&op, keys)
+}
 
-	inst := e.decode(opcode)
-	x := (opcode & 0x0F00) >> 8
-	y := (opcode & 0x00F0) >> 4
-	nnn := opcode & 0x0FFF
-	kk := opcode & 0x00FF
-	n := opcode & 0x000F
+func (e *Emulator) exec(op *op, keys Keypad) error {
+	if e.trace != nil {
+		e.descOpcode(op)
+	}
+
+	x, y, nnn, kk, n := op.x, op.y, op.nnn, op.kk, op.n
 	incPC := true
 
-	switch inst {
+	switch op.inst {
 	case SYS:
 		// 0nnn - SYS addr
 		// Jump to a machine code routine at nnn.
 		//
 		// This instruction is only used on the old computers on which Chip-8
 		// was originally implemented. It is ignored by modern interpreters.
-		panic("not implemented: SYS")
+		return fmt.Errorf("not implemented: SYS at 0x%03x", e.pc)
 	case CLS:
 		// 00E0 - CLS
 		// Clear the display.
 		for i := 0; i < BufferSize; i++ {
+			if e.frameBuffer[i] != 0 {
+				e.stats.PixelsChanged++
+			}
 			e.frameBuffer[i] = 0
 		}
+		e.dirty = true
 	case RET:
 		// 00EE - RET
 		// Return from a subroutine.
 		//
 		// The interpreter sets the program counter to the address at the top
 		// of the stack, then subtracts 1 from the stack pointer.
+		if len(e.stack) == 0 {
+			return fmt.Errorf("stack underflow at 0x%03x", e.pc)
+		}
 		e.pc = e.stack[len(e.stack)-1]
 		e.stack = e.stack[:len(e.stack)-1]
 	case JPAddr:
@@ -215,6 +591,9 @@ func (e *Emulator) execute(opcode uint16, key byte, pressed bool) {
 		//
 		// The interpreter increments the stack pointer, then puts the current
 		// PC on the top of the stack. The PC is then set to nnn.
+		if len(e.stack) == StackSize {
+			return fmt.Errorf("stack overflow at 0x%03x", e.pc)
+		}
 		e.stack = append(e.stack, e.pc)
 		e.pc = nnn
 		incPC = false
@@ -273,6 +652,9 @@ func (e *Emulator) execute(opcode uint16, key byte, pressed bool) {
 		// values, and if either bit is 1, then the same bit in the result is
 		// also 1. Otherwise, it is 0.
 		e.vReg[x] |= e.vReg[y]
+		if e.settings.Quirks.ResetVF {
+			e.vReg[0xF] = 0
+		}
 	case AND:
 		// 8xy2 - AND Vx, Vy
 		// Set Vx = Vx AND Vy.
@@ -282,6 +664,9 @@ func (e *Emulator) execute(opcode uint16, key byte, pressed bool) {
 		// values, and if both bits are 1, then the same bit in the result is
 		// also 1. Otherwise, it is 0.
 		e.vReg[x] &= e.vReg[y]
+		if e.settings.Quirks.ResetVF {
+			e.vReg[0xF] = 0
+		}
 	case XOR:
 		// 8xy3 - XOR Vx, Vy
 		// Set Vx = Vx XOR Vy.
@@ -291,6 +676,9 @@ func (e *Emulator) execute(opcode uint16, key byte, pressed bool) {
 		// bits from two values, and if the bits are not both the same, then
 		//the corresponding bit in the result is set to 1. Otherwise, it is 0.
 		e.vReg[x] ^= e.vReg[y]
+		if e.settings.Quirks.ResetVF {
+			e.vReg[0xF] = 0
+		}
 	case ADDVxVy:
 		// 8xy4 - ADD Vx, Vy
 		// Set Vx = Vx + Vy, set VF = carry.
@@ -299,6 +687,10 @@ func (e *Emulator) execute(opcode uint16, key byte, pressed bool) {
 		// than 8 bits (i.e., > 255,) VF is set to 1, otherwise 0. Only the
 		// lowest 8 bits of the result are kept, and stored in Vx.
 		added := uint16(e.vReg[x]) + uint16(e.vReg[y])
+		if e.settings.Quirks.FlagLast {
+			e.setFlagLast(x, byte(added), added > 0xFF)
+			break
+		}
 		if added > 0xFF {
 			e.vReg[0xF] = 1
 		} else {
@@ -311,6 +703,10 @@ func (e *Emulator) execute(opcode uint16, key byte, pressed bool) {
 		//
 		// If Vx > Vy, then VF is set to 1, otherwise 0. Then Vy is subtracted
 		// from Vx, and the results stored in Vx.
+		if e.settings.Quirks.FlagLast {
+			e.setFlagLast(x, e.vReg[x]-e.vReg[y], e.vReg[x] > e.vReg[y])
+			break
+		}
 		if e.vReg[x] > e.vReg[y] {
 			e.vReg[0xF] = 1
 			e.vReg[x] -= e.vReg[y]
@@ -327,6 +723,13 @@ func (e *Emulator) execute(opcode uint16, key byte, pressed bool) {
 		//
 		// If the least-significant bit of Vx is 1, then VF is set to 1,
 		// otherwise 0. Then Vx is divided by 2.
+		if e.settings.Quirks.ShiftVy {
+			e.vReg[x] = e.vReg[y]
+		}
+		if e.settings.Quirks.FlagLast {
+			e.setFlagLast(x, e.vReg[x]>>1, e.vReg[x]&0x1 == 1)
+			break
+		}
 		e.vReg[0xF] = e.vReg[x] & 0x1
 		e.vReg[x] >>= 1
 	case SUBN:
@@ -335,6 +738,10 @@ func (e *Emulator) execute(opcode uint16, key byte, pressed bool) {
 		//
 		// If Vy > Vx, then VF is set to 1, otherwise 0. Then Vx is subtracted
 		// from Vy, and the results stored in Vx.
+		if e.settings.Quirks.FlagLast {
+			e.setFlagLast(x, e.vReg[y]-e.vReg[x], e.vReg[y] > e.vReg[x])
+			break
+		}
 		if e.vReg[y] > e.vReg[x] {
 			e.vReg[0xF] = 1
 			e.vReg[x] = e.vReg[y] - e.vReg[x]
@@ -351,6 +758,13 @@ func (e *Emulator) execute(opcode uint16, key byte, pressed bool) {
 		//
 		// If the most-significant bit of Vx is 1, then VF is set to 1,
 		// otherwise to 0. Then Vx is multiplied by 2.
+		if e.settings.Quirks.ShiftVy {
+			e.vReg[x] = e.vReg[y]
+		}
+		if e.settings.Quirks.FlagLast {
+			e.setFlagLast(x, e.vReg[x]<<1, e.vReg[x]&0b10000000 != 0)
+			break
+		}
 		e.vReg[0xF] = (e.vReg[x] & 0b10000000) >> 7
 		e.vReg[x] <<= 1
 	case SNEVxVy:
@@ -374,6 +788,9 @@ func (e *Emulator) execute(opcode uint16, key byte, pressed bool) {
 		//
 		// The program counter is set to nnn plus the value of V0.
 		e.pc = nnn + uint16(e.vReg[0])
+		if e.settings.Quirks.JumpVx {
+			e.pc = nnn + uint16(e.vReg[x])
+		}
 		incPC = false
 	case RND:
 		// Cxkk - RND Vx, byte
@@ -382,7 +799,7 @@ func (e *Emulator) execute(opcode uint16, key byte, pressed bool) {
 		// The interpreter generates a random number from 0 to 255, which is
 		// then ANDed with the value kk. The results are stored in Vx. See
 		// instruction 8xy2 for more information on AND.
-		e.vReg[x] = byte(uint16(rand.Intn(256)) & kk)
+		e.vReg[x] = byte(uint16(e.rng.Intn(256)) & kk)
 	case DRW:
 		// Dxyn - DRW Vx, Vy, nibble
 		// Display n-byte sprite starting at memory location I at (Vx, Vy), set
@@ -399,12 +816,22 @@ func (e *Emulator) execute(opcode uint16, key byte, pressed bool) {
 		// sprites.
 		vx := e.vReg[x]
 		vy := e.vReg[y]
-		sprite := e.memory[e.iReg : e.iReg+n]
-		erased := e.drawSprite(vx, vy, sprite)
+		var sprite [15]byte
+		for i := uint16(0); i < n; i++ {
+			sprite[i] = e.memory[(e.iReg+i)%MemorySize]
+		}
+		erased := e.drawSprite(vx, vy, sprite[:n])
+		e.stats.Draws++
 		if erased {
+			e.stats.Collisions++
 			e.vReg[0xF] = 1
 		} else {
 			e.vReg[0xF] = 0
+			e.stableBuffer = e.frameBuffer
+		}
+		if e.settings.Quirks.VBlank {
+			// the frame ends once the caller counts this instruction
+			e.inFrame = e.settings.CyclesPerFrame - 1
 		}
 	case SKP:
 		// Ex9E - SKP Vx
@@ -412,7 +839,8 @@ func (e *Emulator) execute(opcode uint16, key byte, pressed bool) {
 		//
 		// Checks the keyboard, and if the key corresponding to the value of Vx
 		// is currently in the down position, PC is increased by 2.
-		if pressed && key == e.vReg[x] {
+		e.poll(e.vReg[x])
+		if keys.Pressed(e.vReg[x]) {
 			e.pc += 2
 		}
 	case SKNP:
@@ -421,7 +849,8 @@ func (e *Emulator) execute(opcode uint16, key byte, pressed bool) {
 		//
 		// Checks the keyboard, and if the key corresponding to the value of Vx
 		// is currently in the up position, PC is increased by 2.
-		if !pressed || key != e.vReg[x] {
+		e.poll(e.vReg[x])
+		if !keys.Pressed(e.vReg[x]) {
 			e.pc += 2
 		}
 	case LDVxDT:
@@ -436,10 +865,13 @@ func (e *Emulator) execute(opcode uint16, key byte, pressed bool) {
 		//
 		// All execution stops until a key is pressed, then the value of that
 		// key is stored in Vx.
-		if pressed {
-			e.vReg[x] = key
-		} else {
-			incPC = false
+		incPC = false
+		for key := byte(0); keys != 0 && key < 16; key++ {
+			if keys.Pressed(key) {
+				e.vReg[x] = key
+				incPC = true
+				break
+			}
 		}
 	case LDDTVx:
 		// Fx15 - LD DT, Vx
@@ -476,9 +908,9 @@ func (e *Emulator) execute(opcode uint16, key byte, pressed bool) {
 		hundreds := e.vReg[x] / 100
 		tens := (e.vReg[x] / 10) % 10
 		ones := e.vReg[x] % 10
-		e.memory[e.iReg] = hundreds
-		e.memory[e.iReg+1] = tens
-		e.memory[e.iReg+2] = ones
+		e.write(e.iReg, hundreds)
+		e.write(e.iReg+1, tens)
+		e.write(e.iReg+2, ones)
 	case LDIVx:
 		// Fx55 - LD [I], Vx
 		// Store registers V0 through Vx in memory starting at location I.
@@ -486,7 +918,10 @@ func (e *Emulator) execute(opcode uint16, key byte, pressed bool) {
 		// The interpreter copies the values of registers V0 through Vx into
 		// memory, starting at the address in I.
 		for i := uint16(0); i < x+1; i++ {
-			e.memory[e.iReg+i] = e.vReg[i]
+			e.write(e.iReg+i, e.vReg[i])
+		}
+		if e.settings.Quirks.IncrementI {
+			e.iReg += x + 1
 		}
 	case LDVxI:
 		// Fx65 - LD Vx, [I]
@@ -495,22 +930,37 @@ func (e *Emulator) execute(opcode uint16, key byte, pressed bool) {
 		// The interpreter reads values from memory starting at location I into
 		// registers V0 through Vx.
 		for i := uint16(0); i < x+1; i++ {
-			e.vReg[i] = e.memory[e.iReg+i]
+			e.vReg[i] = e.memory[(e.iReg+i)%MemorySize]
+		}
+		if e.settings.Quirks.IncrementI {
+			e.iReg += x + 1
 		}
 	case UNKNOWN:
-		panic(fmt.Errorf("unknown opcode: 0x%04x", opcode))
+		return fmt.Errorf("unknown opcode: 0x%04x at 0x%03x", op.opcode, e.pc)
 	}
 
 	if incPC {
 		e.pc += 2
 	}
+	// addresses wrap around at the end of memory
+	e.pc %= MemorySize
+	return nil
 }
 
 func (e *Emulator) drawSprite(vx, vy byte, sprite []byte) bool {
+	ox, oy := int(vx), int(vy)
+	q := e.settings.Quirks
+	if q.WrapOrigin {
+		ox, oy = ox%BaseWidth, oy%BaseHeight
+	}
 	erased := false
 	for y, b := range sprite {
 		for x, bit := range bits(b) {
-			erased = e.drawPixel(x+int(vx), y+int(vy), bit == 1) || erased
+			px, py := x+ox, y+oy
+			if q.WrapPixels {
+				px, py = px%BaseWidth, py%BaseHeight
+			}
+			erased = e.drawPixel(px, py, bit == 1) || erased
 		}
 	}
 	return erased
@@ -522,6 +972,10 @@ func (e *Emulator) drawPixel(x, y int, fill bool) bool {
 	}
 	prevFilled := e.filled(x, y)
 	curFilled := fill != prevFilled
+	if curFilled != prevFilled {
+		e.stats.PixelsChanged++
+		e.dirty = true
+	}
 	if curFilled {
 		e.frameBuffer[x+y*BaseWidth] = 1
 	} else {
@@ -534,16 +988,11 @@ func (e *Emulator) filled(x, y int) bool {
 	return e.frameBuffer[x+y*BaseWidth] == 1
 }
 
-func (e *Emulator) descOpcode(opcode uint16) {
-	desc := fmt.Sprintf("0x%04x", opcode) + " "
-	inst := e.decode(opcode)
-	x := (opcode & 0x0F00) >> 8
-	y := (opcode & 0x00F0) >> 4
-	nnn := opcode & 0x0FFF
-	kk := opcode & 0x00FF
-	n := opcode & 0x000F
+func (e *Emulator) descOpcode(op *op) {
+	desc := fmt.Sprintf("0x%04x", op.opcode) + " "
+	x, y, nnn, kk, n := op.x, op.y, op.nnn, op.kk, op.n
 
-	switch inst {
+	switch op.inst {
 	case SYS:
 		desc += fmt.Sprintf("SYS 0x%03x", nnn)
 	case CLS:
@@ -618,7 +1067,7 @@ func (e *Emulator) descOpcode(opcode uint16) {
 		desc += "Unknown"
 	}
 
-	fmt.Println(desc)
+	fmt.Fprintln(e.trace, desc)
 }
 
 func bits(b byte) [8]byte {
@@ -1,6 +1,7 @@
 package chip8
 
 import (
+	"context"
 	"math/rand"
 	"testing"
 	"time"
@@ -16,7 +17,7 @@ func TestEmulator(t *testing.T) {
 			e.frameBuffer[i] = byte(rand.Intn(2))
 		}
 
-		e.execute(0x00E0, 0, false)
+		e.execute(0x00E0, 0)
 
 		for _, b := range e.frameBuffer {
 			if b != 0 {
@@ -30,7 +31,7 @@ func TestEmulator(t *testing.T) {
 		e := New(d)
 		e.stack = append(e.stack, 0x666)
 
-		e.execute(0x00EE, 0, false)
+		e.execute(0x00EE, 0)
 
 		if e.pc != 0x666+2 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.pc, 0x666+2)
@@ -41,7 +42,7 @@ func TestEmulator(t *testing.T) {
 		d := NewDisplay()
 		e := New(d)
 
-		e.execute(0x1228, 0, false)
+		e.execute(0x1228, 0)
 
 		if e.pc != 0x228 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.pc, 0x228)
@@ -53,7 +54,7 @@ func TestEmulator(t *testing.T) {
 		e := New(d)
 		prevPC := e.pc
 
-		e.execute(0x2242, 0, false)
+		e.execute(0x2242, 0)
 
 		if e.pc != 0x242 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.pc, 0x242)
@@ -69,7 +70,7 @@ func TestEmulator(t *testing.T) {
 		e.vReg[2] = 1
 		prevPC := e.pc
 
-		e.execute(0x3201, 0, false)
+		e.execute(0x3201, 0)
 
 		if e.pc != prevPC+4 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.pc, prevPC+4)
@@ -81,7 +82,7 @@ func TestEmulator(t *testing.T) {
 		e := New(d)
 		prevPC := e.pc
 
-		e.execute(0x452A, 0, false)
+		e.execute(0x452A, 0)
 
 		if e.pc != prevPC+4 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.pc, prevPC+4)
@@ -95,7 +96,7 @@ func TestEmulator(t *testing.T) {
 		e.vReg[6] = 123
 		prevPC := e.pc
 
-		e.execute(0x5560, 0, false)
+		e.execute(0x5560, 0)
 
 		if e.pc != prevPC+4 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.pc, prevPC+4)
@@ -106,7 +107,7 @@ func TestEmulator(t *testing.T) {
 		d := NewDisplay()
 		e := New(d)
 
-		e.execute(0x600C, 0, false)
+		e.execute(0x600C, 0)
 
 		if e.vReg[0] != 0x0C {
 			t.Errorf("got=0x%04x, want=0x%04x", e.vReg[0], 0x0C)
@@ -117,7 +118,7 @@ func TestEmulator(t *testing.T) {
 		d := NewDisplay()
 		e := New(d)
 
-		e.execute(0x7009, 0, false)
+		e.execute(0x7009, 0)
 
 		if e.vReg[0] != 0x09 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.vReg[0], 0x09)
@@ -130,7 +131,7 @@ func TestEmulator(t *testing.T) {
 		e.vReg[0xD] = 121
 		e.vReg[0xE] = 123
 
-		e.execute(0x8DE0, 0, false)
+		e.execute(0x8DE0, 0)
 
 		if e.vReg[0xD] != e.vReg[0xE] {
 			t.Errorf("got=0x%04x, want=0x%04x", e.vReg[0xD], e.vReg[0xE])
@@ -143,7 +144,7 @@ func TestEmulator(t *testing.T) {
 		e.vReg[0] = 5
 		e.vReg[1] = 2
 
-		e.execute(0x8011, 0, false)
+		e.execute(0x8011, 0)
 
 		if e.vReg[0] != 7 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.vReg[0], 7)
@@ -156,7 +157,7 @@ func TestEmulator(t *testing.T) {
 		e.vReg[0] = 5
 		e.vReg[1] = 3
 
-		e.execute(0x8012, 0, false)
+		e.execute(0x8012, 0)
 
 		if e.vReg[0] != 1 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.vReg[0], 1)
@@ -169,7 +170,7 @@ func TestEmulator(t *testing.T) {
 		e.vReg[0] = 5
 		e.vReg[1] = 3
 
-		e.execute(0x8013, 0, false)
+		e.execute(0x8013, 0)
 
 		if e.vReg[0] != 6 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.vReg[0], 6)
@@ -182,7 +183,7 @@ func TestEmulator(t *testing.T) {
 		e.vReg[0] = 0xFF
 		e.vReg[1] = 0x1
 
-		e.execute(0x8014, 0, false)
+		e.execute(0x8014, 0)
 
 		if e.vReg[0] != 0 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.vReg[0], 0)
@@ -198,7 +199,7 @@ func TestEmulator(t *testing.T) {
 		e.vReg[0] = 1
 		e.vReg[1] = 2
 
-		e.execute(0x8015, 0, false)
+		e.execute(0x8015, 0)
 
 		if e.vReg[0] != 0xFF {
 			t.Errorf("got=0x%04x, want=0x%04x", e.vReg[0], 0xFF)
@@ -213,7 +214,7 @@ func TestEmulator(t *testing.T) {
 		e := New(d)
 		e.vReg[0] = 7
 
-		e.execute(0x8006, 0, false)
+		e.execute(0x8006, 0)
 
 		if e.vReg[0xF] != 1 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.vReg[0xF], 1)
@@ -229,7 +230,7 @@ func TestEmulator(t *testing.T) {
 		e.vReg[0] = 1
 		e.vReg[1] = 2
 
-		e.execute(0x8017, 0, false)
+		e.execute(0x8017, 0)
 
 		if e.vReg[0] != 1 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.vReg[0], 1)
@@ -244,7 +245,7 @@ func TestEmulator(t *testing.T) {
 		e := New(d)
 		e.vReg[0] = 0xFF
 
-		e.execute(0x800E, 0, false)
+		e.execute(0x800E, 0)
 
 		if e.vReg[0xF] != 1 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.vReg[0xF], 1)
@@ -261,7 +262,7 @@ func TestEmulator(t *testing.T) {
 		e.vReg[6] = 123
 		prevPC := e.pc
 
-		e.execute(0x9560, 0, false)
+		e.execute(0x9560, 0)
 
 		if e.pc != prevPC+4 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.pc, prevPC+4)
@@ -272,7 +273,7 @@ func TestEmulator(t *testing.T) {
 		d := NewDisplay()
 		e := New(d)
 
-		e.execute(0xA22A, 0, false)
+		e.execute(0xA22A, 0)
 
 		if e.iReg != 0x22A {
 			t.Errorf("got=0x%04x, want=0x%04x", e.iReg, 0x22A)
@@ -284,7 +285,7 @@ func TestEmulator(t *testing.T) {
 		e := New(d)
 		e.vReg[0] = 1
 
-		e.execute(0xB228, 0, false)
+		e.execute(0xB228, 0)
 
 		if e.pc != 0x229 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.pc, 0x229)
@@ -295,17 +296,79 @@ func TestEmulator(t *testing.T) {
 		// pass
 	})
 
+	t.Run("Cxkk RND seed", func(t *testing.T) {
+		e1 := New(NewHeadless(), WithSeed(42))
+		e2 := New(NewHeadless(), WithSeed(42))
+		e3 := New(NewHeadless(), WithSeed(43))
+		same, differ := true, false
+
+		for i := 0; i < 16; i++ {
+			e1.execute(0xC0FF, 0)
+			e2.execute(0xC0FF, 0)
+			e3.execute(0xC0FF, 0)
+			same = same && e1.vReg[0] == e2.vReg[0]
+			differ = differ || e1.vReg[0] != e3.vReg[0]
+		}
+
+		if !same {
+			t.Error("Error: same seed produced different numbers")
+		}
+		if !differ {
+			t.Error("Error: different seeds produced the same numbers")
+		}
+	})
+
 	t.Run("Dxyn DRW", func(t *testing.T) {
 		// pass
 	})
 
+	t.Run("Dxyn DRW stable", func(t *testing.T) {
+		d := NewDisplay()
+		e := New(d)
+		e.Load(nil)
+		e.iReg = 0 // font sprite for 0
+
+		e.execute(0xD005, 0)
+
+		if e.stableBuffer != e.frameBuffer {
+			t.Error("Error: stable buffer not updated")
+		}
+
+		e.execute(0xD005, 0)
+
+		if e.stableBuffer == e.frameBuffer {
+			t.Error("Error: stable buffer updated on erase")
+		}
+	})
+
+	t.Run("Dxyn DRW dirty", func(t *testing.T) {
+		d := NewDisplay()
+		e := New(d)
+		e.Load(nil)
+
+		e.execute(0xD005, 0)
+
+		if !e.dirty {
+			t.Error("Error: dirty not set on draw")
+		}
+
+		e.dirty = false
+		e.iReg = 0x100 // blank sprite
+
+		e.execute(0xD005, 0)
+
+		if e.dirty {
+			t.Error("Error: dirty set without change")
+		}
+	})
+
 	t.Run("Ex9E SKP", func(t *testing.T) {
 		d := NewDisplay()
 		e := New(d)
 		e.vReg[9] = 3
 		prevPC := e.pc
 
-		e.execute(0xE99E, 0x3, true)
+		e.execute(0xE99E, 1<<0x3)
 
 		if e.pc != prevPC+4 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.pc, prevPC+4)
@@ -318,7 +381,7 @@ func TestEmulator(t *testing.T) {
 		e.vReg[9] = 3
 		prevPC := e.pc
 
-		e.execute(0xE9A1, 0x2, true)
+		e.execute(0xE9A1, 1<<0x2)
 
 		if e.pc != prevPC+4 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.pc, prevPC+4)
@@ -330,7 +393,7 @@ func TestEmulator(t *testing.T) {
 		e := New(d)
 		e.delayTimer = 1
 
-		e.execute(0xF007, 0, false)
+		e.execute(0xF007, 0)
 
 		if e.vReg[0] != 1 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.vReg[0], 1)
@@ -342,13 +405,13 @@ func TestEmulator(t *testing.T) {
 		e := New(d)
 		prevPC := e.pc
 
-		e.execute(0xF00A, 0, false)
+		e.execute(0xF00A, 0)
 
 		if e.pc != prevPC {
 			t.Fatalf("got=0x%04x, want=0x%04x", e.pc, prevPC)
 		}
 
-		e.execute(0xF00A, 1, true)
+		e.execute(0xF00A, 1<<0x1)
 		if e.vReg[0] != 1 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.vReg[0], 1)
 		}
@@ -359,7 +422,7 @@ func TestEmulator(t *testing.T) {
 		e := New(d)
 		e.vReg[0] = 1
 
-		e.execute(0xF015, 0, false)
+		e.execute(0xF015, 0)
 
 		if e.delayTimer != 1 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.delayTimer, 1)
@@ -371,7 +434,7 @@ func TestEmulator(t *testing.T) {
 		e := New(d)
 		e.vReg[0] = 1
 
-		e.execute(0xF018, 0, false)
+		e.execute(0xF018, 0)
 
 		if e.soundTimer != 1 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.soundTimer, 1)
@@ -384,7 +447,7 @@ func TestEmulator(t *testing.T) {
 		e.iReg = 7
 		e.vReg[2] = 4
 
-		e.execute(0xF21E, 0, false)
+		e.execute(0xF21E, 0)
 
 		if e.iReg != 11 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.iReg, 11)
@@ -401,7 +464,7 @@ func TestEmulator(t *testing.T) {
 		e.iReg = 1
 		e.vReg[0] = 213
 
-		e.execute(0xF033, 0, false)
+		e.execute(0xF033, 0)
 
 		if e.memory[1] != 2 {
 			t.Errorf("got=0x%04x, want=0x%04x", e.memory[1], 2)
@@ -422,7 +485,7 @@ func TestEmulator(t *testing.T) {
 			e.vReg[i] = i
 		}
 
-		e.execute(0xF855, 0, false)
+		e.execute(0xF855, 0)
 
 		for i := byte(0); i < 9; i++ {
 			if e.memory[1+i] != i {
@@ -439,7 +502,7 @@ func TestEmulator(t *testing.T) {
 			e.memory[1+i] = i
 		}
 
-		e.execute(0xF865, 0, false)
+		e.execute(0xF865, 0)
 
 		for i := byte(0); i < 9; i++ {
 			if e.vReg[i] != i {
@@ -449,3 +512,71 @@ func TestEmulator(t *testing.T) {
 	})
 
 }
+
+func TestControl(t *testing.T) {
+
+	t.Run("Run cancel", func(t *testing.T) {
+		e := New(NewHeadless())
+		e.Load([]byte{0x12, 0x00}) // JP 0x200
+		ctx, cancel := context.WithTimeout(context.Background(), 3*TimerSpeed)
+		defer cancel()
+
+		err := e.Run(ctx)
+
+		if err != context.DeadlineExceeded {
+			t.Errorf("got=%v, want=%v", err, context.DeadlineExceeded)
+		}
+		if e.Frames() == 0 {
+			t.Error("Error: no frames run")
+		}
+	})
+
+	t.Run("Run error", func(t *testing.T) {
+		e := New(NewHeadless())
+		e.Load([]byte{0xFF, 0xFF})
+
+		err := e.Run(context.Background())
+
+		if err == nil {
+			t.Error("Error: unknown opcode not reported")
+		}
+	})
+
+	t.Run("Pause", func(t *testing.T) {
+		e := New(NewHeadless())
+		e.Load([]byte{0x12, 0x00})
+		e.Pause()
+		ctx, cancel := context.WithTimeout(context.Background(), 3*TimerSpeed)
+		defer cancel()
+
+		e.Run(ctx)
+
+		if e.Frames() != 0 {
+			t.Errorf("got=%d, want=%d", e.Frames(), 0)
+		}
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		e := New(NewHeadless())
+		e.Load([]byte{0x60, 0x01, 0x22, 0x00}) // LD V0, 1; CALL 0x200
+		for i := 0; i < 3; i++ {
+			e.Frame()
+		}
+
+		e.Reset()
+
+		if e.pc != PCStart {
+			t.Errorf("got=0x%04x, want=0x%04x", e.pc, PCStart)
+		}
+		if e.vReg[0] != 0 {
+			t.Errorf("got=0x%04x, want=0x%04x", e.vReg[0], 0)
+		}
+		if len(e.stack) != 0 {
+			t.Errorf("got=%d, want=%d", len(e.stack), 0)
+		}
+		if e.memory[PCStart] != 0x60 {
+			t.Errorf("got=0x%04x, want=0x%04x", e.memory[PCStart], 0x60)
+		}
+	})
+
+}
@@ -16,6 +16,15 @@ const (
 	MemorySize    = 4096
 	VRegisterSize = 16
 	PCStart       = 0x200
+	MaxROMSize    = MemorySize - PCStart
+	StackSize     = 16
+
+	PhosphorDecay  = 0.5
+	PhosphorCutoff = 0.1
+
+	KeyTimeout = 200 * time.Millisecond
+
+	CyclesPerFrame = int(TimerSpeed / ClockSpeed)
 )
 
 var FontSet = [...]byte{
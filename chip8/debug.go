@@ -0,0 +1,76 @@
+package chip8
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrBreakpoint is returned by Frame, Cycle and Run when the program counter
+// reaches a breakpoint, before the instruction there is executed. Running
+// again continues from there, in the middle of the frame if need be.
+var ErrBreakpoint = errors.New("breakpoint")
+
+// SetBreakpoint sets a breakpoint at addr. Compiled blocks are not run while
+// there are breakpoints.
+func (e *Emulator) SetBreakpoint(addr uint16) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.breakpoints == nil {
+		e.breakpoints = map[uint16]bool{}
+	}
+	e.breakpoints[addr%MemorySize] = true
+}
+
+// ClearBreakpoint removes the breakpoint at addr, if any.
+func (e *Emulator) ClearBreakpoint(addr uint16) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.breakpoints, addr%MemorySize)
+}
+
+// Breakpoints returns the addresses of the breakpoints in increasing order.
+func (e *Emulator) Breakpoints() []uint16 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	addrs := []uint16{}
+	for addr := range e.breakpoints {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return addrs[i] < addrs[j] })
+	return addrs
+}
+
+// breakAt reports whether execution must stop at the program counter: there
+// is a breakpoint there, and execution did not just stop at it.
+func (e *Emulator) breakAt() bool {
+	if len(e.breakpoints) == 0 || !e.breakpoints[e.pc] || e.stopped {
+		e.stopped = false
+		return false
+	}
+	e.stopped = true
+	return true
+}
+
+// ReadMemory returns a copy of n bytes of memory starting at addr.
+func (e *Emulator) ReadMemory(addr uint16, n int) ([]byte, error) {
+	if n < 0 || int(addr)+n > MemorySize {
+		return nil, fmt.Errorf("invalid memory range: 0x%03x+%d", addr, n)
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]byte(nil), e.memory[addr:int(addr)+n]...), nil
+}
+
+// WriteMemory writes data to memory starting at addr, as if the program did.
+func (e *Emulator) WriteMemory(addr uint16, data []byte) error {
+	if int(addr)+len(data) > MemorySize {
+		return fmt.Errorf("invalid memory range: 0x%03x+%d", addr, len(data))
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for i, b := range data {
+		e.store(addr+uint16(i), b)
+	}
+	return nil
+}
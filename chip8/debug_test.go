@@ -0,0 +1,94 @@
+package chip8
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/morinokami/go-chip8/games"
+)
+
+func TestCycleFrame(t *testing.T) {
+	// CyclesPerFrame cycles are a frame
+	a, b := New(NewHeadless(), WithSeed(1)), New(NewHeadless(), WithSeed(1))
+	a.Load(games.Games[1].Binary)
+	b.Load(games.Games[1].Binary)
+	for i := 0; i < 100; i++ {
+		if err := a.Frame(); err != nil {
+			t.Fatal(err)
+		}
+		for j := 0; j < CyclesPerFrame; j++ {
+			if err := b.Cycle(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if a.Hash() != b.Hash() || a.Frames() != b.Frames() {
+		t.Error("frames and cycles differ")
+	}
+}
+
+func TestBreakpoint(t *testing.T) {
+	e := New(NewHeadless())
+	// LD V0, 0; ADD V0, 1; JP 0x202
+	e.Load([]byte{0x60, 0x00, 0x70, 0x01, 0x12, 0x02})
+	e.SetBreakpoint(0x204)
+
+	if err := e.Frame(); err != ErrBreakpoint {
+		t.Fatalf("got err=%v, want=%v", err, ErrBreakpoint)
+	}
+	if s := e.State(); s.PC != 0x204 || s.V[0] != 1 {
+		t.Fatalf("stopped at PC=0x%03x with V0=%d", s.PC, s.V[0])
+	}
+	// continuing runs the instruction at the breakpoint, and stops there
+	// again on the next iteration
+	if err := e.Frame(); err != ErrBreakpoint {
+		t.Fatalf("got err=%v, want=%v", err, ErrBreakpoint)
+	}
+	if s := e.State(); s.V[0] != 2 || e.Frames() != 0 {
+		t.Errorf("V0=%d, frames=%d", s.V[0], e.Frames())
+	}
+
+	if got := e.Breakpoints(); len(got) != 1 || got[0] != 0x204 {
+		t.Errorf("breakpoints: %v", got)
+	}
+	e.ClearBreakpoint(0x204)
+	if err := e.Frame(); err != nil {
+		t.Fatal(err)
+	}
+	// the frame that was interrupted ends after its 10th instruction, 3 more
+	// ADDs later
+	if s := e.State(); e.Frames() != 1 || s.V[0] != 5 {
+		t.Errorf("V0=%d, frames=%d", s.V[0], e.Frames())
+	}
+}
+
+func TestMemoryAccess(t *testing.T) {
+	e := New(NewHeadless())
+	// LD V0, 1; JP 0x200
+	e.Load([]byte{0x60, 0x01, 0x12, 0x00})
+	e.Cycle()
+
+	// patch the code that was already run and cached
+	if err := e.WriteMemory(PCStart+1, []byte{0x02}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := e.ReadMemory(PCStart, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []byte{0x60, 0x02, 0x12, 0x00}; !bytes.Equal(got, want) {
+		t.Errorf("got=% x, want=% x", got, want)
+	}
+	e.Cycle()
+	e.Cycle()
+	if s := e.State(); s.V[0] != 2 {
+		t.Errorf("V0: got=%d, want=%d", s.V[0], 2)
+	}
+
+	if _, err := e.ReadMemory(MemorySize-1, 2); err == nil {
+		t.Error("read past the end of memory")
+	}
+	if err := e.WriteMemory(MemorySize-1, []byte{0, 0}); err == nil {
+		t.Error("wrote past the end of memory")
+	}
+}
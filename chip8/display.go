@@ -2,26 +2,160 @@ package chip8
 
 import (
 	"fmt"
+	"image/color"
+	"math"
+	"strings"
+	"time"
 
 	"github.com/faiface/pixel"
-	"github.com/faiface/pixel/imdraw"
 	"github.com/faiface/pixel/pixelgl"
+	"github.com/faiface/pixel/text"
 	"golang.org/x/image/colornames"
+	"golang.org/x/image/font/basicfont"
 )
 
+type DisplayMode int
+
+const (
+	// ModeNormal presents the frame buffer as is.
+	ModeNormal DisplayMode = iota
+	// ModePhosphor emulates phosphor decay: erased pixels fade out over a
+	// few frames instead of disappearing at once.
+	ModePhosphor
+	// ModeBlend blends each frame with the previous one.
+	ModeBlend
+	// ModeStable presents the frame buffer only when a DRW has completed
+	// without erasing any pixels.
+	ModeStable
+)
+
+var displayModeNames = [...]string{"normal", "phosphor", "blend", "stable"}
+
+func (m DisplayMode) String() string {
+	return displayModeNames[m]
+}
+
+func ParseDisplayMode(s string) (DisplayMode, error) {
+	for i, name := range displayModeNames {
+		if name == s {
+			return DisplayMode(i), nil
+		}
+	}
+	return ModeNormal, fmt.Errorf("unknown display mode: %s", s)
+}
+
+func AvailableDisplayModes() string {
+	return strings.Join(displayModeNames[:], ", ")
+}
+
+type ScaleMode int
+
+const (
+	// ScaleInteger scales the screen by the largest integer factor that
+	// fits the window and letterboxes the rest.
+	ScaleInteger ScaleMode = iota
+	// ScaleFit scales the screen as large as the window allows while
+	// keeping its aspect ratio, letterboxing the rest.
+	ScaleFit
+	// ScaleStretch stretches the screen to fill the whole window.
+	ScaleStretch
+)
+
+var scaleModeNames = [...]string{"integer", "fit", "stretch"}
+
+func (m ScaleMode) String() string {
+	return scaleModeNames[m]
+}
+
+func ParseScaleMode(s string) (ScaleMode, error) {
+	for i, name := range scaleModeNames {
+		if name == s {
+			return ScaleMode(i), nil
+		}
+	}
+	return ScaleInteger, fmt.Errorf("unknown scale mode: %s", s)
+}
+
+func AvailableScaleModes() string {
+	return strings.Join(scaleModeNames[:], ", ")
+}
+
 type Display struct {
-	win *pixelgl.Window
+	win        *pixelgl.Window
+	mode       DisplayMode
+	scale      int
+	palette    Palette
+	scaleMode  ScaleMode
+	fullscreen bool
+	intensity  [BufferSize]float64
+	prev       [BufferSize]byte
+	fading     bool
+	sprite     *pixel.Sprite
+	recorder   Recorder
+	recording  string
+	onHotkey   func(Hotkey)
+
+	stats     func() Stats
+	overlay   bool
+	atlas     *text.Atlas
+	lastStats Stats
+	lastAt    time.Time
+	rates     string
 }
 
 func NewDisplay() *Display {
-	return &Display{}
+	return &Display{scale: ScalingFactor, palette: DefaultPalette}
+}
+
+// SetPalette sets the colors of the screen and of its screenshots and
+// recordings.
+func (d *Display) SetPalette(p Palette) {
+	d.palette = p
+}
+
+func (d *Display) SetMode(m DisplayMode) {
+	d.mode = m
+}
+
+func (d *Display) Mode() DisplayMode {
+	return d.mode
+}
+
+// SetScale sets the initial size of the window as a multiple of the CHIP-8
+// screen size.
+func (d *Display) SetScale(scale int) {
+	d.scale = scale
+}
+
+func (d *Display) SetScaleMode(m ScaleMode) {
+	d.scaleMode = m
+}
+
+func (d *Display) SetFullscreen(fullscreen bool) {
+	d.fullscreen = fullscreen
+}
+
+// SetHotkeyHandler sets the function called when P (pause), F5 (reset), C
+// (cheats) or Escape (quit) is pressed or the window is closed.
+func (d *Display) SetHotkeyHandler(f func(Hotkey)) {
+	d.onHotkey = f
+}
+
+// SetStats sets the function the stats overlay, toggled with F9, reads the
+// emulator counters from.
+func (d *Display) SetStats(f func() Stats) {
+	d.stats = f
 }
 
 func (d *Display) Init() {
 	cfg := pixelgl.WindowConfig{
-		Title:  "CHIP-8",
-		Bounds: pixel.R(0, 0, DisplayWidth, DisplayHeight),
-		VSync:  true,
+		Title:     "CHIP-8",
+		Bounds:    pixel.R(0, 0, float64(BaseWidth*d.scale), float64(BaseHeight*d.scale)),
+		Resizable: true,
+		VSync:     true,
+	}
+	if d.fullscreen {
+		cfg.Monitor = pixelgl.PrimaryMonitor()
 	}
 
 	win, err := pixelgl.NewWindow(cfg)
@@ -35,63 +169,226 @@ func (d *Display) Run(f func()) {
 	pixelgl.Run(f)
 }
 
-func (d *Display) Render(buf [BufferSize]byte) {
-	d.win.Clear(colornames.Black)
+// Close saves the recording started with the hotkey, if any, and destroys
+// the window.
+func (d *Display) Close() {
+	d.stopRecording()
+	d.win.Destroy()
+}
+
+// Render presents buf in the window. dirty reports whether buf has changed
+// since the previous call; the screen texture is only rebuilt when it has or
+// when pixels are still fading.
+func (d *Display) Render(buf *[BufferSize]byte, dirty bool) {
+	d.update(buf, dirty)
 
-	imd := imdraw.New(nil)
-	for i, b := range buf {
-		if b == 1 {
-			x := float64(i % 64)
-			y := float64(i / 64)
-			imd.Color = colornames.Pink
-			imd.Push(
-				pixel.V(x*ScalingFactor, DisplayHeight-(y*ScalingFactor)),
-				pixel.V((x+1)*ScalingFactor, DisplayHeight-((y+1)*ScalingFactor)),
-			)
-			imd.Rectangle(0)
+	d.win.Clear(d.palette.Background)
+	// the viewport is recomputed every frame so that window resizes take
+	// effect immediately
+	view, size := viewport(d.win.Bounds(), BaseWidth, BaseHeight, d.scaleMode)
+	d.sprite.Draw(d.win, pixel.IM.ScaledXY(pixel.ZV, size).Moved(view.Center()))
+	if d.overlay {
+		d.drawOverlay()
+	}
+	d.win.Update()
+
+	if d.recorder != nil {
+		if err := d.recorder.Record(buf, FrameRate); err != nil {
+			fmt.Println(err)
+			d.recorder = nil
 		}
 	}
-	imd.Draw(d.win)
 
-	d.win.Update()
+	if d.onHotkey != nil {
+		switch {
+		case d.win.JustPressed(pixelgl.KeyP):
+			d.onHotkey(HotkeyPause)
+		case d.win.JustPressed(pixelgl.KeyF5):
+			d.onHotkey(HotkeyReset)
+		case d.win.JustPressed(pixelgl.KeyC):
+			d.onHotkey(HotkeyCheats)
+		case d.win.JustPressed(pixelgl.KeyEscape), d.win.Closed():
+			d.onHotkey(HotkeyQuit)
+		}
+	}
+	if d.win.JustPressed(pixelgl.KeyF9) && d.stats != nil {
+		d.overlay = !d.overlay
+	}
+	if d.win.JustPressed(pixelgl.KeyF10) {
+		d.toggleRecording()
+	}
+	if d.win.JustPressed(pixelgl.KeyF11) {
+		d.toggleFullscreen()
+	}
+	if d.win.JustPressed(pixelgl.KeyF12) {
+		d.screenshot(buf)
+	}
 }
 
-func (d *Display) Key() (byte, bool) {
-	if d.win.Pressed(pixelgl.Key4) {
-		return 0x1, true
-	} else if d.win.Pressed(pixelgl.Key5) {
-		return 0x2, true
-	} else if d.win.Pressed(pixelgl.Key6) {
-		return 0x3, true
-	} else if d.win.Pressed(pixelgl.Key7) {
-		return 0xC, true
-	} else if d.win.Pressed(pixelgl.KeyR) {
-		return 0x4, true
-	} else if d.win.Pressed(pixelgl.KeyT) {
-		return 0x5, true
-	} else if d.win.Pressed(pixelgl.KeyY) {
-		return 0x6, true
-	} else if d.win.Pressed(pixelgl.KeyU) {
-		return 0xD, true
-	} else if d.win.Pressed(pixelgl.KeyF) {
-		return 0x7, true
-	} else if d.win.Pressed(pixelgl.KeyG) {
-		return 0x8, true
-	} else if d.win.Pressed(pixelgl.KeyH) {
-		return 0x9, true
-	} else if d.win.Pressed(pixelgl.KeyJ) {
-		return 0xE, true
-	} else if d.win.Pressed(pixelgl.KeyV) {
-		return 0xA, true
-	} else if d.win.Pressed(pixelgl.KeyB) {
-		return 0x0, true
-	} else if d.win.Pressed(pixelgl.KeyN) {
-		return 0xB, true
-	} else if d.win.Pressed(pixelgl.KeyM) {
-		return 0xF, true
+// screenshot saves buf to a timestamped PNG file in the working directory.
+func (d *Display) screenshot(buf *[BufferSize]byte) {
+	path := time.Now().Format("chip8-20060102-150405.png")
+	if err := SavePNG(path, buf, d.scale, d.palette); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("Saved " + path)
+}
+
+func (d *Display) update(buf *[BufferSize]byte, dirty bool) {
+	if !dirty && !d.fading && d.sprite != nil {
+		return
+	}
+	d.fading = d.fade(buf)
+
+	pic := pixel.MakePictureData(pixel.R(0, 0, BaseWidth, BaseHeight))
+	for i, v := range d.intensity {
+		x := i % BaseWidth
+		y := i / BaseWidth
+		// picture data is stored bottom-up
+		bg, fg := d.palette.Background, d.palette.Foreground
+		pic.Pix[(BaseHeight-1-y)*pic.Stride+x] = color.RGBA{
+			R: byte(float64(bg.R) + (float64(fg.R)-float64(bg.R))*v),
+			G: byte(float64(bg.G) + (float64(fg.G)-float64(bg.G))*v),
+			B: byte(float64(bg.B) + (float64(fg.B)-float64(bg.B))*v),
+			A: 0xFF,
+		}
+	}
+	d.sprite = pixel.NewSprite(pic, pic.Bounds())
+}
+
+// drawOverlay draws the emulator counters in the top left corner. Rates are
+// averaged over a second.
+func (d *Display) drawOverlay() {
+	if d.atlas == nil {
+		d.atlas = text.NewAtlas(basicfont.Face7x13, text.ASCII)
+	}
+
+	s := d.stats()
+	if now := time.Now(); now.Sub(d.lastAt) >= time.Second {
+		// counters start over when the ROM is reset
+		if !d.lastAt.IsZero() && s.Instructions >= d.lastStats.Instructions {
+			secs := now.Sub(d.lastAt).Seconds()
+			d.rates = fmt.Sprintf("%.0f instr/s\n%.1f fps\n%.1f draws/s\n",
+				float64(s.Instructions-d.lastStats.Instructions)/secs,
+				float64(s.Frames-d.lastStats.Frames)/secs,
+				float64(s.Draws-d.lastStats.Draws)/secs)
+		}
+		d.lastStats, d.lastAt = s, now
+	}
+
+	txt := text.New(pixel.V(4, d.win.Bounds().H()-d.atlas.LineHeight()), d.atlas)
+	txt.Color = colornames.White
+	fmt.Fprint(txt, d.rates)
+	fmt.Fprintf(txt, "%d instructions\n%d frames\n%d draws\n%d pixels changed\n%d collisions\n",
+		s.Instructions, s.Frames, s.Draws, s.PixelsChanged, s.Collisions)
+	txt.Draw(d.win, pixel.IM)
+}
+
+func (d *Display) toggleFullscreen() {
+	if d.win.Monitor() == nil {
+		d.win.SetMonitor(pixelgl.PrimaryMonitor())
 	} else {
-		return 0x0, false
+		d.win.SetMonitor(nil)
+	}
+}
+
+// toggleRecording starts recording the window to a timestamped GIF file in
+// the working directory, or stops and saves the current recording.
+func (d *Display) toggleRecording() {
+	if d.recorder != nil {
+		d.stopRecording()
+		return
+	}
+
+	path := time.Now().Format("chip8-20060102-150405.gif")
+	r, err := NewRecorder(path, d.scale, d.palette)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	d.recorder = r
+	d.recording = path
+	fmt.Println("Recording to " + path)
+}
+
+// stopRecording closes and saves the current recording, if any.
+func (d *Display) stopRecording() {
+	if d.recorder == nil {
+		return
+	}
+	if err := d.recorder.Close(); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Println("Saved " + d.recording)
+	}
+	d.recorder = nil
+}
+
+// viewport returns the area of bounds that a cols x rows screen is drawn to
+// and the size of a single screen pixel within it.
+func viewport(bounds pixel.Rect, cols, rows int, m ScaleMode) (pixel.Rect, pixel.Vec) {
+	size := pixel.V(bounds.W()/float64(cols), bounds.H()/float64(rows))
+	switch m {
+	case ScaleInteger:
+		s := math.Floor(math.Min(size.X, size.Y))
+		if s < 1 {
+			s = math.Min(size.X, size.Y)
+		}
+		size = pixel.V(s, s)
+	case ScaleFit:
+		s := math.Min(size.X, size.Y)
+		size = pixel.V(s, s)
+	}
+
+	w, h := size.X*float64(cols), size.Y*float64(rows)
+	min := bounds.Min.Add(pixel.V(
+		math.Floor((bounds.W()-w)/2),
+		math.Floor((bounds.H()-h)/2),
+	))
+	return pixel.R(min.X, min.Y, min.X+w, min.Y+h), size
+}
+
+// fade updates the intensity of each pixel according to the display mode and
+// reports whether any of them changed.
+func (d *Display) fade(buf *[BufferSize]byte) bool {
+	prev := d.intensity
+	for i, b := range buf {
+		switch d.mode {
+		case ModePhosphor:
+			if b == 1 {
+				d.intensity[i] = 1
+				continue
+			}
+			d.intensity[i] *= PhosphorDecay
+			if d.intensity[i] < PhosphorCutoff {
+				d.intensity[i] = 0
+			}
+		case ModeBlend:
+			d.intensity[i] = float64(b+d.prev[i]) / 2
+		default:
+			d.intensity[i] = float64(b)
+		}
+	}
+	d.prev = *buf
+	return d.intensity != prev
+}
+
+var displayKeys = [16]pixelgl.Button{
+	0x1: pixelgl.Key4, 0x2: pixelgl.Key5, 0x3: pixelgl.Key6, 0xC: pixelgl.Key7,
+	0x4: pixelgl.KeyR, 0x5: pixelgl.KeyT, 0x6: pixelgl.KeyY, 0xD: pixelgl.KeyU,
+	0x7: pixelgl.KeyF, 0x8: pixelgl.KeyG, 0x9: pixelgl.KeyH, 0xE: pixelgl.KeyJ,
+	0xA: pixelgl.KeyV, 0x0: pixelgl.KeyB, 0xB: pixelgl.KeyN, 0xF: pixelgl.KeyM,
+}
+
+func (d *Display) Keypad() Keypad {
+	var keys Keypad
+	for key, button := range displayKeys {
+		if d.win.Pressed(button) {
+			keys |= 1 << key
+		}
 	}
+	return keys
 }
 
 func (d *Display) Beep() {
@@ -0,0 +1,231 @@
+package chip8
+
+import (
+	"image/color"
+	"image/gif"
+	"testing"
+
+	"github.com/faiface/pixel"
+	"github.com/faiface/pixel/imdraw"
+	"github.com/morinokami/go-chip8/games"
+	"golang.org/x/image/colornames"
+)
+
+func TestDisplay(t *testing.T) {
+
+	t.Run("ModeNormal", func(t *testing.T) {
+		d := NewDisplay()
+		buf := [BufferSize]byte{}
+		buf[3] = 1
+
+		d.fade(&buf)
+
+		if d.intensity[3] != 1 {
+			t.Errorf("got=%v, want=%v", d.intensity[3], 1)
+		}
+		if d.intensity[4] != 0 {
+			t.Errorf("got=%v, want=%v", d.intensity[4], 0)
+		}
+	})
+
+	t.Run("ModePhosphor", func(t *testing.T) {
+		d := NewDisplay()
+		d.SetMode(ModePhosphor)
+		buf := [BufferSize]byte{}
+		buf[3] = 1
+		d.fade(&buf)
+		buf[3] = 0
+
+		d.fade(&buf)
+
+		if d.intensity[3] != PhosphorDecay {
+			t.Errorf("got=%v, want=%v", d.intensity[3], PhosphorDecay)
+		}
+
+		for i := 0; i < 10; i++ {
+			d.fade(&buf)
+		}
+
+		if d.intensity[3] != 0 {
+			t.Errorf("got=%v, want=%v", d.intensity[3], 0)
+		}
+	})
+
+	t.Run("ModeBlend", func(t *testing.T) {
+		d := NewDisplay()
+		d.SetMode(ModeBlend)
+		buf := [BufferSize]byte{}
+		buf[3] = 1
+		d.fade(&buf)
+		buf[3] = 0
+		buf[4] = 1
+
+		d.fade(&buf)
+
+		if d.intensity[3] != 0.5 {
+			t.Errorf("got=%v, want=%v", d.intensity[3], 0.5)
+		}
+		if d.intensity[4] != 0.5 {
+			t.Errorf("got=%v, want=%v", d.intensity[4], 0.5)
+		}
+	})
+
+	t.Run("ParseDisplayMode", func(t *testing.T) {
+		for _, m := range []DisplayMode{ModeNormal, ModePhosphor, ModeBlend, ModeStable} {
+			got, err := ParseDisplayMode(m.String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != m {
+				t.Errorf("got=%v, want=%v", got, m)
+			}
+		}
+		if _, err := ParseDisplayMode("crt"); err == nil {
+			t.Error("Error: expected unknown display mode")
+		}
+	})
+
+	t.Run("viewport", func(t *testing.T) {
+		tests := []struct {
+			bounds pixel.Rect
+			mode   ScaleMode
+			view   pixel.Rect
+			size   pixel.Vec
+		}{
+			{pixel.R(0, 0, 640, 320), ScaleInteger, pixel.R(0, 0, 640, 320), pixel.V(10, 10)},
+			{pixel.R(0, 0, 700, 400), ScaleInteger, pixel.R(30, 40, 670, 360), pixel.V(10, 10)},
+			{pixel.R(0, 0, 700, 400), ScaleFit, pixel.R(0, 25, 700, 375), pixel.V(10.9375, 10.9375)},
+			{pixel.R(0, 0, 700, 400), ScaleStretch, pixel.R(0, 0, 700, 400), pixel.V(10.9375, 12.5)},
+		}
+		for _, tt := range tests {
+			view, size := viewport(tt.bounds, BaseWidth, BaseHeight, tt.mode)
+			if view != tt.view {
+				t.Errorf("%v: got=%v, want=%v", tt.mode, view, tt.view)
+			}
+			if size != tt.size {
+				t.Errorf("%v: got=%v, want=%v", tt.mode, size, tt.size)
+			}
+		}
+	})
+
+	t.Run("stop recording", func(t *testing.T) {
+		d := NewDisplay()
+		w := &nopCloser{}
+		d.recorder = NewGIFRecorder(w, 1, DefaultPalette)
+		buf := [BufferSize]byte{}
+		if err := d.recorder.Record(&buf, FrameRate); err != nil {
+			t.Fatal(err)
+		}
+
+		d.stopRecording()
+
+		if d.recorder != nil {
+			t.Error("Error: recorder not cleared")
+		}
+		if _, err := gif.DecodeAll(w); err != nil {
+			t.Errorf("recording not saved: %v", err)
+		}
+	})
+
+}
+
+// gpuTarget is a pixel.Target that copies what is drawn to it, as a window
+// uploads vertices and textures to the GPU, without needing a GL context.
+type gpuTarget struct {
+	texture []color.RGBA
+}
+
+func (g *gpuTarget) MakeTriangles(t pixel.Triangles) pixel.TargetTriangles {
+	tri := pixel.MakeTrianglesData(t.Len())
+	tri.Update(t)
+	return gpuTriangles{tri}
+}
+
+func (g *gpuTarget) MakePicture(p pixel.Picture) pixel.TargetPicture {
+	if pd, ok := p.(*pixel.PictureData); ok {
+		g.texture = append(g.texture[:0], pd.Pix...)
+	}
+	return gpuPicture{p}
+}
+
+type gpuTriangles struct {
+	*pixel.TrianglesData
+}
+
+func (t gpuTriangles) Draw() {}
+
+type gpuPicture struct {
+	pixel.Picture
+}
+
+func (p gpuPicture) Draw(t pixel.TargetTriangles) {}
+
+// renderIMDraw draws buf as the display did before the screen texture, with
+// a rectangle for every pixel that is on.
+func renderIMDraw(t pixel.Target, buf *[BufferSize]byte) {
+	imd := imdraw.New(nil)
+	for i, b := range buf {
+		if b == 1 {
+			x := float64(i % 64)
+			y := float64(i / 64)
+			imd.Color = colornames.Pink
+			imd.Push(
+				pixel.V(x*ScalingFactor, DisplayHeight-(y*ScalingFactor)),
+				pixel.V((x+1)*ScalingFactor, DisplayHeight-((y+1)*ScalingFactor)),
+			)
+			imd.Rectangle(0)
+		}
+	}
+	imd.Draw(t)
+}
+
+// frameUI is a headless UI that keeps every rendered frame.
+type frameUI struct {
+	*Headless
+	frames []*[BufferSize]byte
+	dirty  []bool
+}
+
+func (u *frameUI) Render(buf *[BufferSize]byte, dirty bool) {
+	screen := *buf
+	u.frames = append(u.frames, &screen)
+	u.dirty = append(u.dirty, dirty)
+}
+
+// BenchmarkRender draws the same frames of BRIX with the rectangles the
+// display used to build every frame, and with the screen texture rebuilt
+// only when the screen changes.
+func BenchmarkRender(b *testing.B) {
+	g, err := games.Find("BRIX")
+	if err != nil {
+		b.Fatal(err)
+	}
+	ui := &frameUI{Headless: NewHeadless()}
+	e := New(ui, WithSeed(1))
+	e.Load(g.Binary)
+	for i := 0; i < 300; i++ {
+		ui.SetKeypad(gameInput(i))
+		if err := e.Frame(); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.Run("imdraw", func(b *testing.B) {
+		t := &gpuTarget{}
+		for i := 0; i < b.N; i++ {
+			renderIMDraw(t, ui.frames[i%len(ui.frames)])
+		}
+	})
+
+	b.Run("texture", func(b *testing.B) {
+		t := &gpuTarget{}
+		d := NewDisplay()
+		m := pixel.IM.Scaled(pixel.ZV, ScalingFactor).Moved(pixel.V(DisplayWidth/2, DisplayHeight/2))
+		for i := 0; i < b.N; i++ {
+			f := i % len(ui.frames)
+			// the first frame of each pass starts over from another screen
+			d.update(ui.frames[f], ui.dirty[f] || f == 0)
+			d.sprite.Draw(t, m)
+		}
+	})
+}
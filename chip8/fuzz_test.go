@@ -0,0 +1,66 @@
+package chip8
+
+import (
+	"testing"
+
+	"github.com/morinokami/go-chip8/games"
+)
+
+// fuzzCycles bounds how long a fuzzed program runs.
+const fuzzCycles = 1000
+
+// checkInvariants runs e for fuzzCycles or until it returns an error, and
+// checks that the machine stays in a valid state after every cycle. Errors
+// are expected for random programs, panics are not.
+func checkInvariants(t *testing.T, e *Emulator, keys Keypad) {
+	for i := 0; i < fuzzCycles; i++ {
+		if err := e.cycle(keys); err != nil {
+			return
+		}
+		if e.pc >= MemorySize {
+			t.Fatalf("cycle %d: pc out of range: 0x%04x", i, e.pc)
+		}
+		if len(e.stack) > StackSize {
+			t.Fatalf("cycle %d: stack too deep: %d", i, len(e.stack))
+		}
+		if i%CyclesPerFrame == CyclesPerFrame-1 {
+			e.tick()
+		}
+	}
+}
+
+func FuzzROM(f *testing.F) {
+	for _, g := range games.Games {
+		f.Add(g.Binary, uint16(0))
+	}
+	f.Fuzz(func(t *testing.T, rom []byte, keys uint16) {
+		e := New(NewHeadless(), WithSeed(1))
+		if err := e.Load(rom); err != nil {
+			if len(rom) <= MaxROMSize {
+				t.Fatal(err)
+			}
+			return
+		}
+		checkInvariants(t, e, Keypad(keys))
+	})
+}
+
+// FuzzState starts from an arbitrary machine state rather than a freshly
+// loaded ROM, so that registers, I and the stack take values a program
+// would need many cycles to reach.
+func FuzzState(f *testing.F) {
+	f.Add([]byte{0xD0, 0x1F, 0xF3, 0x33, 0xFF, 0x55, 0x00, 0xEE}, []byte{}, uint16(0xFFFF), uint16(PCStart), uint8(0))
+	f.Add([]byte{0xB0, 0xFF}, []byte{0xFF}, uint16(0), uint16(MemorySize-1), uint8(StackSize))
+	f.Fuzz(func(t *testing.T, mem, regs []byte, i, pc uint16, depth uint8) {
+		e := New(NewHeadless(), WithSeed(1))
+		e.Load(nil)
+		copy(e.memory[PCStart:], mem)
+		copy(e.vReg[:], regs)
+		e.iReg = i
+		e.pc = pc % MemorySize
+		for d := 0; d < int(depth)%(StackSize+1); d++ {
+			e.stack = append(e.stack, uint16(d*2+PCStart))
+		}
+		checkInvariants(t, e, 0)
+	})
+}
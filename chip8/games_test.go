@@ -0,0 +1,72 @@
+package chip8
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/morinokami/go-chip8/games"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata/golden")
+
+// gameFrames is the number of frames each bundled game is run for.
+const gameFrames = 300
+
+// gameInput is the keypad state on frame i: every key is pressed in turn for
+// 5 frames and released for 5, which gets past the title screens and makes
+// most games move.
+func gameInput(i int) Keypad {
+	if i/5%2 == 1 {
+		return 0
+	}
+	return 1 << (i / 10 % 16)
+}
+
+// TestGames runs every bundled game with a fixed seed and scripted input,
+// and compares the final screen and state hash to
+// testdata/golden/games/<name>.txt. Run it with -update to regenerate them.
+func TestGames(t *testing.T) {
+	for _, g := range games.Games {
+		g := g
+		t.Run(g.Name, func(t *testing.T) {
+			h := NewHeadless()
+			e := New(h, WithSeed(1))
+			e.Load(g.Binary)
+			for i := 0; i < gameFrames; i++ {
+				h.SetKeypad(gameInput(i))
+				if err := e.Frame(); err != nil {
+					t.Fatalf("frame %d: %v", i, err)
+				}
+			}
+
+			screen := e.Screen()
+			got := ASCII(&screen) + "hash: " + e.Hash() + "\n"
+			checkGolden(t, filepath.Join("testdata", "golden", "games", g.Name+".txt"), got)
+		})
+	}
+}
+
+// checkGolden compares got to the contents of path, or overwrites path with
+// got when the test is run with -update.
+func checkGolden(t *testing.T, path, got string) {
+	t.Helper()
+	if *update {
+		if err := ioutil.WriteFile(path, []byte(got), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		t.Fatalf("%s does not exist, run the test with -update to create it", path)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("screen does not match %s:\ngot:\n%s\nwant:\n%s", path, got, want)
+	}
+}
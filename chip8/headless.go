@@ -0,0 +1,43 @@
+package chip8
+
+// Headless is a UI that presents nothing and whose keypad is set
+// programmatically. It is meant for running the emulator from tools and
+// tests.
+type Headless struct {
+	mode DisplayMode
+	keys Keypad
+}
+
+func NewHeadless() *Headless {
+	return &Headless{}
+}
+
+func (h *Headless) SetMode(m DisplayMode) {
+	h.mode = m
+}
+
+func (h *Headless) Mode() DisplayMode {
+	return h.mode
+}
+
+func (h *Headless) Init() {}
+
+func (h *Headless) Run(f func()) {
+	f()
+}
+
+func (h *Headless) Render(buf *[BufferSize]byte, dirty bool) {}
+
+// SetKeypad sets the keypad state reported to the emulator until the next
+// call.
+func (h *Headless) SetKeypad(keys Keypad) {
+	h.keys = keys
+}
+
+func (h *Headless) Keypad() Keypad {
+	return h.keys
+}
+
+func (h *Headless) Beep() {}
+
+func (h *Headless) Close() {}
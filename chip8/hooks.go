@@ -0,0 +1,105 @@
+package chip8
+
+// Hooks are callbacks the emulator runs as it executes a program, for
+// scripts such as bots, HUDs and cheats. They run with the emulator locked
+// and reach the machine through c; they must not call the methods of the
+// emulator. Any of them may be nil.
+type Hooks struct {
+	// Input is called at the start of every frame with the keypad state
+	// read from the UI, and returns the one the frame runs with.
+	Input func(c *Context, keys Keypad) Keypad
+	// Frame is called at the end of every frame.
+	Frame func(c *Context)
+	// At holds functions called before the instruction at their address is
+	// executed. Compiled blocks are not run while there are any.
+	At map[uint16]func(c *Context)
+	// Write is called after the program writes b to memory at addr.
+	Write func(c *Context, addr uint16, b byte)
+	// Poll is called when the program checks whether key is pressed.
+	Poll func(c *Context, key byte)
+}
+
+// WithHooks makes the emulator run the callbacks of h. It may be given
+// several times.
+func WithHooks(h *Hooks) Option {
+	return func(e *Emulator) {
+		e.hooks = append(e.hooks, h)
+		if len(h.At) > 0 {
+			e.hookAt = true
+		}
+	}
+}
+
+// Keys returns the keypad state of the current frame.
+func (c *Context) Keys() Keypad {
+	return c.e.keys
+}
+
+// Frames returns the number of frames run since the ROM was loaded.
+func (c *Context) Frames() uint64 {
+	return c.e.frames
+}
+
+// Read returns the byte of memory at addr.
+func (c *Context) Read(addr uint16) byte {
+	return c.e.memory[addr%MemorySize]
+}
+
+// Write writes b to memory at addr. Write hooks are not called.
+func (c *Context) Write(addr uint16, b byte) {
+	c.e.store(addr, b)
+}
+
+// Print shows s over the screen with its top left corner at x, y, until the
+// next frame starts. Letters, digits and a few symbols are drawn 3
+// pixels wide and 5 high, on a background cleared 1 pixel around them.
+func (c *Context) Print(x, y int, s string) {
+	c.e.overlay = append(c.e.overlay, label{x: x, y: y, s: s})
+}
+
+func (e *Emulator) hookInput(keys Keypad) Keypad {
+	for _, h := range e.hooks {
+		if h.Input != nil {
+			keys = h.Input(&e.ctx, keys)
+		}
+	}
+	return keys
+}
+
+func (e *Emulator) hookFrame() {
+	for _, h := range e.hooks {
+		if h.Frame != nil {
+			h.Frame(&e.ctx)
+		}
+	}
+}
+
+// hookPC runs the hooks at the program counter.
+func (e *Emulator) hookPC() {
+	pc := e.pc
+	for _, h := range e.hooks {
+		if f := h.At[pc]; f != nil {
+			f(&e.ctx)
+		}
+	}
+}
+
+// write is the store of a program write.
+func (e *Emulator) write(addr uint16, b byte) {
+	e.store(addr, b)
+	for _, h := range e.hooks {
+		if h.Write != nil {
+			h.Write(&e.ctx, addr%MemorySize, b)
+		}
+	}
+}
+
+// poll records that the program checked whether key is pressed.
+func (e *Emulator) poll(key byte) {
+	e.stats.Polled |= 1 << key
+	for _, h := range e.hooks {
+		if h.Poll != nil {
+			h.Poll(&e.ctx, key)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package chip8
+
+import (
+	"reflect"
+	"testing"
+)
+
+// screenUI keeps the last rendered screen.
+type screenUI struct {
+	*Headless
+	screen [BufferSize]byte
+}
+
+func (u *screenUI) Render(buf *[BufferSize]byte, dirty bool) {
+	u.screen = *buf
+}
+
+func TestHooks(t *testing.T) {
+	type write struct {
+		addr uint16
+		b    byte
+	}
+	var writes []write
+	var polled []byte
+	var frames []uint64
+	h := &Hooks{
+		// a bot holding key 5
+		Input: func(c *Context, keys Keypad) Keypad {
+			return keys | 1<<5
+		},
+		Frame: func(c *Context) {
+			frames = append(frames, c.Frames())
+			if c.Frames() == 1 {
+				c.Print(0, 0, "1")
+			}
+		},
+		At: map[uint16]func(c *Context){
+			0x206: func(c *Context) {
+				c.V[2] = 7
+				// not seen by the write hook
+				c.Write(0x400, 1)
+			},
+		},
+		Write: func(c *Context, addr uint16, b byte) {
+			writes = append(writes, write{addr, b})
+		},
+		Poll: func(c *Context, key byte) {
+			if !c.Keys().Pressed(key) {
+				t.Errorf("key %d not pressed", key)
+			}
+			polled = append(polled, key)
+		},
+	}
+	ui := &screenUI{Headless: NewHeadless()}
+	e := New(ui, WithHooks(h))
+	// LD V0, 5; SKNP V0; LD V1, 1; LD I, 0x300; LD [I], V2; JP 0x20A
+	e.Load([]byte{0x60, 0x05, 0xE0, 0xA1, 0x61, 0x01, 0xA3, 0x00, 0xF2, 0x55, 0x12, 0x0A})
+
+	if err := e.Frame(); err != nil {
+		t.Fatal(err)
+	}
+	s := e.State()
+	if s.V[1] != 1 || s.V[2] != 7 || s.Memory[0x400] != 1 {
+		t.Errorf("got V1=%d, V2=%d, [0x400]=%d", s.V[1], s.V[2], s.Memory[0x400])
+	}
+	if want := []write{{0x300, 5}, {0x301, 1}, {0x302, 7}}; !reflect.DeepEqual(writes, want) {
+		t.Errorf("writes: got=%v, want=%v", writes, want)
+	}
+	if want := []byte{5}; !reflect.DeepEqual(polled, want) {
+		t.Errorf("polled: got=%v, want=%v", polled, want)
+	}
+	// the middle column of "1" and the cleared pixels around it
+	if ui.screen[1] != 1 || ui.screen[0] != 0 || ui.screen[3+5*BaseWidth] != 0 {
+		t.Error("text not shown")
+	}
+
+	if err := e.Frame(); err != nil {
+		t.Fatal(err)
+	}
+	if ui.screen[1] != 0 {
+		t.Error("text shown after the next frame")
+	}
+	if want := []uint64{1, 2}; !reflect.DeepEqual(frames, want) {
+		t.Errorf("frames: got=%v, want=%v", frames, want)
+	}
+}
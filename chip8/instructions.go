@@ -40,3 +40,18 @@ const (
 	LDVxI                        // Fx65
 	UNKNOWN
 )
+
+var instructionNames = [...]string{
+	"SYS addr", "CLS", "RET", "JP addr", "CALL addr",
+	"SE Vx, byte", "SNE Vx, byte", "SE Vx, Vy", "LD Vx, byte", "ADD Vx, byte",
+	"LD Vx, Vy", "OR Vx, Vy", "AND Vx, Vy", "XOR Vx, Vy", "ADD Vx, Vy",
+	"SUB Vx, Vy", "SHR Vx", "SUBN Vx, Vy", "SHL Vx", "SNE Vx, Vy",
+	"LD I, addr", "JP V0, addr", "RND Vx, byte", "DRW Vx, Vy, n", "SKP Vx",
+	"SKNP Vx", "LD Vx, DT", "LD Vx, K", "LD DT, Vx", "LD ST, Vx",
+	"ADD I, Vx", "LD F, Vx", "LD B, Vx", "LD [I], Vx", "LD Vx, [I]",
+	"UNKNOWN",
+}
+
+func (i Instruction) String() string {
+	return instructionNames[i]
+}
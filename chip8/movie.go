@@ -0,0 +1,124 @@
+package chip8
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sync"
+)
+
+// Settings are the emulator parameters a movie depends on.
+type Settings struct {
+	CyclesPerFrame int    `json:"cycles_per_frame"`
+	Quirks         Quirks `json:"quirks"`
+}
+
+// DefaultSettings returns the settings of an emulator created without
+// WithSettings.
+func DefaultSettings() Settings {
+	return Settings{CyclesPerFrame: CyclesPerFrame}
+}
+
+// Movie is a recording of the keypad input of a session. Replaying it with
+// the same ROM, seed and settings reproduces the session exactly.
+type Movie struct {
+	mu       sync.Mutex
+	Game     string   `json:"game,omitempty"`
+	ROM      string   `json:"rom"`
+	Seed     int64    `json:"seed"`
+	Settings Settings `json:"settings"`
+	// keypad state of each frame
+	Keys []Keypad `json:"keys"`
+	// state hash after the last frame
+	Hash string `json:"hash"`
+}
+
+func NewMovie(game string, rom []byte, seed int64) *Movie {
+	return &Movie{
+		Game:     game,
+		ROM:      ROMHash(rom),
+		Seed:     seed,
+		Settings: DefaultSettings(),
+	}
+}
+
+func LoadMovie(path string) (*Movie, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	m := &Movie{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *Movie) Save(path string) error {
+	m.mu.Lock()
+	data, err := json.Marshal(m)
+	m.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// Check reports whether the movie can be replayed with rom. The emulator
+// replaying it takes its settings from the movie.
+func (m *Movie) Check(rom []byte) error {
+	if h := ROMHash(rom); h != m.ROM {
+		return fmt.Errorf("movie was recorded with a different ROM: %s", m.ROM)
+	}
+	if m.Settings.CyclesPerFrame < 1 {
+		return fmt.Errorf("movie has invalid settings: %+v", m.Settings)
+	}
+	return nil
+}
+
+// Verify reports whether e, after replaying the whole movie, is in the same
+// state as when the movie was recorded.
+func (m *Movie) Verify(e *Emulator) error {
+	if e.Frames() != uint64(len(m.Keys)) {
+		return fmt.Errorf("replayed %d frames, want %d", e.Frames(), len(m.Keys))
+	}
+	if h := e.Hash(); h != m.Hash {
+		return fmt.Errorf("state hash mismatch: got=%s, want=%s", h, m.Hash)
+	}
+	return nil
+}
+
+func (m *Movie) record(keys Keypad, hash string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Keys = append(m.Keys, keys)
+	m.Hash = hash
+}
+
+func ROMHash(rom []byte) string {
+	h := sha256.Sum256(rom)
+	return hex.EncodeToString(h[:])
+}
+
+// Hash returns a hash of the machine state: memory, registers, timers, stack
+// and frame buffer.
+func (e *Emulator) Hash() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.hash()
+}
+
+func (e *Emulator) hash() string {
+	h := sha256.New()
+	h.Write(e.memory[:])
+	h.Write(e.vReg[:])
+	h.Write(e.frameBuffer[:])
+	binary.Write(h, binary.BigEndian, e.iReg)
+	binary.Write(h, binary.BigEndian, e.pc)
+	binary.Write(h, binary.BigEndian, e.stack)
+	h.Write([]byte{e.delayTimer, e.soundTimer})
+	return hex.EncodeToString(h.Sum(nil))
+}
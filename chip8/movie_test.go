@@ -0,0 +1,103 @@
+package chip8
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/morinokami/go-chip8/games"
+)
+
+func TestMovie(t *testing.T) {
+	g, err := games.Find("TETRIS")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	record := func(seed int64) *Movie {
+		m := NewMovie(g.Name, g.Binary, seed)
+		h := NewHeadless()
+		e := New(h, WithSeed(seed), WithInputRecording(m))
+		e.Load(g.Binary)
+		for i := 0; i < 300; i++ {
+			// alternate between moving and rotating
+			h.SetKeypad(Keypad(1 << (4 + i/20%3)))
+			if err := e.Frame(); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return m
+	}
+
+	replay := func(m *Movie, seed int64) *Emulator {
+		e := New(NewHeadless(), WithSeed(seed), WithReplay(m))
+		e.Load(g.Binary)
+		for range m.Keys {
+			if err := e.Frame(); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return e
+	}
+
+	t.Run("replay", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "movie")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "tetris.json")
+		if err := record(42).Save(path); err != nil {
+			t.Fatal(err)
+		}
+
+		m, err := LoadMovie(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := m.Check(g.Binary); err != nil {
+			t.Fatal(err)
+		}
+		e := replay(m, m.Seed)
+
+		if err := m.Verify(e); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("different seed", func(t *testing.T) {
+		m := record(42)
+		e := replay(m, 43)
+
+		if err := m.Verify(e); err == nil {
+			t.Error("Error: replay with a different seed verified")
+		}
+	})
+
+	t.Run("reset while recording", func(t *testing.T) {
+		m := NewMovie(g.Name, g.Binary, 42)
+		e := New(NewHeadless(), WithSeed(42), WithInputRecording(m))
+		e.Load(g.Binary)
+		for i := 0; i < 100; i++ {
+			if i == 50 {
+				e.Reset()
+			}
+			if err := e.Frame(); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if err := m.Verify(replay(m, 42)); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("different ROM", func(t *testing.T) {
+		m := record(42)
+
+		if err := m.Check([]byte{0x00, 0xE0}); err == nil {
+			t.Error("Error: movie accepted a different ROM")
+		}
+	})
+}
@@ -0,0 +1,55 @@
+package chip8
+
+// label is a string printed over the screen by a hook.
+type label struct {
+	x, y int
+	s    string
+}
+
+// glyphs are the characters of the overlay font, 3 pixels wide, one row per
+// byte from the top, with the leftmost pixel in bit 2. Lowercase letters are
+// drawn as uppercase and other characters as blanks.
+var glyphs = map[rune][5]byte{
+	'0': {7, 5, 5, 5, 7}, '1': {2, 6, 2, 2, 7}, '2': {7, 1, 7, 4, 7},
+	'3': {7, 1, 3, 1, 7}, '4': {5, 5, 7, 1, 1}, '5': {7, 4, 7, 1, 7},
+	'6': {7, 4, 7, 5, 7}, '7': {7, 1, 1, 2, 2}, '8': {7, 5, 7, 5, 7},
+	'9': {7, 5, 7, 1, 7}, 'A': {2, 5, 7, 5, 5}, 'B': {6, 5, 6, 5, 6},
+	'C': {3, 4, 4, 4, 3}, 'D': {6, 5, 5, 5, 6}, 'E': {7, 4, 6, 4, 7},
+	'F': {7, 4, 6, 4, 4}, 'G': {3, 4, 5, 5, 3}, 'H': {5, 5, 7, 5, 5},
+	'I': {7, 2, 2, 2, 7}, 'J': {1, 1, 1, 5, 2}, 'K': {5, 5, 6, 5, 5},
+	'L': {4, 4, 4, 4, 7}, 'M': {5, 7, 7, 5, 5}, 'N': {6, 5, 5, 5, 5},
+	'O': {2, 5, 5, 5, 2}, 'P': {6, 5, 6, 4, 4}, 'Q': {2, 5, 5, 6, 3},
+	'R': {6, 5, 6, 5, 5}, 'S': {3, 4, 2, 1, 6}, 'T': {7, 2, 2, 2, 2},
+	'U': {5, 5, 5, 5, 7}, 'V': {5, 5, 5, 5, 2}, 'W': {5, 5, 7, 7, 5},
+	'X': {5, 5, 2, 5, 5}, 'Y': {5, 5, 2, 2, 2}, 'Z': {7, 1, 2, 4, 7},
+	':': {0, 2, 0, 2, 0}, '.': {0, 0, 0, 0, 2}, '-': {0, 0, 7, 0, 0},
+	'+': {0, 2, 7, 2, 0}, '=': {0, 7, 0, 7, 0}, '/': {1, 1, 2, 4, 4},
+	'!': {2, 2, 2, 0, 2}, '?': {7, 1, 2, 0, 2}, '%': {5, 1, 2, 4, 5},
+}
+
+// drawLabel draws t into buf, clipped to the screen.
+func drawLabel(buf *[BufferSize]byte, t label) {
+	set := func(x, y int, on bool) {
+		if x < 0 || x >= BaseWidth || y < 0 || y >= BaseHeight {
+			return
+		}
+		buf[x+y*BaseWidth] = 0
+		if on {
+			buf[x+y*BaseWidth] = 1
+		}
+	}
+	x := t.x
+	for _, r := range t.s {
+		if r >= 'a' && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		g := glyphs[r]
+		for dy := -1; dy < 6; dy++ {
+			for dx := -1; dx < 4; dx++ {
+				on := dy >= 0 && dy < 5 && dx >= 0 && dx < 3 && g[dy]&(4>>dx) != 0
+				set(x+dx, t.y+dy, on)
+			}
+		}
+		x += 4
+	}
+}
@@ -0,0 +1,107 @@
+package chip8
+
+// Program is a ROM compiled ahead of time to Go by go-chip8 recompile. Blocks
+// holds the compiled basic blocks of the ROM by start address; everything
+// else, such as indirect jumps and code the ROM writes at run time, is left
+// to the interpreter.
+type Program struct {
+	ROM    string
+	Blocks map[uint16]*Block
+}
+
+// Block is a compiled basic block. Run executes its instructions in one go
+// and sets the program counter to the next instruction.
+type Block struct {
+	Insts []Instruction
+	Run   func(c *Context)
+}
+
+// size returns the number of bytes of memory the block was compiled from.
+func (b *Block) size() int {
+	return 2 * len(b.Insts)
+}
+
+// Context gives compiled blocks access to the machine state.
+type Context struct {
+	V  *[VRegisterSize]byte
+	I  *uint16
+	PC *uint16
+	DT *byte
+	ST *byte
+
+	e    *Emulator
+	keys Keypad
+}
+
+// Exec runs the instruction at addr with the interpreter. Compiled blocks use
+// it for instructions that are not worth inlining.
+func (c *Context) Exec(addr uint16) {
+	// compiled blocks only contain instructions that cannot fail
+	c.e.exec(c.e.opAt(addr), c.keys)
+}
+
+// Pressed reports whether key is held in the current frame.
+func (c *Context) Pressed(key byte) bool {
+	c.e.poll(key)
+	return c.keys.Pressed(key)
+}
+
+// WithProgram makes the emulator run the compiled blocks of p instead of
+// interpreting them, whenever the loaded ROM is the one p was compiled from.
+func WithProgram(p *Program) Option {
+	return func(e *Emulator) {
+		e.program = p
+	}
+}
+
+// loadProgram enables the blocks of the program if it was compiled from rom.
+func (e *Emulator) loadProgram(rom []byte) {
+	e.blocks = nil
+	if e.program == nil || e.program.ROM != ROMHash(rom) {
+		return
+	}
+	e.blocks = &[MemorySize]*Block{}
+	for addr, b := range e.program.Blocks {
+		e.blocks[addr%MemorySize] = b
+	}
+}
+
+// blockAt returns the compiled block starting at the program counter if it
+// is still valid and fits in budget instructions.
+func (e *Emulator) blockAt(budget int) *Block {
+	if e.blocks == nil || e.trace != nil || len(e.breakpoints) > 0 || e.hookAt ||
+		e.settings.Quirks != (Quirks{}) {
+		return nil
+	}
+	b := e.blocks[e.pc]
+	if b == nil || len(b.Insts) > budget {
+		return nil
+	}
+	return b
+}
+
+// runBlock runs b and counts its instructions.
+func (e *Emulator) runBlock(b *Block, keys Keypad) {
+	e.ctx.keys = keys
+	b.Run(&e.ctx)
+	e.stats.Instructions += uint64(len(b.Insts))
+	for _, inst := range b.Insts {
+		e.stats.Histogram[inst]++
+	}
+}
+
+// invalidateBlocks disables the compiled blocks that include addr, so that
+// code written at run time is interpreted.
+func (e *Emulator) invalidateBlocks(addr uint16) {
+	if e.blocks == nil {
+		return
+	}
+	for a := int(addr) - 2*CyclesPerFrame + 1; a <= int(addr); a++ {
+		if a < 0 {
+			continue
+		}
+		if b := e.blocks[a]; b != nil && int(addr) < a+b.size() {
+			e.blocks[a] = nil
+		}
+	}
+}
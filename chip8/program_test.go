@@ -0,0 +1,90 @@
+package chip8
+
+import "testing"
+
+func TestProgram(t *testing.T) {
+	// LD V1, 0x01; LD V2, 0x02; JP 0x200
+	rom := []byte{0x61, 0x01, 0x62, 0x02, 0x12, 0x00}
+	var runs int
+	p := &Program{
+		ROM: ROMHash(rom),
+		Blocks: map[uint16]*Block{
+			0x200: {Insts: []Instruction{LDVxByte, LDVxByte, JPAddr}, Run: func(c *Context) {
+				runs++
+				c.V[0x1] = 0x01
+				c.V[0x2] = 0x02
+				*c.PC = 0x200
+			}},
+		},
+	}
+
+	t.Run("run", func(t *testing.T) {
+		e := New(NewHeadless(), WithProgram(p))
+		e.Load(rom)
+		runs = 0
+		if err := e.Frame(); err != nil {
+			t.Fatal(err)
+		}
+		// three blocks fit in a frame, the last instruction is interpreted
+		if runs != CyclesPerFrame/3 {
+			t.Errorf("got=%d, want=%d", runs, CyclesPerFrame/3)
+		}
+		if s := e.Stats(); s.Instructions != uint64(CyclesPerFrame) || s.Histogram[JPAddr] != 3 {
+			t.Errorf("got=%d instructions, %d JP, want=%d, %d", s.Instructions, s.Histogram[JPAddr], CyclesPerFrame, 3)
+		}
+	})
+
+	t.Run("other ROM", func(t *testing.T) {
+		e := New(NewHeadless(), WithProgram(p))
+		e.Load([]byte{0x61, 0x01, 0x62, 0x03, 0x12, 0x00})
+		runs = 0
+		e.Frame()
+		if runs != 0 {
+			t.Errorf("got=%d, want=%d", runs, 0)
+		}
+	})
+
+	t.Run("self-modifying code", func(t *testing.T) {
+		e := New(NewHeadless(), WithProgram(p))
+		e.Load(rom)
+		// overwrite the operand of LD V2, 0x02
+		e.iReg = 0x203
+		e.vReg[0] = 0x07
+		e.execute(0xF055, 0)
+		e.pc = PCStart
+		runs = 0
+		e.Frame()
+		if runs != 0 {
+			t.Errorf("got=%d, want=%d", runs, 0)
+		}
+		if e.vReg[2] != 0x07 {
+			t.Errorf("got=0x%02x, want=0x%02x", e.vReg[2], 0x07)
+		}
+	})
+}
+
+func TestRecompile(t *testing.T) {
+	// LD V1, 0x01; SE V1, 0x01; CALL 0x20a; JP 0x200; RET
+	rom := []byte{0x61, 0x01, 0x31, 0x01, 0x22, 0x0a, 0x12, 0x00, 0x00, 0x00, 0x00, 0xEE}
+	r := &recompiler{rom: rom}
+	r.analyze()
+
+	var starts []uint16
+	for _, b := range r.blocks() {
+		starts = append(starts, b.start)
+	}
+	// the block at 0x204 would start with CALL and RET at 0x20a is left to the
+	// interpreter; 0x208 is never reached
+	want := []uint16{0x200, 0x206}
+	if len(starts) != len(want) || starts[0] != want[0] || starts[1] != want[1] {
+		t.Errorf("got=%x, want=%x", starts, want)
+	}
+
+	src, err := Recompile(rom, "test", "test.ch8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(src) == 0 {
+		t.Error("no source generated")
+	}
+}
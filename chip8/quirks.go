@@ -0,0 +1,92 @@
+package chip8
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Quirks select between the behaviors of CHIP-8 interpreters for the
+// instructions they disagree on. The zero value is the behavior of this
+// emulator, which follows Cowgod's reference; programs written for other
+// interpreters, such as Octo, may need some of them set.
+type Quirks struct {
+	// ShiftVy makes 8xy6 and 8xyE shift Vy into Vx rather than Vx in place.
+	ShiftVy bool `json:"shift_vy,omitempty"`
+	// IncrementI makes Fx55 and Fx65 leave I past the last register.
+	IncrementI bool `json:"increment_i,omitempty"`
+	// FlagLast makes 8xy4 to 8xyE compute Vx before setting VF, so that the
+	// flag wins when x is F.
+	FlagLast bool `json:"flag_last,omitempty"`
+	// ResetVF makes 8xy1, 8xy2 and 8xy3 set VF to 0.
+	ResetVF bool `json:"reset_vf,omitempty"`
+	// JumpVx makes Bxnn jump to xnn + Vx rather than nnn + V0.
+	JumpVx bool `json:"jump_vx,omitempty"`
+	// WrapOrigin draws sprites at their coordinates modulo the screen size.
+	WrapOrigin bool `json:"wrap_origin,omitempty"`
+	// WrapPixels draws the pixels of a sprite past an edge of the screen at
+	// the opposite edge rather than clipping them.
+	WrapPixels bool `json:"wrap_pixels,omitempty"`
+	// VBlank makes Dxyn end the frame, so that at most one sprite is drawn
+	// per frame as on the COSMAC VIP.
+	VBlank bool `json:"vblank,omitempty"`
+}
+
+// WithSettings makes the emulator run s.CyclesPerFrame instructions per
+// frame, or CyclesPerFrame if it is 0, with the quirks s.Quirks. A movie
+// replayed with WithReplay overrides them with its own settings.
+func WithSettings(s Settings) Option {
+	return func(e *Emulator) {
+		if s.CyclesPerFrame < 1 {
+			s.CyclesPerFrame = CyclesPerFrame
+		}
+		e.settings = s
+	}
+}
+
+// setFlagLast sets Vx to v and then VF to flag, as 8xy4 to 8xyE do with the
+// FlagLast quirk. Without it they set VF first and then compute Vx, which
+// may read the new VF when x is F.
+func (e *Emulator) setFlagLast(x uint16, v byte, flag bool) {
+	e.vReg[x] = v
+	e.vReg[0xF] = 0
+	if flag {
+		e.vReg[0xF] = 1
+	}
+}
+
+// SettingsSize is the size of settings encoded by MarshalBinary.
+const SettingsSize = 3
+
+// flags returns the fields of q in order.
+func (q *Quirks) flags() []*bool {
+	return []*bool{&q.ShiftVy, &q.IncrementI, &q.FlagLast, &q.ResetVF, &q.JumpVx, &q.WrapOrigin, &q.WrapPixels, &q.VBlank}
+}
+
+// MarshalBinary encodes s as the number of instructions per frame, a
+// big-endian uint16, followed by a byte with a bit per quirk, from the
+// lowest in field order.
+func (s Settings) MarshalBinary() ([]byte, error) {
+	if s.CyclesPerFrame < 0 || s.CyclesPerFrame > 0xFFFF {
+		return nil, fmt.Errorf("invalid instructions per frame: %d", s.CyclesPerFrame)
+	}
+	data := []byte{byte(s.CyclesPerFrame >> 8), byte(s.CyclesPerFrame), 0}
+	for i, f := range s.Quirks.flags() {
+		if *f {
+			data[2] |= 1 << i
+		}
+	}
+	return data, nil
+}
+
+// UnmarshalBinary decodes settings encoded by MarshalBinary.
+func (s *Settings) UnmarshalBinary(data []byte) error {
+	if len(data) != SettingsSize {
+		return errors.New("invalid settings")
+	}
+	s.CyclesPerFrame = int(data[0])<<8 | int(data[1])
+	s.Quirks = Quirks{}
+	for i, f := range s.Quirks.flags() {
+		*f = data[2]&(1<<i) != 0
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package chip8
+
+import "testing"
+
+func TestQuirks(t *testing.T) {
+	tests := []struct {
+		name   string
+		quirks Quirks
+		setup  func(e *Emulator)
+		opcode uint16
+		check  func(e *Emulator) bool
+	}{
+		{"8xy6 in place", Quirks{}, func(e *Emulator) { e.vReg[1], e.vReg[2] = 0x03, 0x10 }, 0x8126,
+			func(e *Emulator) bool { return e.vReg[1] == 0x01 && e.vReg[0xF] == 1 }},
+		{"8xy6 ShiftVy", Quirks{ShiftVy: true}, func(e *Emulator) { e.vReg[1], e.vReg[2] = 0x03, 0x10 }, 0x8126,
+			func(e *Emulator) bool { return e.vReg[1] == 0x08 && e.vReg[0xF] == 0 }},
+		{"8xyE ShiftVy", Quirks{ShiftVy: true}, func(e *Emulator) { e.vReg[1], e.vReg[2] = 0x01, 0x81 }, 0x812E,
+			func(e *Emulator) bool { return e.vReg[1] == 0x02 && e.vReg[0xF] == 1 }},
+		{"Fx55 IncrementI", Quirks{IncrementI: true}, func(e *Emulator) { e.iReg = 0x300 }, 0xF255,
+			func(e *Emulator) bool { return e.iReg == 0x303 }},
+		{"Fx65 IncrementI", Quirks{IncrementI: true}, func(e *Emulator) { e.iReg = 0x300 }, 0xF065,
+			func(e *Emulator) bool { return e.iReg == 0x301 }},
+		{"8xy4 flag first", Quirks{}, func(e *Emulator) { e.vReg[0xF], e.vReg[1] = 0x01, 0x02 }, 0x8F14,
+			func(e *Emulator) bool { return e.vReg[0xF] == 0x03 }},
+		{"8xy4 FlagLast", Quirks{FlagLast: true}, func(e *Emulator) { e.vReg[0xF], e.vReg[1] = 0x01, 0x02 }, 0x8F14,
+			func(e *Emulator) bool { return e.vReg[0xF] == 0 }},
+		{"8xy5 FlagLast", Quirks{FlagLast: true}, func(e *Emulator) { e.vReg[0xF], e.vReg[1] = 0x05, 0x02 }, 0x8F15,
+			func(e *Emulator) bool { return e.vReg[0xF] == 1 }},
+		{"8xy7 FlagLast", Quirks{FlagLast: true}, func(e *Emulator) { e.vReg[0xF], e.vReg[1] = 0x05, 0x02 }, 0x8F17,
+			func(e *Emulator) bool { return e.vReg[0xF] == 0 }},
+		{"8xy1 ResetVF", Quirks{ResetVF: true}, func(e *Emulator) { e.vReg[0xF], e.vReg[1] = 1, 0x0F }, 0x8121,
+			func(e *Emulator) bool { return e.vReg[0xF] == 0 && e.vReg[1] == 0x0F }},
+		{"Bnnn", Quirks{}, func(e *Emulator) { e.vReg[0], e.vReg[2] = 1, 2 }, 0xB240,
+			func(e *Emulator) bool { return e.pc == 0x241 }},
+		{"Bxnn JumpVx", Quirks{JumpVx: true}, func(e *Emulator) { e.vReg[0], e.vReg[2] = 1, 2 }, 0xB240,
+			func(e *Emulator) bool { return e.pc == 0x242 }},
+		{"Dxyn clip", Quirks{}, func(e *Emulator) { e.vReg[1], e.vReg[2], e.iReg = 62, 31, 0x300 }, 0xD122,
+			func(e *Emulator) bool { return e.filled(63, 31) && !e.filled(0, 31) && !e.filled(62, 0) }},
+		{"Dxyn WrapPixels", Quirks{WrapPixels: true}, func(e *Emulator) { e.vReg[1], e.vReg[2], e.iReg = 62, 31, 0x300 }, 0xD122,
+			func(e *Emulator) bool { return e.filled(63, 31) && e.filled(0, 31) && e.filled(62, 0) }},
+		{"Dxyn origin", Quirks{}, func(e *Emulator) { e.vReg[1], e.vReg[2], e.iReg = 65, 33, 0x300 }, 0xD121,
+			func(e *Emulator) bool { return !e.filled(1, 1) }},
+		{"Dxyn WrapOrigin", Quirks{WrapOrigin: true}, func(e *Emulator) { e.vReg[1], e.vReg[2], e.iReg = 65, 33, 0x300 }, 0xD121,
+			func(e *Emulator) bool { return e.filled(1, 1) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := New(NewHeadless(), WithSettings(Settings{Quirks: tt.quirks}))
+			e.memory[0x300], e.memory[0x301] = 0xFF, 0xFF
+			tt.setup(e)
+			e.execute(tt.opcode, 0)
+			if !tt.check(e) {
+				t.Errorf("V=%v, I=0x%03x, PC=0x%03x", e.vReg, e.iReg, e.pc)
+			}
+		})
+	}
+}
+
+// TestDefaultFlags pins the results of 8xy4 to 8xyE with x = F under the
+// default settings, where VF is set before Vx is computed from it.
+func TestDefaultFlags(t *testing.T) {
+	tests := []struct {
+		opcode uint16
+		vf, v1 byte
+		want   byte
+	}{
+		{0x8F14, 0x01, 0x02, 0x03},
+		{0x8F14, 0xFF, 0x02, 0x01},
+		{0x8F15, 0x05, 0x02, 0xFF},
+		{0x8F15, 0x02, 0x05, 0xFB},
+		{0x8F16, 0x03, 0x00, 0x00},
+		{0x8F17, 0x02, 0x05, 0x04},
+		{0x8F17, 0x05, 0x02, 0x02},
+		{0x8F1E, 0x81, 0x00, 0x02},
+	}
+	for _, tt := range tests {
+		e := New(NewHeadless())
+		e.vReg[0xF], e.vReg[1] = tt.vf, tt.v1
+		e.execute(tt.opcode, 0)
+		if e.vReg[0xF] != tt.want {
+			t.Errorf("0x%04x with VF=0x%02x, V1=0x%02x: got=0x%02x, want=0x%02x", tt.opcode, tt.vf, tt.v1, e.vReg[0xF], tt.want)
+		}
+	}
+}
+
+func TestSettings(t *testing.T) {
+	rom := []byte{0x70, 0x01, 0x12, 0x00} // V0 += 1, loop
+
+	e := New(NewHeadless(), WithSettings(Settings{CyclesPerFrame: 30}))
+	if err := e.Load(rom); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Frame(); err != nil {
+		t.Fatal(err)
+	}
+	if got := e.State().V[0]; got != 15 {
+		t.Errorf("V0 after a frame of 30 instructions: got=%d, want=15", got)
+	}
+
+	// only one sprite is drawn per frame with VBlank
+	rom = []byte{0xD0, 0x01, 0x70, 0x01, 0x12, 0x00}
+	e = New(NewHeadless(), WithSettings(Settings{Quirks: Quirks{VBlank: true}}))
+	if err := e.Load(rom); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if err := e.Frame(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := e.State().V[0]; got != 2 {
+		t.Errorf("V0 after 3 frames: got=%d, want=2", got)
+	}
+
+	// a replay takes the settings of the movie
+	m := NewMovie("", rom, 1)
+	New(NewHeadless(), WithSettings(Settings{CyclesPerFrame: 7}), WithInputRecording(m))
+	if m.Settings.CyclesPerFrame != 7 {
+		t.Errorf("recorded settings: got=%+v", m.Settings)
+	}
+	e = New(NewHeadless(), WithReplay(m))
+	if e.settings.CyclesPerFrame != 7 {
+		t.Errorf("replayed settings: got=%+v", e.settings)
+	}
+}
+
+func TestSettingsBinary(t *testing.T) {
+	for _, s := range []Settings{
+		DefaultSettings(),
+		{CyclesPerFrame: 1000, Quirks: Quirks{ShiftVy: true, FlagLast: true, WrapPixels: true, VBlank: true}},
+	} {
+		data, err := s.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got Settings
+		if err := got.UnmarshalBinary(data); err != nil || got != s {
+			t.Errorf("got=%+v, %v, want=%+v", got, err, s)
+		}
+	}
+	if _, err := (Settings{CyclesPerFrame: 1 << 16}).MarshalBinary(); err == nil {
+		t.Error("no error for 65536 instructions per frame")
+	}
+}
@@ -0,0 +1,42 @@
+package chip8
+
+import "math/rand"
+
+// maxDrawn is the most values drawn from the random number generator since
+// it was seeded. Restoring a state draws as many values again, so past it
+// the generator is seeded again with a value drawn from it.
+const maxDrawn = 1 << 20
+
+// source is the source of the random number generator used by RND. It
+// counts the values drawn from it, so that the state of the generator can be
+// saved as its seed and a count, and restored by drawing as many values
+// again.
+type source struct {
+	src   rand.Source
+	seed  int64
+	drawn uint64
+}
+
+func (s *source) Int63() int64 {
+	if s.drawn == maxDrawn {
+		s.Seed(s.src.Int63())
+	}
+	s.drawn++
+	return s.src.Int63()
+}
+
+func (s *source) Seed(seed int64) {
+	s.src.Seed(seed)
+	s.seed = seed
+	s.drawn = 0
+}
+
+// reseed resets the random number generator to its state after drawn values
+// were drawn since it was seeded with e.seed.
+func (e *Emulator) reseed(drawn uint64) {
+	e.src = &source{src: rand.NewSource(e.seed), seed: e.seed}
+	for e.src.drawn < drawn {
+		e.src.Int63()
+	}
+	e.rng = rand.New(e.src)
+}
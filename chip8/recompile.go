@@ -0,0 +1,253 @@
+package chip8
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+)
+
+// Recompile translates rom to the source of a Go package named pkg, which
+// declares the compiled Program for WithProgram.
+//
+// The control flow of rom is followed from PCStart through jumps, calls and
+// skips, and every basic block found is compiled to a Go function. Blocks end
+// at jumps, skips and writes to memory, and before instructions that are left
+// to the interpreter: CALL, RET, JP V0, LD Vx, K and anything that may fail.
+// Blocks are at most CyclesPerFrame instructions long.
+func Recompile(rom []byte, pkg string, source string) ([]byte, error) {
+	if len(rom) > MaxROMSize {
+		return nil, fmt.Errorf("ROM is too large: %d bytes, max %d", len(rom), MaxROMSize)
+	}
+	r := &recompiler{rom: rom}
+	r.analyze()
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by go-chip8 recompile from %s; DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	fmt.Fprintf(&buf, "import \"github.com/morinokami/go-chip8/chip8\"\n\n")
+	fmt.Fprintf(&buf, "var Program = &chip8.Program{\n")
+	fmt.Fprintf(&buf, "ROM: %q,\n", ROMHash(rom))
+	fmt.Fprintf(&buf, "Blocks: map[uint16]*chip8.Block{\n")
+	blocks := r.blocks()
+	for _, b := range blocks {
+		fmt.Fprintf(&buf, "0x%03x: {Insts: []chip8.Instruction{", b.start)
+		for i, op := range b.ops {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			fmt.Fprintf(&buf, "chip8.%s", instructionIdents[op.inst])
+		}
+		fmt.Fprintf(&buf, "}, Run: block%03x},\n", b.start)
+	}
+	fmt.Fprintf(&buf, "},\n}\n")
+
+	for _, b := range blocks {
+		fmt.Fprintf(&buf, "\nfunc block%03x(c *chip8.Context) {\n", b.start)
+		addr := b.start
+		for _, op := range b.ops {
+			fmt.Fprintf(&buf, "// 0x%03x: %s\n", addr, describe(op))
+			buf.WriteString(compileOp(addr, op))
+			addr += 2
+		}
+		if !b.terminated {
+			fmt.Fprintf(&buf, "*c.PC = 0x%03x\n", addr%MemorySize)
+		}
+		buf.WriteString("}\n")
+	}
+	return format.Source(buf.Bytes())
+}
+
+type recompiler struct {
+	rom     []byte
+	reached map[uint16]bool
+	leaders map[uint16]bool
+}
+
+type block struct {
+	start      uint16
+	ops        []op
+	terminated bool
+}
+
+func (r *recompiler) opAt(addr uint16) (op, bool) {
+	i := int(addr) - PCStart
+	if i < 0 || i+1 >= len(r.rom) {
+		return op{}, false
+	}
+	e := &Emulator{}
+	return e.decodeOp(uint16(r.rom[i])<<8 | uint16(r.rom[i+1])), true
+}
+
+// analyze finds the instructions reachable from PCStart, and the leaders:
+// the addresses that start a basic block.
+func (r *recompiler) analyze() {
+	r.reached = map[uint16]bool{}
+	r.leaders = map[uint16]bool{PCStart: true}
+	work := []uint16{PCStart}
+	for len(work) > 0 {
+		addr := work[len(work)-1]
+		work = work[:len(work)-1]
+		if r.reached[addr] {
+			continue
+		}
+		op, ok := r.opAt(addr)
+		if !ok {
+			continue
+		}
+		r.reached[addr] = true
+
+		next := (addr + 2) % MemorySize
+		var succs []uint16
+		switch op.inst {
+		case JPAddr:
+			succs = []uint16{op.nnn}
+			r.leaders[op.nnn] = true
+		case CALL:
+			succs = []uint16{op.nnn, next}
+			r.leaders[op.nnn] = true
+			r.leaders[next] = true
+		case RET, JPV0Addr, SYS, UNKNOWN:
+		case SEVxByte, SNEVxByte, SEVxVy, SNEVxVy, SKP, SKNP:
+			skip := (addr + 4) % MemorySize
+			succs = []uint16{next, skip}
+			r.leaders[next] = true
+			r.leaders[skip] = true
+		case LDVxK, LDBVx, LDIVx:
+			succs = []uint16{next}
+			r.leaders[next] = true
+		default:
+			succs = []uint16{next}
+		}
+		work = append(work, succs...)
+	}
+}
+
+// blocks returns the compiled blocks, ordered by address.
+func (r *recompiler) blocks() []block {
+	var starts []int
+	for addr := range r.leaders {
+		if r.reached[addr] {
+			starts = append(starts, int(addr))
+		}
+	}
+	sort.Ints(starts)
+
+	var blocks []block
+	for _, start := range starts {
+		b := block{start: uint16(start)}
+		for addr := uint16(start); len(b.ops) < CyclesPerFrame; addr += 2 {
+			if addr != b.start && r.leaders[addr] {
+				break
+			}
+			op, ok := r.opAt(addr)
+			if !ok || !compilable(op.inst) {
+				break
+			}
+			b.ops = append(b.ops, op)
+			if terminates(op.inst) {
+				// jumps and skips set the program counter themselves, while
+				// after a write to memory the interpreter has to check that
+				// the code that follows is unchanged
+				b.terminated = op.inst != LDBVx && op.inst != LDIVx
+				break
+			}
+		}
+		if len(b.ops) > 0 {
+			blocks = append(blocks, b)
+		}
+	}
+	return blocks
+}
+
+// compilable reports whether inst can be part of a compiled block. The
+// others change the stack, jump to computed addresses, wait or may fail, and
+// are left to the interpreter.
+func compilable(inst Instruction) bool {
+	switch inst {
+	case SYS, RET, CALL, JPV0Addr, LDVxK, UNKNOWN:
+		return false
+	}
+	return true
+}
+
+// terminates reports whether inst ends a block.
+func terminates(inst Instruction) bool {
+	switch inst {
+	case JPAddr, SEVxByte, SNEVxByte, SEVxVy, SNEVxVy, SKP, SKNP, LDBVx, LDIVx:
+		return true
+	}
+	return false
+}
+
+// compileOp returns the Go code for op at addr. Instructions whose behavior
+// goes beyond plain assignments are run by the interpreter.
+func compileOp(addr uint16, op op) string {
+	next := (addr + 2) % MemorySize
+	skip := (addr + 4) % MemorySize
+	branch := func(cond string) string {
+		return fmt.Sprintf("if %s {\n*c.PC = 0x%03x\nreturn\n}\n*c.PC = 0x%03x\n", cond, skip, next)
+	}
+
+	switch op.inst {
+	case JPAddr:
+		return fmt.Sprintf("*c.PC = 0x%03x\n", op.nnn)
+	case SEVxByte:
+		return branch(fmt.Sprintf("c.V[0x%x] == 0x%02x", op.x, op.kk))
+	case SNEVxByte:
+		return branch(fmt.Sprintf("c.V[0x%x] != 0x%02x", op.x, op.kk))
+	case SEVxVy:
+		return branch(fmt.Sprintf("c.V[0x%x] == c.V[0x%x]", op.x, op.y))
+	case SNEVxVy:
+		return branch(fmt.Sprintf("c.V[0x%x] != c.V[0x%x]", op.x, op.y))
+	case SKP:
+		return branch(fmt.Sprintf("c.Pressed(c.V[0x%x])", op.x))
+	case SKNP:
+		return branch(fmt.Sprintf("!c.Pressed(c.V[0x%x])", op.x))
+	case LDVxByte:
+		return fmt.Sprintf("c.V[0x%x] = 0x%02x\n", op.x, op.kk)
+	case ADDVxByte:
+		return fmt.Sprintf("c.V[0x%x] += 0x%02x\n", op.x, op.kk)
+	case LDVxVy:
+		return fmt.Sprintf("c.V[0x%x] = c.V[0x%x]\n", op.x, op.y)
+	case OR:
+		return fmt.Sprintf("c.V[0x%x] |= c.V[0x%x]\n", op.x, op.y)
+	case AND:
+		return fmt.Sprintf("c.V[0x%x] &= c.V[0x%x]\n", op.x, op.y)
+	case XOR:
+		return fmt.Sprintf("c.V[0x%x] ^= c.V[0x%x]\n", op.x, op.y)
+	case LDIAddr:
+		return fmt.Sprintf("*c.I = 0x%03x\n", op.nnn)
+	case LDVxDT:
+		return fmt.Sprintf("c.V[0x%x] = *c.DT\n", op.x)
+	case LDDTVx:
+		return fmt.Sprintf("*c.DT = c.V[0x%x]\n", op.x)
+	case LDSTVx:
+		return fmt.Sprintf("*c.ST = c.V[0x%x]\n", op.x)
+	case ADDIVx:
+		return fmt.Sprintf("*c.I += uint16(c.V[0x%x])\n", op.x)
+	case LDFVx:
+		return fmt.Sprintf("*c.I = uint16(c.V[0x%x] * 5)\n", op.x)
+	}
+	return fmt.Sprintf("c.Exec(0x%03x)\n", addr)
+}
+
+// describe returns the assembly of op for comments in generated code.
+func describe(op op) string {
+	var buf bytes.Buffer
+	e := &Emulator{trace: &buf}
+	e.descOpcode(&op)
+	return string(bytes.TrimSpace(buf.Bytes()))
+}
+
+// instructionIdents are the names of the Instruction constants.
+var instructionIdents = [...]string{
+	"SYS", "CLS", "RET", "JPAddr", "CALL",
+	"SEVxByte", "SNEVxByte", "SEVxVy", "LDVxByte", "ADDVxByte",
+	"LDVxVy", "OR", "AND", "XOR", "ADDVxVy",
+	"SUB", "SHR", "SUBN", "SHL", "SNEVxVy",
+	"LDIAddr", "JPV0Addr", "RND", "DRW", "SKP",
+	"SKNP", "LDVxDT", "LDVxK", "LDDTVx", "LDSTVx",
+	"ADDIVx", "LDFVx", "LDBVx", "LDIVx", "LDVxI",
+	"UNKNOWN",
+}
@@ -0,0 +1,218 @@
+package chip8
+
+import (
+	"bufio"
+	"fmt"
+	"image/color"
+	"image/gif"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Recorder receives the frames presented by the emulator.
+type Recorder interface {
+	// Record adds a frame that is shown for d.
+	Record(buf *[BufferSize]byte, d time.Duration) error
+	Close() error
+}
+
+// NewRecorder creates a recorder writing to path. The format is chosen by
+// the extension: .gif for an animated GIF, .y4m for a YUV4MPEG2 stream and
+// .rgb for raw 24-bit RGB frames.
+func NewRecorder(path string, scale int, p Palette) (Recorder, error) {
+	ext := filepath.Ext(path)
+	if ext != ".gif" && ext != ".y4m" && ext != ".rgb" {
+		return nil, fmt.Errorf("unknown recording format: %s", ext)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	switch ext {
+	case ".gif":
+		return NewGIFRecorder(f, scale, p), nil
+	case ".y4m":
+		return NewY4MRecorder(f, scale, p), nil
+	default:
+		return NewRGBRecorder(f, scale, p), nil
+	}
+}
+
+// minGIFDelay is the shortest frame delay, in 1/100s, that viewers honor.
+// Frames with shorter delays are commonly slowed down to 1/10s.
+const minGIFDelay = 2
+
+// GIFRecorder records frames into an animated GIF, which is written when the
+// recorder is closed. Consecutive identical frames are merged into one, and
+// frame delays are derived from the total elapsed time so that rounding to
+// 1/100s does not accumulate.
+type GIFRecorder struct {
+	mu      sync.Mutex
+	w       io.WriteCloser
+	scale   int
+	palette Palette
+	frames  [][BufferSize]byte
+	delays  []int
+	pending *[BufferSize]byte
+	elapsed time.Duration
+	emitted int
+}
+
+func NewGIFRecorder(w io.WriteCloser, scale int, p Palette) *GIFRecorder {
+	return &GIFRecorder{w: w, scale: scale, palette: p}
+}
+
+func (r *GIFRecorder) Record(buf *[BufferSize]byte, d time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.pending == nil || *r.pending != *buf {
+		r.flush(false)
+		frame := *buf
+		r.pending = &frame
+	}
+	r.elapsed += d
+	return nil
+}
+
+// flush adds the pending frame to the GIF. Unless force is set, a frame that
+// would be shown for less than minGIFDelay is dropped and its time is given
+// to the next one.
+func (r *GIFRecorder) flush(force bool) {
+	if r.pending == nil {
+		return
+	}
+	delay := int((r.elapsed+5*time.Millisecond)/(10*time.Millisecond)) - r.emitted
+	if delay < minGIFDelay {
+		if !force {
+			return
+		}
+		delay = minGIFDelay
+	}
+	r.frames = append(r.frames, *r.pending)
+	r.delays = append(r.delays, delay)
+	r.emitted += delay
+}
+
+func (r *GIFRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.flush(true)
+	r.pending = nil
+
+	g := &gif.GIF{Delay: r.delays}
+	for i := range r.frames {
+		g.Image = append(g.Image, Image(&r.frames[i], r.scale, r.palette))
+	}
+	if err := gif.EncodeAll(r.w, g); err != nil {
+		r.w.Close()
+		return err
+	}
+	return r.w.Close()
+}
+
+// Y4MRecorder streams frames in the YUV4MPEG2 format, which most video
+// encoders accept as input. The frame rate is taken from the duration of the
+// first frame.
+type Y4MRecorder struct {
+	mu      sync.Mutex
+	w       io.WriteCloser
+	bw      *bufio.Writer
+	scale   int
+	palette Palette
+	started bool
+}
+
+func NewY4MRecorder(w io.WriteCloser, scale int, p Palette) *Y4MRecorder {
+	return &Y4MRecorder{w: w, bw: bufio.NewWriter(w), scale: scale, palette: p}
+}
+
+func (r *Y4MRecorder) Record(buf *[BufferSize]byte, d time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.started {
+		num, den := int64(time.Second), int64(d)
+		g := gcd(num, den)
+		_, err := fmt.Fprintf(r.bw, "YUV4MPEG2 W%d H%d F%d:%d Ip A1:1 C444\n",
+			BaseWidth*r.scale, BaseHeight*r.scale, num/g, den/g)
+		if err != nil {
+			return err
+		}
+		r.started = true
+	}
+
+	var planes [2][3]byte
+	for i, c := range []color.RGBA{r.palette.Background, r.palette.Foreground} {
+		y, cb, cr := color.RGBToYCbCr(c.R, c.G, c.B)
+		planes[i] = [3]byte{y, cb, cr}
+	}
+	if _, err := r.bw.WriteString("FRAME\n"); err != nil {
+		return err
+	}
+	img := Image(buf, r.scale, r.palette)
+	for plane := 0; plane < 3; plane++ {
+		for _, p := range img.Pix {
+			if err := r.bw.WriteByte(planes[p][plane]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *Y4MRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.bw.Flush(); err != nil {
+		r.w.Close()
+		return err
+	}
+	return r.w.Close()
+}
+
+// RGBRecorder streams frames as raw 24-bit RGB pixels with no header.
+type RGBRecorder struct {
+	mu      sync.Mutex
+	w       io.WriteCloser
+	bw      *bufio.Writer
+	scale   int
+	palette Palette
+}
+
+func NewRGBRecorder(w io.WriteCloser, scale int, p Palette) *RGBRecorder {
+	return &RGBRecorder{w: w, bw: bufio.NewWriter(w), scale: scale, palette: p}
+}
+
+func (r *RGBRecorder) Record(buf *[BufferSize]byte, d time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	colors := [2]color.RGBA{r.palette.Background, r.palette.Foreground}
+	img := Image(buf, r.scale, r.palette)
+	for _, p := range img.Pix {
+		c := colors[p]
+		if _, err := r.bw.Write([]byte{c.R, c.G, c.B}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *RGBRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.bw.Flush(); err != nil {
+		r.w.Close()
+		return err
+	}
+	return r.w.Close()
+}
+
+func gcd(a, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
@@ -0,0 +1,72 @@
+package chip8
+
+import (
+	"bytes"
+	"image/gif"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+type nopCloser struct {
+	bytes.Buffer
+}
+
+func (nopCloser) Close() error { return nil }
+
+func TestRecorder(t *testing.T) {
+
+	t.Run("GIF", func(t *testing.T) {
+		w := &nopCloser{}
+		r := NewGIFRecorder(w, 1, DefaultPalette)
+		a := [BufferSize]byte{}
+		b := [BufferSize]byte{}
+		b[0] = 1
+
+		// 60 Hz frames do not divide into 1/100s
+		period := time.Second / 60
+		frames := []*[BufferSize]byte{&a, &a, &a, &b, &a, &b, &b}
+		for _, f := range frames {
+			if err := r.Record(f, period); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := r.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		g, err := gif.DecodeAll(w)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []int{5, 2, 5}
+		if len(g.Delay) != len(want) {
+			t.Fatalf("got=%v, want=%v", g.Delay, want)
+		}
+		for i := range want {
+			if g.Delay[i] != want[i] {
+				t.Errorf("got=%v, want=%v", g.Delay, want)
+			}
+		}
+	})
+
+	t.Run("Y4M", func(t *testing.T) {
+		w := &nopCloser{}
+		r := NewY4MRecorder(w, 1, DefaultPalette)
+		buf := [BufferSize]byte{}
+
+		r.Record(&buf, TimerSpeed)
+		r.Record(&buf, TimerSpeed)
+		r.Close()
+
+		header := "YUV4MPEG2 W64 H32 F50:1 Ip A1:1 C444\n"
+		want := len(header) + 2*(len("FRAME\n")+3*BufferSize)
+		if w.Len() != want {
+			t.Errorf("got=%d, want=%d", w.Len(), want)
+		}
+		if got, _ := ioutil.ReadAll(&w.Buffer); string(got[:len(header)]) != header {
+			t.Errorf("got=%q, want=%q", got[:len(header)], header)
+		}
+	})
+
+}
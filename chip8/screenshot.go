@@ -0,0 +1,70 @@
+package chip8
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/image/colornames"
+)
+
+type Palette struct {
+	Background color.RGBA
+	Foreground color.RGBA
+}
+
+var DefaultPalette = Palette{
+	Background: colornames.Black,
+	Foreground: colornames.Pink,
+}
+
+// Image converts buf to an image in which each CHIP-8 pixel is a
+// scale x scale square.
+func Image(buf *[BufferSize]byte, scale int, p Palette) *image.Paletted {
+	img := image.NewPaletted(
+		image.Rect(0, 0, BaseWidth*scale, BaseHeight*scale),
+		color.Palette{p.Background, p.Foreground},
+	)
+	for y := 0; y < BaseHeight*scale; y++ {
+		for x := 0; x < BaseWidth*scale; x++ {
+			img.Pix[y*img.Stride+x] = buf[x/scale+y/scale*BaseWidth]
+		}
+	}
+	return img
+}
+
+func WritePNG(w io.Writer, buf *[BufferSize]byte, scale int, p Palette) error {
+	return png.Encode(w, Image(buf, scale, p))
+}
+
+func SavePNG(path string, buf *[BufferSize]byte, scale int, p Palette) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := WritePNG(f, buf, scale, p); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// ASCII converts buf to text, drawing filled pixels as '#' and empty ones
+// as '.'.
+func ASCII(buf *[BufferSize]byte) string {
+	var sb strings.Builder
+	for y := 0; y < BaseHeight; y++ {
+		for x := 0; x < BaseWidth; x++ {
+			if buf[x+y*BaseWidth] == 1 {
+				sb.WriteByte('#')
+			} else {
+				sb.WriteByte('.')
+			}
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
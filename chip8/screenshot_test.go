@@ -0,0 +1,43 @@
+package chip8
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestScreenshot(t *testing.T) {
+
+	t.Run("Image", func(t *testing.T) {
+		buf := [BufferSize]byte{}
+		buf[1+BaseWidth] = 1
+
+		img := Image(&buf, 2, DefaultPalette)
+
+		if img.Bounds().Dx() != BaseWidth*2 || img.Bounds().Dy() != BaseHeight*2 {
+			t.Fatalf("got=%v, want=%dx%d", img.Bounds(), BaseWidth*2, BaseHeight*2)
+		}
+		for _, p := range [][2]int{{2, 2}, {3, 2}, {2, 3}, {3, 3}} {
+			if img.At(p[0], p[1]) != DefaultPalette.Foreground {
+				t.Errorf("(%d, %d): got=%v, want=%v", p[0], p[1], img.At(p[0], p[1]), DefaultPalette.Foreground)
+			}
+		}
+		if img.At(1, 1) != DefaultPalette.Background {
+			t.Errorf("got=%v, want=%v", img.At(1, 1), DefaultPalette.Background)
+		}
+	})
+
+	t.Run("ASCII", func(t *testing.T) {
+		buf := [BufferSize]byte{}
+		buf[1+BaseWidth] = 1
+
+		lines := strings.Split(ASCII(&buf), "\n")
+
+		if len(lines) != BaseHeight+1 {
+			t.Fatalf("got=%d, want=%d", len(lines), BaseHeight+1)
+		}
+		if lines[1][:3] != ".#." {
+			t.Errorf("got=%q, want=%q", lines[1][:3], ".#.")
+		}
+	})
+
+}
@@ -0,0 +1,168 @@
+package chip8
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// State is a copy of the machine state.
+type State struct {
+	Memory [MemorySize]byte
+	V      [VRegisterSize]byte
+	I      uint16
+	PC     uint16
+	DT     byte
+	ST     byte
+	Stack  []uint16
+	Screen [BufferSize]byte
+
+	// Seed is the seed of the random number generator, and RNG the number
+	// of values drawn from it since, at most maxDrawn
+	Seed int64
+	RNG  uint64
+	// Frames is the number of frames run since the ROM was loaded
+	Frames uint64
+}
+
+// State returns a copy of the machine state.
+func (e *Emulator) State() State {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.state()
+}
+
+func (e *Emulator) state() State {
+	return State{
+		Memory: e.memory,
+		V:      e.vReg,
+		I:      e.iReg,
+		PC:     e.pc,
+		DT:     e.delayTimer,
+		ST:     e.soundTimer,
+		Stack:  append([]uint16(nil), e.stack...),
+		Screen: e.frameBuffer,
+		Seed:   e.src.seed,
+		RNG:    e.src.drawn,
+		Frames: e.frames,
+	}
+}
+
+// SetState restores the machine state s, at the start of a frame. The loaded
+// ROM, which Reset goes back to, is kept. Since the memory may no longer
+// match the ROM, compiled blocks are disabled until the next Load or Reset.
+func (e *Emulator) SetState(s State) error {
+	if s.PC >= MemorySize {
+		return fmt.Errorf("invalid program counter: 0x%04x", s.PC)
+	}
+	if len(s.Stack) > StackSize {
+		return fmt.Errorf("stack too deep: %d", len(s.Stack))
+	}
+	if err := s.check(); err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.memory = s.Memory
+	e.vReg = s.V
+	e.iReg = s.I
+	e.pc = s.PC
+	e.delayTimer = s.DT
+	e.soundTimer = s.ST
+	e.stack = append([]uint16(nil), s.Stack...)
+	e.frameBuffer = s.Screen
+	e.stableBuffer = s.Screen
+	e.dirty = true
+	e.seed = s.Seed
+	e.reseed(s.RNG)
+	e.frames = s.Frames
+	e.inFrame = 0
+	e.stopped = false
+	e.invalidate()
+	e.blocks = nil
+	return nil
+}
+
+// stateMagic starts every encoded state, followed by a version byte.
+const stateMagic = "C8ST"
+
+const stateVersion = 2
+
+// MarshalBinary encodes s as the magic and version, the memory, the
+// registers and timers, the stack depth and entries, the screen, the seed and
+// count of the random number generator, and the frame count. Numbers are
+// big-endian.
+func (s State) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(stateMagic)
+	buf.WriteByte(stateVersion)
+	buf.Write(s.Memory[:])
+	buf.Write(s.V[:])
+	binary.Write(&buf, binary.BigEndian, s.I)
+	binary.Write(&buf, binary.BigEndian, s.PC)
+	buf.WriteByte(s.DT)
+	buf.WriteByte(s.ST)
+	buf.WriteByte(byte(len(s.Stack)))
+	binary.Write(&buf, binary.BigEndian, s.Stack)
+	buf.Write(s.Screen[:])
+	binary.Write(&buf, binary.BigEndian, s.Seed)
+	binary.Write(&buf, binary.BigEndian, s.RNG)
+	binary.Write(&buf, binary.BigEndian, s.Frames)
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a state encoded by MarshalBinary.
+func (s *State) UnmarshalBinary(data []byte) error {
+	r := bytes.NewReader(data)
+	header := make([]byte, len(stateMagic)+1)
+	if _, err := r.Read(header); err != nil || string(header[:len(stateMagic)]) != stateMagic {
+		return errors.New("not a saved state")
+	}
+	if v := header[len(stateMagic)]; v != stateVersion {
+		return fmt.Errorf("unsupported state version: %d", v)
+	}
+
+	var st State
+	var depth byte
+	for _, v := range []interface{}{&st.Memory, &st.V, &st.I, &st.PC, &st.DT, &st.ST, &depth} {
+		if err := binary.Read(r, binary.BigEndian, v); err != nil {
+			return fmt.Errorf("truncated state: %w", err)
+		}
+	}
+	if depth > StackSize {
+		return fmt.Errorf("stack too deep: %d", depth)
+	}
+	st.Stack = make([]uint16, depth)
+	if err := binary.Read(r, binary.BigEndian, st.Stack); err != nil {
+		return fmt.Errorf("truncated state: %w", err)
+	}
+	for _, v := range []interface{}{&st.Screen, &st.Seed, &st.RNG, &st.Frames} {
+		if err := binary.Read(r, binary.BigEndian, v); err != nil {
+			return fmt.Errorf("truncated state: %w", err)
+		}
+	}
+	if r.Len() > 0 {
+		return errors.New("trailing data after state")
+	}
+	if err := st.check(); err != nil {
+		return err
+	}
+	*s = st
+	return nil
+}
+
+// check returns an error if the screen of s has pixels other than 0 and 1,
+// or if restoring its random number generator would draw more than maxDrawn
+// values.
+func (s *State) check() error {
+	for i, p := range s.Screen {
+		if p > 1 {
+			return fmt.Errorf("invalid pixel at (%d, %d): %d", i%BaseWidth, i/BaseWidth, p)
+		}
+	}
+	if s.RNG > maxDrawn {
+		return fmt.Errorf("too many random numbers drawn: %d", s.RNG)
+	}
+	return nil
+}
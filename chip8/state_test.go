@@ -0,0 +1,103 @@
+package chip8
+
+import (
+	"testing"
+
+	"github.com/morinokami/go-chip8/games"
+)
+
+func TestState(t *testing.T) {
+	// TANK draws random numbers all along
+	g, err := games.Find("TANK")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := NewHeadless()
+	e := New(h, WithSeed(1))
+	e.Load(g.Binary)
+	run := func(start int) string {
+		for i := start; i < start+100; i++ {
+			h.SetKeypad(gameInput(i))
+			if err := e.Frame(); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return e.Hash()
+	}
+
+	run(0)
+	drawn := e.src.drawn
+	data, err := e.State().MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := run(100)
+	if e.src.drawn == drawn {
+		t.Fatal("no random numbers drawn")
+	}
+
+	var s State
+	if err := s.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.SetState(s); err != nil {
+		t.Fatal(err)
+	}
+	if got := run(100); got != want {
+		t.Errorf("hash after restoring: got=%s, want=%s", got, want)
+	}
+	if e.Frames() != 200 {
+		t.Errorf("frames: got=%d, want=%d", e.Frames(), 200)
+	}
+
+	for _, data := range [][]byte{nil, []byte("C8ST\x01"), data[:len(data)-1], append(data, 0)} {
+		if err := s.UnmarshalBinary(data); err == nil {
+			t.Errorf("%d bytes: no error", len(data))
+		}
+	}
+}
+
+func TestInvalidState(t *testing.T) {
+	e := New(NewHeadless())
+	for _, tt := range []struct {
+		name   string
+		modify func(*State)
+	}{
+		{"bad screen", func(s *State) { s.Screen[BaseWidth+3] = 2 }},
+		{"too many random numbers", func(s *State) { s.RNG = 1<<64 - 1 }},
+	} {
+		s := e.State()
+		tt.modify(&s)
+		if err := e.SetState(s); err == nil {
+			t.Errorf("%s: SetState: no error", tt.name)
+		}
+		data, err := s.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := s.UnmarshalBinary(data); err == nil {
+			t.Errorf("%s: UnmarshalBinary: no error", tt.name)
+		}
+	}
+}
+
+// TestStateReseeded checks that the random number generator is seeded again
+// before its state would take too long to restore.
+func TestStateReseeded(t *testing.T) {
+	e := New(NewHeadless(), WithSeed(1))
+	e.reseed(maxDrawn - 1)
+	for i := 0; i < 3; i++ {
+		e.rng.Int63()
+	}
+	s := e.State()
+	if s.RNG != 2 || s.Seed == 1 {
+		t.Fatalf("got seed=%d, drawn=%d", s.Seed, s.RNG)
+	}
+	want := e.rng.Int63()
+	if err := e.SetState(s); err != nil {
+		t.Fatal(err)
+	}
+	if got := e.rng.Int63(); got != want {
+		t.Errorf("got=%d, want=%d", got, want)
+	}
+}
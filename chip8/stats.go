@@ -0,0 +1,74 @@
+package chip8
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Stats are counters of what the emulator has done since the ROM was
+// loaded.
+type Stats struct {
+	Instructions uint64
+	Frames       uint64
+	// DRW instructions executed
+	Draws uint64
+	// pixels turned on or off by DRW and CLS
+	PixelsChanged uint64
+	// DRW instructions that erased a pixel
+	Collisions uint64
+	// executed instructions by type
+	Histogram [UNKNOWN + 1]uint64
+	// keys checked by SKP and SKNP
+	Polled Keypad
+}
+
+// Stats returns the counters of the current session.
+func (e *Emulator) Stats() Stats {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	s := e.stats
+	s.Frames = e.frames
+	return s
+}
+
+// Add adds the counters of t to s.
+func (s *Stats) Add(t Stats) {
+	s.Instructions += t.Instructions
+	s.Frames += t.Frames
+	s.Draws += t.Draws
+	s.PixelsChanged += t.PixelsChanged
+	s.Collisions += t.Collisions
+	for i, n := range t.Histogram {
+		s.Histogram[i] += n
+	}
+	s.Polled |= t.Polled
+}
+
+// Top returns the n most executed instruction types, most executed first.
+func (s *Stats) Top(n int) []Instruction {
+	var insts []Instruction
+	for i, c := range s.Histogram {
+		if c > 0 {
+			insts = append(insts, Instruction(i))
+		}
+	}
+	sort.SliceStable(insts, func(i, j int) bool {
+		return s.Histogram[insts[i]] > s.Histogram[insts[j]]
+	})
+	if len(insts) > n {
+		insts = insts[:n]
+	}
+	return insts
+}
+
+// HistogramString formats the n most executed instruction types with their
+// share of all executed instructions, one per line.
+func (s *Stats) HistogramString(n int) string {
+	var sb strings.Builder
+	for _, inst := range s.Top(n) {
+		fmt.Fprintf(&sb, "%-14s %12d %5.1f%%\n", inst, s.Histogram[inst],
+			100*float64(s.Histogram[inst])/float64(s.Instructions))
+	}
+	return sb.String()
+}
@@ -0,0 +1,52 @@
+package chip8
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStats(t *testing.T) {
+	// draw the font sprite for 0 and erase it, twice, then loop
+	rom := []byte{0xD0, 0x05, 0xD0, 0x05, 0xD0, 0x05, 0xD0, 0x05, 0x12, 0x08}
+	e := New(NewHeadless())
+	e.Load(rom)
+	for i := 0; i < 10; i++ {
+		if err := e.Frame(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := e.Stats()
+	if s.Frames != 10 {
+		t.Errorf("Frames: got=%d, want=%d", s.Frames, 10)
+	}
+	if s.Instructions != uint64(10*CyclesPerFrame) {
+		t.Errorf("Instructions: got=%d, want=%d", s.Instructions, 10*CyclesPerFrame)
+	}
+	if s.Draws != 4 || s.Histogram[DRW] != 4 {
+		t.Errorf("Draws: got=%d, histogram=%d, want=%d", s.Draws, s.Histogram[DRW], 4)
+	}
+	if s.Collisions != 2 {
+		t.Errorf("Collisions: got=%d, want=%d", s.Collisions, 2)
+	}
+	var on uint64
+	screen := e.Screen()
+	for _, p := range screen {
+		on += uint64(p)
+	}
+	// the sprite for 0 has 14 pixels set
+	if s.PixelsChanged != 4*14 || on != 0 {
+		t.Errorf("PixelsChanged: got=%d, want=%d, with %d pixels on", s.PixelsChanged, 4*14, on)
+	}
+	if top := s.Top(1); len(top) != 1 || top[0] != JPAddr {
+		t.Errorf("Top: got=%v, want=[%v]", top, JPAddr)
+	}
+	if h := s.HistogramString(1); !strings.HasPrefix(h, "JP addr") {
+		t.Errorf("HistogramString: got=%q", h)
+	}
+
+	e.Reset()
+	if s := e.Stats(); s.Instructions != 0 {
+		t.Errorf("after Reset: got=%d, want=%d", s.Instructions, 0)
+	}
+}
@@ -0,0 +1,238 @@
+package chip8
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/term"
+)
+
+type CellMode int
+
+const (
+	// CellHalfBlock draws two vertically stacked pixels per character using
+	// Unicode half blocks.
+	CellHalfBlock CellMode = iota
+	// CellBraille draws a 2x4 block of pixels per character using Unicode
+	// Braille patterns.
+	CellBraille
+)
+
+var cellModeNames = [...]string{"half", "braille"}
+
+func (m CellMode) String() string {
+	return cellModeNames[m]
+}
+
+func ParseCellMode(s string) (CellMode, error) {
+	for i, name := range cellModeNames {
+		if name == s {
+			return CellMode(i), nil
+		}
+	}
+	return CellHalfBlock, fmt.Errorf("unknown cell mode: %s", s)
+}
+
+func AvailableCellModes() string {
+	return strings.Join(cellModeNames[:], ", ")
+}
+
+var terminalKeys = map[byte]byte{
+	'4': 0x1, '5': 0x2, '6': 0x3, '7': 0xC,
+	'r': 0x4, 't': 0x5, 'y': 0x6, 'u': 0xD,
+	'f': 0x7, 'g': 0x8, 'h': 0x9, 'j': 0xE,
+	'v': 0xA, 'b': 0x0, 'n': 0xB, 'm': 0xF,
+}
+
+// Terminal renders the screen to a text terminal and reads the keypad from
+// its standard input. Since terminals only report key presses, a key is
+// considered held until KeyTimeout has passed without it being repeated.
+type Terminal struct {
+	in       *os.File
+	out      io.Writer
+	mode     DisplayMode
+	cellMode CellMode
+	state    *term.State
+	onHotkey func(Hotkey)
+
+	mu        sync.Mutex
+	pressedAt [16]time.Time
+}
+
+func NewTerminal() *Terminal {
+	return &Terminal{in: os.Stdin, out: os.Stdout}
+}
+
+// SetMode sets the display mode. ModePhosphor and ModeBlend cannot be
+// shaded in a terminal and behave like ModeNormal.
+func (t *Terminal) SetMode(m DisplayMode) {
+	t.mode = m
+}
+
+func (t *Terminal) Mode() DisplayMode {
+	return t.mode
+}
+
+func (t *Terminal) SetCellMode(m CellMode) {
+	t.cellMode = m
+}
+
+// SetHotkeyHandler sets the function called when P (pause), Backspace
+// (reset), C (cheats) or Q or Ctrl-C (quit) is pressed. Without a handler,
+// Ctrl-C restores the terminal and interrupts the process.
+func (t *Terminal) SetHotkeyHandler(f func(Hotkey)) {
+	t.onHotkey = f
+}
+
+func (t *Terminal) Init() {
+	state, err := term.MakeRaw(int(t.in.Fd()))
+	if err != nil {
+		panic(err)
+	}
+	t.state = state
+
+	// clear the screen and hide the cursor
+	fmt.Fprint(t.out, "\x1b[2J\x1b[?25l")
+
+	go t.read()
+}
+
+func (t *Terminal) Run(f func()) {
+	f()
+}
+
+// Close shows the cursor again and restores the terminal state.
+func (t *Terminal) Close() {
+	fmt.Fprint(t.out, "\x1b[?25h\r\n")
+	if t.state != nil {
+		term.Restore(int(t.in.Fd()), t.state)
+	}
+}
+
+func (t *Terminal) read() {
+	buf := make([]byte, 16)
+	for {
+		n, err := t.in.Read(buf)
+		if err != nil {
+			return
+		}
+		for _, c := range buf[:n] {
+			// the terminal is in raw mode, so Ctrl-C has to be handled here
+			if c == 0x03 && t.onHotkey == nil {
+				t.Close()
+				p, _ := os.FindProcess(os.Getpid())
+				p.Signal(os.Interrupt)
+				return
+			}
+			if t.onHotkey != nil {
+				switch lower(c) {
+				case 'p':
+					t.onHotkey(HotkeyPause)
+				case 0x7F:
+					t.onHotkey(HotkeyReset)
+				case 'c':
+					t.onHotkey(HotkeyCheats)
+				case 'q', 0x03:
+					t.onHotkey(HotkeyQuit)
+				}
+			}
+			if k, ok := terminalKeys[lower(c)]; ok {
+				t.mu.Lock()
+				t.pressedAt[k] = time.Now()
+				t.mu.Unlock()
+			}
+		}
+	}
+}
+
+func (t *Terminal) Render(buf *[BufferSize]byte, dirty bool) {
+	if !dirty {
+		return
+	}
+	// move the cursor home and overwrite the previous frame
+	fmt.Fprint(t.out, "\x1b[H"+cells(buf, t.cellMode))
+}
+
+func (t *Terminal) Keypad() Keypad {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var keys Keypad
+	for key, at := range t.pressedAt {
+		if !at.IsZero() && time.Since(at) <= KeyTimeout {
+			keys |= 1 << key
+		}
+	}
+	return keys
+}
+
+func (t *Terminal) Beep() {
+	fmt.Fprint(t.out, "\a")
+}
+
+// cells converts buf to lines of text, separated by CRLF since the terminal
+// is in raw mode.
+func cells(buf *[BufferSize]byte, m CellMode) string {
+	w, h := 1, 2
+	if m == CellBraille {
+		w, h = 2, 4
+	}
+
+	var sb strings.Builder
+	for y := 0; y < BaseHeight; y += h {
+		for x := 0; x < BaseWidth; x += w {
+			if m == CellBraille {
+				sb.WriteRune(braille(buf, x, y))
+			} else {
+				sb.WriteRune(halfBlock(buf, x, y))
+			}
+		}
+		sb.WriteString("\r\n")
+	}
+	return sb.String()
+}
+
+func halfBlock(buf *[BufferSize]byte, x, y int) rune {
+	top := buf[x+y*BaseWidth] == 1
+	bottom := buf[x+(y+1)*BaseWidth] == 1
+	switch {
+	case top && bottom:
+		return '█'
+	case top:
+		return '▀'
+	case bottom:
+		return '▄'
+	default:
+		return ' '
+	}
+}
+
+// brailleDots maps a pixel offset within a cell to its Braille dot bit.
+var brailleDots = [4][2]rune{
+	{0x01, 0x08},
+	{0x02, 0x10},
+	{0x04, 0x20},
+	{0x40, 0x80},
+}
+
+func braille(buf *[BufferSize]byte, x, y int) rune {
+	r := rune(0x2800)
+	for dy := 0; dy < 4; dy++ {
+		for dx := 0; dx < 2; dx++ {
+			if buf[x+dx+(y+dy)*BaseWidth] == 1 {
+				r |= brailleDots[dy][dx]
+			}
+		}
+	}
+	return r
+}
+
+func lower(c byte) byte {
+	if 'A' <= c && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
@@ -0,0 +1,61 @@
+package chip8
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTerminal(t *testing.T) {
+
+	t.Run("half blocks", func(t *testing.T) {
+		buf := [BufferSize]byte{}
+		buf[0] = 1
+		buf[1+BaseWidth] = 1
+		buf[2] = 1
+		buf[2+BaseWidth] = 1
+
+		got := []rune(cells(&buf, CellHalfBlock))
+
+		want := []rune("▀▄█ ")
+		for i, r := range want {
+			if got[i] != r {
+				t.Errorf("got=%q, want=%q", got[i], r)
+			}
+		}
+		if len(got) != (BaseWidth+2)*BaseHeight/2 {
+			t.Errorf("got=%d, want=%d", len(got), (BaseWidth+2)*BaseHeight/2)
+		}
+	})
+
+	t.Run("braille", func(t *testing.T) {
+		buf := [BufferSize]byte{}
+		buf[0] = 1
+		buf[1+3*BaseWidth] = 1
+
+		got := []rune(cells(&buf, CellBraille))
+
+		if got[0] != '⢁' {
+			t.Errorf("got=%q, want=%q", got[0], '⢁')
+		}
+		if len(got) != (BaseWidth/2+2)*BaseHeight/4 {
+			t.Errorf("got=%d, want=%d", len(got), (BaseWidth/2+2)*BaseHeight/4)
+		}
+	})
+
+	t.Run("key timeout", func(t *testing.T) {
+		term := NewTerminal()
+		term.pressedAt[0xA] = time.Now()
+		term.pressedAt[0xB] = time.Now()
+
+		if keys := term.Keypad(); keys != 1<<0xA|1<<0xB {
+			t.Errorf("got=0x%04x, want=0x%04x", keys, 1<<0xA|1<<0xB)
+		}
+
+		term.pressedAt[0xA] = time.Now().Add(-2 * KeyTimeout)
+
+		if keys := term.Keypad(); keys != 1<<0xB {
+			t.Errorf("got=0x%04x, want=0x%04x", keys, 1<<0xB)
+		}
+	})
+
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+
+	"github.com/morinokami/go-chip8/chip8"
+	"github.com/morinokami/go-chip8/control"
+	"github.com/morinokami/go-chip8/games"
+	"github.com/urfave/cli/v2"
+)
+
+func controlCommand() *cli.Command {
+	var listen string
+	var game string
+	var seed int64
+	return &cli.Command{
+		Name:  "control",
+		Usage: "serve an API to drive the emulator from other programs",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "listen",
+				Value:       "tcp:localhost:8081",
+				Usage:       "address to listen on, unix:PATH or tcp:HOST:PORT",
+				Destination: &listen,
+			},
+			&cli.StringFlag{
+				Name:        "game",
+				Aliases:     []string{"g"},
+				Usage:       "game to load, a ROM file, an Octo .8o or .gif file, a name or one of the following numbers: " + games.AvailableGames(),
+				Destination: &game,
+			},
+			&cli.Int64Flag{
+				Name:        "seed",
+				Usage:       "seed for the random number generator (default: current time)",
+				Destination: &seed,
+			},
+		},
+		Action: func(c *cli.Context) error {
+			var opts []chip8.Option
+			if c.IsSet("seed") {
+				opts = append(opts, chip8.WithSeed(seed))
+			}
+			var g games.Game
+			if game != "" {
+				var settings chip8.Settings
+				var err error
+				if g, settings, _, err = openGame(game, ""); err != nil {
+					return err
+				}
+				opts = append(opts, chip8.WithSettings(settings))
+			}
+			s := control.NewService(opts...)
+			if game != "" {
+				if err := s.Emulator().Load(g.Binary); err != nil {
+					return err
+				}
+			}
+
+			l, err := control.Listen(listen)
+			if err != nil {
+				return err
+			}
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer stop()
+			go func() {
+				<-ctx.Done()
+				// also removes the Unix socket
+				l.Close()
+			}()
+			log.Printf("listening on %s", listen)
+			if err := control.Serve(l, s); err != nil && !errors.Is(err, net.ErrClosed) {
+				return err
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,111 @@
+package control
+
+import (
+	"io"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+
+	"github.com/morinokami/go-chip8/chip8"
+)
+
+// Client calls the API of a Service.
+type Client struct {
+	c *rpc.Client
+}
+
+// Dial connects to the service at addr, "unix:PATH" or "tcp:HOST:PORT".
+func Dial(addr string) (*Client, error) {
+	network, address, err := splitAddr(addr)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := net.Dial(network, address)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(conn), nil
+}
+
+// NewClient returns a client that talks to a service over conn.
+func NewClient(conn io.ReadWriteCloser) *Client {
+	return &Client{c: jsonrpc.NewClient(conn)}
+}
+
+func (c *Client) Close() error {
+	return c.c.Close()
+}
+
+func (c *Client) call(method string, args, reply interface{}) error {
+	return c.c.Call(ServiceName+"."+method, args, reply)
+}
+
+// LoadROM loads rom and resets the machine.
+func (c *Client) LoadROM(rom []byte) error {
+	return c.call("LoadROM", &LoadROMArgs{ROM: rom}, &Empty{})
+}
+
+// LoadGame loads the bundled game name, which may also be its number.
+func (c *Client) LoadGame(name string) error {
+	return c.call("LoadROM", &LoadROMArgs{Game: name}, &Empty{})
+}
+
+// Step executes up to n instructions, stopping at breakpoints.
+func (c *Client) Step(n int) (Result, error) {
+	var r Result
+	err := c.call("Step", &StepArgs{Cycles: n}, &r)
+	return r, err
+}
+
+// RunFrames runs up to n frames, stopping at breakpoints.
+func (c *Client) RunFrames(n int) (Result, error) {
+	var r Result
+	err := c.call("RunFrames", &RunFramesArgs{Frames: n}, &r)
+	return r, err
+}
+
+func (c *Client) ReadMemory(addr uint16, n int) ([]byte, error) {
+	var m Memory
+	err := c.call("ReadMemory", &ReadMemoryArgs{Addr: addr, Len: n}, &m)
+	return m.Data, err
+}
+
+func (c *Client) WriteMemory(addr uint16, data []byte) error {
+	return c.call("WriteMemory", &WriteMemoryArgs{Addr: addr, Data: data}, &Empty{})
+}
+
+func (c *Client) Registers() (Registers, error) {
+	var r Registers
+	err := c.call("GetRegisters", &Empty{}, &r)
+	return r, err
+}
+
+// SetKeys sets the keypad state for the following frames.
+func (c *Client) SetKeys(keys chip8.Keypad) error {
+	return c.call("SetKeys", &SetKeysArgs{Keys: uint16(keys)}, &Empty{})
+}
+
+// Frame returns the screen.
+func (c *Client) Frame() ([chip8.BufferSize]byte, error) {
+	var f Frame
+	var screen [chip8.BufferSize]byte
+	if err := c.call("GetFrame", &Empty{}, &f); err != nil {
+		return screen, err
+	}
+	copy(screen[:], f.Pixels)
+	return screen, nil
+}
+
+func (c *Client) SetBreakpoint(addr uint16) error {
+	return c.call("SetBreakpoint", &BreakpointArgs{Addr: addr}, &Empty{})
+}
+
+func (c *Client) ClearBreakpoint(addr uint16) error {
+	return c.call("ClearBreakpoint", &BreakpointArgs{Addr: addr}, &Empty{})
+}
+
+func (c *Client) Breakpoints() ([]uint16, error) {
+	var b Breakpoints
+	err := c.call("ListBreakpoints", &Empty{}, &b)
+	return b.Addrs, err
+}
@@ -0,0 +1,268 @@
+// Package control serves an API to drive an emulator from other programs,
+// for test automation and remote debugging, and provides a Go client for it.
+//
+// The API is JSON-RPC 1.0 over a Unix socket or TCP connection, as served
+// by net/rpc/jsonrpc, so that any language can call it: each request is a
+// JSON object such as
+//
+//	{"method": "Emulator.RunFrames", "params": [{"frames": 60}], "id": 1}
+//
+// The methods are those of Service. Byte slices are base64-encoded. Requests
+// sent without waiting for the previous reply may run in any order.
+package control
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"strings"
+	"sync"
+
+	"github.com/morinokami/go-chip8/chip8"
+	"github.com/morinokami/go-chip8/games"
+)
+
+// ServiceName is the name the methods of Service are served under.
+const ServiceName = "Emulator"
+
+// Service is the API. The emulator only runs when asked to, with a keypad
+// state set by SetKeys.
+type Service struct {
+	// mu serializes calls, so that each one sees the effect of the previous
+	// ones
+	mu sync.Mutex
+	e  *chip8.Emulator
+	ui *chip8.Headless
+}
+
+// Empty is the argument or reply of methods that take or return nothing.
+type Empty struct{}
+
+type LoadROMArgs struct {
+	// ROM is the ROM to load, unless Game names a bundled game
+	ROM  []byte `json:"rom"`
+	Game string `json:"game"`
+}
+
+type StepArgs struct {
+	Cycles int `json:"cycles"`
+}
+
+type RunFramesArgs struct {
+	Frames int `json:"frames"`
+}
+
+// Result is the reply of Step and RunFrames.
+type Result struct {
+	// Breakpoint is set if execution stopped at a breakpoint, before the
+	// instruction at PC
+	Breakpoint bool   `json:"breakpoint"`
+	PC         uint16 `json:"pc"`
+	// Frames is the number of frames run since the ROM was loaded
+	Frames uint64 `json:"frames"`
+}
+
+type ReadMemoryArgs struct {
+	Addr uint16 `json:"addr"`
+	Len  int    `json:"len"`
+}
+
+type WriteMemoryArgs struct {
+	Addr uint16 `json:"addr"`
+	Data []byte `json:"data"`
+}
+
+type Memory struct {
+	Data []byte `json:"data"`
+}
+
+type Registers struct {
+	V     [chip8.VRegisterSize]byte `json:"v"`
+	I     uint16                    `json:"i"`
+	PC    uint16                    `json:"pc"`
+	DT    byte                      `json:"dt"`
+	ST    byte                      `json:"st"`
+	Stack []uint16                  `json:"stack"`
+}
+
+type SetKeysArgs struct {
+	// Keys has one bit per key, as chip8.Keypad
+	Keys uint16 `json:"keys"`
+}
+
+// Frame is the screen, one byte per pixel, row by row.
+type Frame struct {
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	Pixels []byte `json:"pixels"`
+}
+
+type BreakpointArgs struct {
+	Addr uint16 `json:"addr"`
+}
+
+type Breakpoints struct {
+	Addrs []uint16 `json:"addrs"`
+}
+
+// NewService creates a service and its emulator, configured with opts.
+func NewService(opts ...chip8.Option) *Service {
+	ui := chip8.NewHeadless()
+	return &Service{e: chip8.New(ui, opts...), ui: ui}
+}
+
+// Emulator returns the emulator of s.
+func (s *Service) Emulator() *chip8.Emulator {
+	return s.e
+}
+
+// LoadROM loads a ROM and resets the machine.
+func (s *Service) LoadROM(args *LoadROMArgs, reply *Empty) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rom := args.ROM
+	if args.Game != "" {
+		g, err := games.Find(args.Game)
+		if err != nil {
+			return err
+		}
+		rom = g.Binary
+	}
+	return s.e.Load(rom)
+}
+
+// Step executes up to Cycles instructions, stopping at breakpoints.
+func (s *Service) Step(args *StepArgs, reply *Result) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.run(args.Cycles, s.e.Cycle, reply)
+}
+
+// RunFrames runs up to Frames frames, stopping at breakpoints. A frame
+// interrupted by a breakpoint counts as run once it ends.
+func (s *Service) RunFrames(args *RunFramesArgs, reply *Result) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.run(args.Frames, s.e.Frame, reply)
+}
+
+// run calls step n times or until it stops at a breakpoint.
+func (s *Service) run(n int, step func() error, reply *Result) error {
+	for i := 0; i < n; i++ {
+		err := step()
+		if errors.Is(err, chip8.ErrBreakpoint) {
+			reply.Breakpoint = true
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	reply.PC = s.e.State().PC
+	reply.Frames = s.e.Frames()
+	return nil
+}
+
+func (s *Service) ReadMemory(args *ReadMemoryArgs, reply *Memory) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, err := s.e.ReadMemory(args.Addr, args.Len)
+	reply.Data = data
+	return err
+}
+
+func (s *Service) WriteMemory(args *WriteMemoryArgs, reply *Empty) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.e.WriteMemory(args.Addr, args.Data)
+}
+
+func (s *Service) GetRegisters(args *Empty, reply *Registers) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st := s.e.State()
+	*reply = Registers{V: st.V, I: st.I, PC: st.PC, DT: st.DT, ST: st.ST, Stack: st.Stack}
+	if reply.Stack == nil {
+		reply.Stack = []uint16{}
+	}
+	return nil
+}
+
+// SetKeys sets the keypad state for the following frames.
+func (s *Service) SetKeys(args *SetKeysArgs, reply *Empty) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ui.SetKeypad(chip8.Keypad(args.Keys))
+	return nil
+}
+
+func (s *Service) GetFrame(args *Empty, reply *Frame) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	screen := s.e.Screen()
+	*reply = Frame{Width: chip8.BaseWidth, Height: chip8.BaseHeight, Pixels: screen[:]}
+	return nil
+}
+
+func (s *Service) SetBreakpoint(args *BreakpointArgs, reply *Empty) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.e.SetBreakpoint(args.Addr)
+	return nil
+}
+
+func (s *Service) ClearBreakpoint(args *BreakpointArgs, reply *Empty) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.e.ClearBreakpoint(args.Addr)
+	return nil
+}
+
+func (s *Service) ListBreakpoints(args *Empty, reply *Breakpoints) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	reply.Addrs = s.e.Breakpoints()
+	return nil
+}
+
+// Serve serves s on the connections accepted by l until l is closed.
+func Serve(l net.Listener, s *Service) error {
+	srv := rpc.NewServer()
+	if err := srv.RegisterName(ServiceName, s); err != nil {
+		return err
+	}
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go srv.ServeCodec(jsonrpc.NewServerCodec(conn))
+	}
+}
+
+// splitAddr splits addr, "unix:PATH" or "tcp:HOST:PORT", into a network and
+// an address. Addresses without a network are TCP addresses.
+func splitAddr(addr string) (string, string, error) {
+	network, address := "tcp", addr
+	if i := strings.Index(addr, ":"); i >= 0 {
+		switch addr[:i] {
+		case "unix", "tcp":
+			network, address = addr[:i], addr[i+1:]
+		}
+	}
+	if address == "" {
+		return "", "", fmt.Errorf("invalid address: %q", addr)
+	}
+	return network, address, nil
+}
+
+// Listen listens on addr, "unix:PATH" or "tcp:HOST:PORT".
+func Listen(addr string) (net.Listener, error) {
+	network, address, err := splitAddr(addr)
+	if err != nil {
+		return nil, err
+	}
+	return net.Listen(network, address)
+}
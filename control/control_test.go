@@ -0,0 +1,168 @@
+package control
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/morinokami/go-chip8/chip8"
+)
+
+// keyROM counts frames in V1 until key 5 is pressed, then draws the digit
+// 5 at (0, 0) and loops.
+var keyROM = []byte{
+	0x60, 0x05, // 0x200: LD V0, 5
+	0x71, 0x01, // 0x202: ADD V1, 1
+	0xE0, 0x9E, // 0x204: SKP V0
+	0x12, 0x02, // 0x206: JP 0x202
+	0xF0, 0x29, // 0x208: LD F, V0
+	0x62, 0x00, // 0x20A: LD V2, 0
+	0xD2, 0x25, // 0x20C: DRW V2, V2, 5
+	0x12, 0x0E, // 0x20E: JP 0x20E
+}
+
+// serve serves a new service on addr and returns a client connected to it.
+func serve(t *testing.T, addr string) *Client {
+	t.Helper()
+	l, err := Listen(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go Serve(l, NewService(chip8.WithSeed(1)))
+	network := l.Addr().Network()
+	c, err := Dial(network + ":" + l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		c.Close()
+		l.Close()
+	})
+	return c
+}
+
+func TestClient(t *testing.T) {
+	for _, addr := range []string{"tcp:127.0.0.1:0", "unix:" + filepath.Join(t.TempDir(), "chip8.sock")} {
+		t.Run(addr[:3], func(t *testing.T) {
+			testClient(t, serve(t, addr))
+		})
+	}
+}
+
+func testClient(t *testing.T, c *Client) {
+	if err := c.LoadROM(keyROM); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := c.RunFrames(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Frames != 3 || r.Breakpoint {
+		t.Errorf("RunFrames: %+v", r)
+	}
+	regs, err := c.Registers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2 instructions before the loop, then 3 per iteration
+	if regs.V[1] != 10 {
+		t.Errorf("V1: got=%d, want=%d", regs.V[1], 10)
+	}
+
+	if err := c.SetBreakpoint(0x20C); err != nil {
+		t.Fatal(err)
+	}
+	if bps, err := c.Breakpoints(); err != nil || len(bps) != 1 || bps[0] != 0x20C {
+		t.Errorf("Breakpoints: %v, %v", bps, err)
+	}
+	if err := c.SetKeys(1 << 5); err != nil {
+		t.Fatal(err)
+	}
+	r, err = c.RunFrames(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Breakpoint || r.PC != 0x20C || r.Frames != 3 {
+		t.Errorf("RunFrames to the breakpoint: %+v", r)
+	}
+	screen, err := c.Frame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if screen != ([chip8.BufferSize]byte{}) {
+		t.Error("screen drawn before DRW")
+	}
+
+	if r, err := c.Step(1); err != nil || r.PC != 0x20E {
+		t.Errorf("Step: %+v, %v", r, err)
+	}
+	if screen, _ = c.Frame(); screen[0] != 1 || screen[4] != 0 {
+		t.Errorf("top row of the digit: %v", screen[:8])
+	}
+	if err := c.ClearBreakpoint(0x20C); err != nil {
+		t.Fatal(err)
+	}
+
+	// patch the loop at 0x20E into LD V3, 0x42
+	if err := c.WriteMemory(0x20E, []byte{0x63, 0x42}); err != nil {
+		t.Fatal(err)
+	}
+	if data, err := c.ReadMemory(0x20C, 4); err != nil || fmt.Sprintf("% x", data) != "d2 25 63 42" {
+		t.Errorf("ReadMemory: % x, %v", data, err)
+	}
+	if _, err := c.Step(1); err != nil {
+		t.Fatal(err)
+	}
+	if regs, _ = c.Registers(); regs.V[3] != 0x42 {
+		t.Errorf("V3: got=0x%02x, want=0x42", regs.V[3])
+	}
+
+	if _, err := c.ReadMemory(0xFFF, 2); err == nil {
+		t.Error("ReadMemory past the end of memory succeeded")
+	}
+	if err := c.LoadGame("NOPE"); err == nil {
+		t.Error("LoadGame of an unknown game succeeded")
+	}
+	if err := c.LoadGame("PONG"); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestJSON calls the service without the Go client, as other languages
+// would.
+func TestJSON(t *testing.T) {
+	l, err := Listen("127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go Serve(l, NewService())
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	dec := json.NewDecoder(bufio.NewReader(conn))
+	var res struct {
+		ID     int             `json:"id"`
+		Result json.RawMessage `json:"result"`
+		Error  interface{}     `json:"error"`
+	}
+	for _, tt := range []struct{ req, want string }{
+		{`{"method": "Emulator.LoadROM", "params": [{"game": "PONG"}], "id": 1}`, `{}`},
+		{`{"method": "Emulator.RunFrames", "params": [{"frames": 2}], "id": 2}`, `{"breakpoint":false,"pc":530,"frames":2}`},
+	} {
+		fmt.Fprintln(conn, tt.req)
+		if err := dec.Decode(&res); err != nil {
+			t.Fatal(err)
+		}
+		if string(res.Result) != tt.want || res.Error != nil {
+			t.Errorf("request %d: got result=%s, error=%v, want=%s", res.ID, res.Result, res.Error, tt.want)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+// Package env wraps the emulator in a reinforcement learning environment
+// in the style of OpenAI Gym: an agent observes the screen, picks one of a
+// small set of actions and is rewarded by changes in the game's score.
+package env
+
+import (
+	"math/bits"
+
+	"github.com/morinokami/go-chip8/chip8"
+	"github.com/morinokami/go-chip8/games"
+)
+
+// Observation is the screen, one byte per pixel.
+type Observation [chip8.BufferSize]byte
+
+// Action is an index into Actions. Action 0 presses no key.
+type Action int
+
+// probeFrames is the number of frames a ROM is run for to find the keys it
+// polls.
+const probeFrames = 600
+
+type Env struct {
+	game      games.Game
+	rules     Rules
+	actions   []chip8.Keypad
+	frameSkip int
+	maxSteps  int
+
+	ui    *chip8.Headless
+	e     *chip8.Emulator
+	score int
+	steps int
+	done  bool
+	err   error
+}
+
+type Option func(*Env)
+
+// WithFrameSkip makes each step repeat the action for n frames. The default
+// is 4.
+func WithFrameSkip(n int) Option {
+	return func(env *Env) {
+		env.frameSkip = n
+	}
+}
+
+// WithMaxSteps ends episodes after n steps. By default episodes only end
+// when the game is over.
+func WithMaxSteps(n int) Option {
+	return func(env *Env) {
+		env.maxSteps = n
+	}
+}
+
+// WithRules overrides the rules of the game.
+func WithRules(r Rules) Option {
+	return func(env *Env) {
+		env.rules = r
+	}
+}
+
+// New creates an environment for g, using its Rules if it has any. Call
+// Reset to start an episode.
+func New(g games.Game, opts ...Option) *Env {
+	env := &Env{game: g, rules: Games[g.Name], frameSkip: 4}
+	for _, opt := range opts {
+		opt(env)
+	}
+
+	keys := env.rules.Keys
+	if keys == nil {
+		keys = probe(g.Binary)
+	}
+	env.actions = []chip8.Keypad{0}
+	for _, k := range keys {
+		env.actions = append(env.actions, 1<<k)
+	}
+	return env
+}
+
+// probe runs rom pressing every key in turn and returns the keys it checks
+// with SKP and SKNP, or all keys if it only waits for key presses.
+func probe(rom []byte) []byte {
+	ui := chip8.NewHeadless()
+	e := chip8.New(ui, chip8.WithSeed(1))
+	if err := e.Load(rom); err != nil {
+		return nil
+	}
+	for i := 0; i < probeFrames; i++ {
+		var keys chip8.Keypad
+		if i/5%2 == 0 {
+			keys = 1 << (i / 10 % 16)
+		}
+		ui.SetKeypad(keys)
+		if err := e.Frame(); err != nil {
+			break
+		}
+	}
+
+	polled := e.Stats().Polled
+	if polled == 0 {
+		polled = 0xFFFF
+	}
+	var keys []byte
+	for polled != 0 {
+		k := bits.TrailingZeros16(uint16(polled))
+		keys = append(keys, byte(k))
+		polled &^= 1 << k
+	}
+	return keys
+}
+
+// Actions returns the keypad state of each action.
+func (env *Env) Actions() []chip8.Keypad {
+	return env.actions
+}
+
+// Reset starts a new episode with the random number generator seeded with
+// seed, and returns the first observation.
+func (env *Env) Reset(seed int64) Observation {
+	env.ui = chip8.NewHeadless()
+	env.e = chip8.New(env.ui, chip8.WithSeed(seed))
+	env.err = env.e.Load(env.game.Binary)
+	env.steps = 0
+	env.done = env.err != nil
+	env.score = 0
+	if env.rules.Score != nil {
+		s := env.e.State()
+		env.score = env.rules.Score(&s)
+	}
+	return Observation(env.e.Screen())
+}
+
+// Step presses the keys of a for the configured number of frames and
+// returns the observation after them, the reward earned and whether the
+// episode is over. Stepping after the episode is over does nothing.
+func (env *Env) Step(a Action) (Observation, float64, bool) {
+	if env.done {
+		return Observation(env.e.Screen()), 0, true
+	}
+
+	env.ui.SetKeypad(env.actions[a])
+	var reward float64
+	for i := 0; i < env.frameSkip; i++ {
+		if env.err = env.e.Frame(); env.err != nil {
+			env.done = true
+			break
+		}
+		s := env.e.State()
+		if env.rules.Score != nil {
+			score := env.rules.Score(&s)
+			reward += float64(score - env.score)
+			env.score = score
+		}
+		if env.rules.Done != nil && env.rules.Done(&s) {
+			env.done = true
+			break
+		}
+	}
+	env.steps++
+	if env.maxSteps > 0 && env.steps >= env.maxSteps {
+		env.done = true
+	}
+	return Observation(env.e.Screen()), reward, env.done
+}
+
+// Score returns the current score of the episode.
+func (env *Env) Score() int {
+	return env.score
+}
+
+// Err returns the error that ended the episode, if the emulator failed.
+func (env *Env) Err() error {
+	return env.err
+}
@@ -0,0 +1,106 @@
+package env
+
+import (
+	"testing"
+
+	"github.com/morinokami/go-chip8/chip8"
+	"github.com/morinokami/go-chip8/games"
+)
+
+func find(t *testing.T, name string) games.Game {
+	t.Helper()
+	g, err := games.Find(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestActions(t *testing.T) {
+	tests := []struct {
+		game string
+		want []chip8.Keypad
+	}{
+		{"PONG", []chip8.Keypad{0, 1 << 0x1, 1 << 0x4}},
+		{"BRIX", []chip8.Keypad{0, 1 << 0x4, 1 << 0x6}},
+	}
+	for _, tt := range tests {
+		env := New(find(t, tt.game))
+		got := env.Actions()
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: got=%v, want=%v", tt.game, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: got=%v, want=%v", tt.game, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestDeterminism(t *testing.T) {
+	env := New(find(t, "BRIX"))
+	run := func() (Observation, float64) {
+		obs := env.Reset(7)
+		var total float64
+		for i := 0; i < 200; i++ {
+			var r float64
+			obs, r, _ = env.Step(Action(i / 10 % len(env.Actions())))
+			total += r
+		}
+		return obs, total
+	}
+	obs1, total1 := run()
+	obs2, total2 := run()
+	if obs1 != obs2 || total1 != total2 {
+		t.Errorf("episodes with the same seed differ: rewards %v and %v", total1, total2)
+	}
+}
+
+func TestEpisodes(t *testing.T) {
+	tests := []struct {
+		game   string
+		action Action
+	}{
+		// a paddle held against the top loses most balls, while a still
+		// one can rally forever
+		{"PONG", 1},
+		{"BRIX", 0},
+		// pieces pile up in the middle of the well
+		{"TETRIS", 0},
+	}
+	for _, tt := range tests {
+		env := New(find(t, tt.game))
+		env.Reset(1)
+		var total float64
+		done := false
+		steps := 0
+		for ; !done && steps < 10000; steps++ {
+			var r float64
+			_, r, done = env.Step(tt.action)
+			total += r
+		}
+		if err := env.Err(); err != nil {
+			t.Fatalf("%s: %v", tt.game, err)
+		}
+		if !done {
+			t.Errorf("%s: not done after %d steps", tt.game, steps)
+		}
+		if int(total) != env.Score() {
+			t.Errorf("%s: total reward %v, score %d", tt.game, total, env.Score())
+		}
+		t.Logf("%s: score %d after %d steps", tt.game, env.Score(), steps)
+	}
+}
+
+func TestMaxSteps(t *testing.T) {
+	env := New(find(t, "PONG"), WithMaxSteps(3), WithFrameSkip(1))
+	env.Reset(1)
+	for i := 1; i <= 3; i++ {
+		if _, _, done := env.Step(0); done != (i == 3) {
+			t.Errorf("step %d: done=%v", i, done)
+		}
+	}
+}
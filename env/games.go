@@ -0,0 +1,67 @@
+package env
+
+import "github.com/morinokami/go-chip8/chip8"
+
+// Rules describe how a game is scored and when it is over, by reading its
+// memory, registers and screen.
+type Rules struct {
+	// Keys the agent may press. If nil, they are found by running the ROM.
+	Keys []byte
+	// Score returns the score of the game in state s. The reward of a step
+	// is the change in score.
+	Score func(s *chip8.State) int
+	// Done reports whether the game in state s is over.
+	Done func(s *chip8.State) bool
+}
+
+// Games are the rules of the bundled games that have them, by name.
+var Games = map[string]Rules{
+	// The score is kept in VE, with the left player's points in the tens
+	// and the right player's in the ones, and written as BCD to 0x2F2 when
+	// it is displayed. The agent plays the left paddle, with 1 and 4.
+	"PONG": {
+		Keys: []byte{0x1, 0x4},
+		Score: func(s *chip8.State) int {
+			return int(s.Memory[0x2F3]) - int(s.Memory[0x2F4])
+		},
+		Done: func(s *chip8.State) bool {
+			// either digit would overflow into the other at 10
+			return s.Memory[0x2F3] == 9 || s.Memory[0x2F4] == 9
+		},
+	},
+	// The score is written as BCD to 0x314. The game ends in a loop at
+	// 0x2DE when the last life is lost or all bricks are cleared.
+	"BRIX": {
+		Score: func(s *chip8.State) int {
+			return bcd(s.Memory[0x314:0x317])
+		},
+		Done: func(s *chip8.State) bool {
+			return s.PC == 0x2DE
+		},
+	},
+	// The number of cleared lines is written as BCD to 0x804. The game
+	// never stops by itself; once the stack reaches the top, new pieces lock
+	// at row 2 of the well, which falling pieces never reach.
+	"TETRIS": {
+		Score: func(s *chip8.State) int {
+			return bcd(s.Memory[0x804:0x807])
+		},
+		Done: func(s *chip8.State) bool {
+			for x := 27; x < 37; x++ {
+				if s.Screen[x+2*chip8.BaseWidth] == 1 {
+					return true
+				}
+			}
+			return false
+		},
+	},
+}
+
+// bcd returns the value of the decimal digits b, most significant first.
+func bcd(b []byte) int {
+	n := 0
+	for _, d := range b {
+		n = n*10 + int(d)
+	}
+	return n
+}
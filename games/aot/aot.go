@@ -0,0 +1,59 @@
+// Package aot holds the bundled games compiled ahead of time by go-chip8
+// recompile. Run gen.py to regenerate it.
+package aot
+
+import (
+	"github.com/morinokami/go-chip8/chip8"
+	"github.com/morinokami/go-chip8/games/aot/blinky"
+	"github.com/morinokami/go-chip8/games/aot/blitz"
+	"github.com/morinokami/go-chip8/games/aot/brix"
+	"github.com/morinokami/go-chip8/games/aot/connect4"
+	"github.com/morinokami/go-chip8/games/aot/g15puzzle"
+	"github.com/morinokami/go-chip8/games/aot/guess"
+	"github.com/morinokami/go-chip8/games/aot/hidden"
+	"github.com/morinokami/go-chip8/games/aot/ibm"
+	"github.com/morinokami/go-chip8/games/aot/invaders"
+	"github.com/morinokami/go-chip8/games/aot/kaleid"
+	"github.com/morinokami/go-chip8/games/aot/maze"
+	"github.com/morinokami/go-chip8/games/aot/merlin"
+	"github.com/morinokami/go-chip8/games/aot/missile"
+	"github.com/morinokami/go-chip8/games/aot/pong"
+	"github.com/morinokami/go-chip8/games/aot/pong2"
+	"github.com/morinokami/go-chip8/games/aot/puzzle"
+	"github.com/morinokami/go-chip8/games/aot/syzygy"
+	"github.com/morinokami/go-chip8/games/aot/tank"
+	"github.com/morinokami/go-chip8/games/aot/tetris"
+	"github.com/morinokami/go-chip8/games/aot/tictac"
+	"github.com/morinokami/go-chip8/games/aot/ufo"
+	"github.com/morinokami/go-chip8/games/aot/vbrix"
+	"github.com/morinokami/go-chip8/games/aot/vers"
+	"github.com/morinokami/go-chip8/games/aot/wipeoff"
+)
+
+// Programs are the compiled games by name.
+var Programs = map[string]*chip8.Program{
+	"15PUZZLE": g15puzzle.Program,
+	"BLINKY":   blinky.Program,
+	"BLITZ":    blitz.Program,
+	"BRIX":     brix.Program,
+	"CONNECT4": connect4.Program,
+	"GUESS":    guess.Program,
+	"HIDDEN":   hidden.Program,
+	"IBM":      ibm.Program,
+	"INVADERS": invaders.Program,
+	"KALEID":   kaleid.Program,
+	"MAZE":     maze.Program,
+	"MERLIN":   merlin.Program,
+	"MISSILE":  missile.Program,
+	"PONG":     pong.Program,
+	"PONG2":    pong2.Program,
+	"PUZZLE":   puzzle.Program,
+	"SYZYGY":   syzygy.Program,
+	"TANK":     tank.Program,
+	"TETRIS":   tetris.Program,
+	"TICTAC":   tictac.Program,
+	"UFO":      ufo.Program,
+	"VBRIX":    vbrix.Program,
+	"VERS":     vers.Program,
+	"WIPEOFF":  wipeoff.Program,
+}
@@ -0,0 +1,49 @@
+package aot
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/morinokami/go-chip8/chip8"
+	"github.com/morinokami/go-chip8/games"
+)
+
+// TestGolden runs the compiled games like chip8.TestGames runs the
+// interpreted ones, and checks that they end in the same state.
+func TestGolden(t *testing.T) {
+	for _, g := range games.Games {
+		g := g
+		t.Run(g.Name, func(t *testing.T) {
+			p, ok := Programs[g.Name]
+			if !ok {
+				t.Fatal("not compiled")
+			}
+			h := chip8.NewHeadless()
+			e := chip8.New(h, chip8.WithSeed(1), chip8.WithProgram(p))
+			if err := e.Load(g.Binary); err != nil {
+				t.Fatal(err)
+			}
+			for i := 0; i < 300; i++ {
+				// the input of chip8.TestGames
+				var keys chip8.Keypad
+				if i/5%2 == 0 {
+					keys = 1 << (i / 10 % 16)
+				}
+				h.SetKeypad(keys)
+				if err := e.Frame(); err != nil {
+					t.Fatalf("frame %d: %v", i, err)
+				}
+			}
+
+			want, err := ioutil.ReadFile(filepath.Join("..", "..", "chip8", "testdata", "golden", "games", g.Name+".txt"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			screen := e.Screen()
+			if got := chip8.ASCII(&screen) + "hash: " + e.Hash() + "\n"; got != string(want) {
+				t.Errorf("got:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
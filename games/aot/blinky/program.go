@@ -0,0 +1,3488 @@
+// Code generated by go-chip8 recompile from BLINKY; DO NOT EDIT.
+
+package blinky
+
+import "github.com/morinokami/go-chip8/chip8"
+
+var Program = &chip8.Program{
+	ROM: "22ca535175f53fd0c8c0295b77198d7830a9c44b81497f14ee1fbc6c1322adc0",
+	Blocks: map[uint16]*chip8.Block{
+		0x200: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block200},
+		0x21a: {Insts: []chip8.Instruction{chip8.XOR, chip8.XOR, chip8.LDIAddr, chip8.LDIVx}, Run: block21a},
+		0x222: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDIAddr, chip8.LDIVx}, Run: block222},
+		0x228: {Insts: []chip8.Instruction{chip8.XOR}, Run: block228},
+		0x22a: {Insts: []chip8.Instruction{chip8.XOR}, Run: block22a},
+		0x22e: {Insts: []chip8.Instruction{chip8.CLS}, Run: block22e},
+		0x232: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.LDVxByte, chip8.OR, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block232},
+		0x248: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.DRW}, Run: block248},
+		0x250: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block250},
+		0x252: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block252},
+		0x254: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxI, chip8.LDVxVy, chip8.RND, chip8.AND}, Run: block254},
+		0x260: {Insts: []chip8.Instruction{chip8.RND, chip8.AND}, Run: block260},
+		0x266: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block266},
+		0x26c: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block26c},
+		0x26e: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block26e},
+		0x270: {Insts: []chip8.Instruction{chip8.LDVxVy}, Run: block270},
+		0x274: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block274},
+		0x27a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block27a},
+		0x27c: {Insts: []chip8.Instruction{chip8.LDVxDT, chip8.SNEVxByte}, Run: block27c},
+		0x280: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block280},
+		0x282: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.SHR, chip8.LDVxVy, chip8.SHR, chip8.SUB, chip8.SNEVxByte}, Run: block282},
+		0x28e: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block28e},
+		0x290: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block290},
+		0x292: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block292},
+		0x294: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block294},
+		0x296: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block296},
+		0x298: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block298},
+		0x29a: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.SHR, chip8.LDVxVy, chip8.SHR, chip8.SUB, chip8.SNEVxByte}, Run: block29a},
+		0x2a6: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2a6},
+		0x2a8: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2a8},
+		0x2aa: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2aa},
+		0x2ac: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2ac},
+		0x2ae: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2ae},
+		0x2b0: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2b0},
+		0x2b2: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.LDVxByte, chip8.LDVxByte, chip8.DRW, chip8.LDVxByte, chip8.AND, chip8.LDVxByte, chip8.OR, chip8.LDVxByte}, Run: block2b2},
+		0x2c8: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.SHR, chip8.LDVxVy, chip8.SHR, chip8.SUB, chip8.SNEVxByte}, Run: block2c8},
+		0x2d4: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2d4},
+		0x2d6: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2d6},
+		0x2d8: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2d8},
+		0x2da: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2da},
+		0x2dc: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2dc},
+		0x2de: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2de},
+		0x2e0: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.SHR, chip8.LDVxVy, chip8.SHR, chip8.SUB, chip8.SNEVxByte}, Run: block2e0},
+		0x2ec: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2ec},
+		0x2ee: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2ee},
+		0x2f0: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2f0},
+		0x2f2: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2f2},
+		0x2f4: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2f4},
+		0x2f6: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2f6},
+		0x2f8: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.LDVxByte, chip8.LDVxByte, chip8.DRW, chip8.LDVxByte, chip8.AND, chip8.LDVxByte, chip8.OR, chip8.LDVxByte}, Run: block2f8},
+		0x30e: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block30e},
+		0x310: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block310},
+		0x316: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.DRW, chip8.LDVxByte, chip8.XOR, chip8.LDVxVy, chip8.AND, chip8.SEVxByte}, Run: block316},
+		0x326: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block326},
+		0x328: {Insts: []chip8.Instruction{chip8.LDVxVy}, Run: block328},
+		0x32e: {Insts: []chip8.Instruction{chip8.CLS, chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr}, Run: block32e},
+		0x338: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr}, Run: block338},
+		0x340: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block340},
+		0x348: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.LDIAddr, chip8.DRW, chip8.LDVxByte}, Run: block348},
+		0x354: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block354},
+		0x356: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block356},
+		0x358: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.LDIAddr, chip8.DRW, chip8.ADDVxByte, chip8.ADDVxByte, chip8.SEVxByte}, Run: block358},
+		0x366: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block366},
+		0x368: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.LDIAddr, chip8.DRW, chip8.LDVxByte}, Run: block368},
+		0x374: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block374},
+		0x376: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block376},
+		0x378: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.LDIAddr, chip8.DRW, chip8.ADDVxByte, chip8.SEVxByte}, Run: block378},
+		0x384: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block384},
+		0x386: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.LDIAddr, chip8.DRW, chip8.LDVxByte}, Run: block386},
+		0x392: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block392},
+		0x394: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block394},
+		0x396: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.LDIAddr, chip8.DRW, chip8.ADDVxByte, chip8.ADDVxByte, chip8.SEVxByte}, Run: block396},
+		0x3a4: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block3a4},
+		0x3a6: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.LDIAddr, chip8.DRW, chip8.LDVxByte}, Run: block3a6},
+		0x3b2: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block3b2},
+		0x3b4: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block3b4},
+		0x3b6: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.LDIAddr, chip8.DRW, chip8.ADDVxByte, chip8.SEVxByte}, Run: block3b6},
+		0x3c2: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block3c2},
+		0x3c4: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block3c4},
+		0x3c6: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.LDIAddr, chip8.DRW, chip8.JPAddr}, Run: block3c6},
+		0x3d0: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxByte, chip8.AND, chip8.LDVxVy, chip8.LDVxVy, chip8.LDVxByte, chip8.SKNP}, Run: block3d0},
+		0x3de: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block3de},
+		0x3e0: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block3e0},
+		0x3e4: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block3e4},
+		0x3e6: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block3e6},
+		0x3ea: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block3ea},
+		0x3ec: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block3ec},
+		0x3f0: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block3f0},
+		0x3f2: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block3f2},
+		0x3f4: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block3f4},
+		0x3f6: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block3f6},
+		0x3f8: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block3f8},
+		0x3fa: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block3fa},
+		0x3fc: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block3fc},
+		0x3fe: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block3fe},
+		0x400: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block400},
+		0x402: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxVy}, Run: block402},
+		0x408: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxByte, chip8.AND}, Run: block408},
+		0x40e: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block40e},
+		0x410: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block410},
+		0x412: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxVy, chip8.AND, chip8.SNEVxByte}, Run: block412},
+		0x41a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block41a},
+		0x41c: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block41c},
+		0x41e: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block41e},
+		0x420: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block420},
+		0x422: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block422},
+		0x426: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.OR, chip8.LDVxVy, chip8.LDVxVy, chip8.JPAddr}, Run: block426},
+		0x432: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxVy, chip8.ADDVxByte}, Run: block432},
+		0x43a: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxByte, chip8.AND, chip8.SEVxByte}, Run: block43a},
+		0x442: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block442},
+		0x444: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte, chip8.JPAddr}, Run: block444},
+		0x44a: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxVy, chip8.ADDVxByte}, Run: block44a},
+		0x452: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxByte, chip8.AND, chip8.SEVxByte}, Run: block452},
+		0x45a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block45a},
+		0x45c: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte, chip8.JPAddr}, Run: block45c},
+		0x462: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxVy, chip8.ADDVxByte}, Run: block462},
+		0x46a: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxByte, chip8.AND, chip8.SEVxByte}, Run: block46a},
+		0x472: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block472},
+		0x474: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte, chip8.JPAddr}, Run: block474},
+		0x47a: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxVy, chip8.ADDVxByte}, Run: block47a},
+		0x482: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxByte, chip8.AND, chip8.SEVxByte}, Run: block482},
+		0x48a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block48a},
+		0x48c: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte, chip8.JPAddr}, Run: block48c},
+		0x494: {Insts: []chip8.Instruction{chip8.DRW, chip8.LDVxVy}, Run: block494},
+		0x49a: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.OR, chip8.LDIVx}, Run: block49a},
+		0x4a2: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.ADDVxByte, chip8.LDVxByte, chip8.LDVxDT, chip8.SNEVxByte}, Run: block4a2},
+		0x4ae: {Insts: []chip8.Instruction{chip8.LDSTVx}, Run: block4ae},
+		0x4b0: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block4b0},
+		0x4b2: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.OR, chip8.LDIVx}, Run: block4b2},
+		0x4ba: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.ADDVxByte, chip8.LDVxVy, chip8.LDVxVy}, Run: block4ba},
+		0x4c6: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.SEVxByte}, Run: block4c6},
+		0x4cc: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block4cc},
+		0x4ce: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.XOR}, Run: block4ce},
+		0x4d2: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxVy}, Run: block4d2},
+		0x4d8: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.SEVxByte}, Run: block4d8},
+		0x4de: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block4de},
+		0x4e0: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.XOR}, Run: block4e0},
+		0x4e4: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDSTVx, chip8.LDDTVx, chip8.JPAddr}, Run: block4e4},
+		0x4ec: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block4ec},
+		0x4ee: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block4ee},
+		0x4f0: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block4f0},
+		0x4f2: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block4f2},
+		0x4f4: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block4f4},
+		0x4f6: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxVy, chip8.LDVxByte, chip8.AND, chip8.LDVxVy, chip8.LDVxVy}, Run: block4f6},
+		0x504: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxByte, chip8.AND, chip8.SEVxByte}, Run: block504},
+		0x50c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block50c},
+		0x50e: {Insts: []chip8.Instruction{chip8.DRW, chip8.SNEVxByte}, Run: block50e},
+		0x512: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block512},
+		0x514: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block514},
+		0x516: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block516},
+		0x518: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block518},
+		0x51a: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block51a},
+		0x51c: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block51c},
+		0x51e: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block51e},
+		0x520: {Insts: []chip8.Instruction{chip8.DRW}, Run: block520},
+		0x524: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxDT, chip8.SEVxByte}, Run: block524},
+		0x52a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block52a},
+		0x52c: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block52c},
+		0x52e: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block52e},
+		0x530: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.SHL, chip8.SEVxByte}, Run: block530},
+		0x536: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block536},
+		0x538: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.SUB, chip8.SNEVxByte}, Run: block538},
+		0x53e: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block53e},
+		0x540: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block540},
+		0x542: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block542},
+		0x544: {Insts: []chip8.Instruction{chip8.XOR, chip8.LDVxVy, chip8.SUB, chip8.SNEVxByte}, Run: block544},
+		0x54c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block54c},
+		0x54e: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block54e},
+		0x550: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block550},
+		0x552: {Insts: []chip8.Instruction{chip8.XOR, chip8.JPAddr}, Run: block552},
+		0x556: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.SUB, chip8.SNEVxByte}, Run: block556},
+		0x55c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block55c},
+		0x55e: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block55e},
+		0x560: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block560},
+		0x562: {Insts: []chip8.Instruction{chip8.XOR, chip8.LDVxVy, chip8.SUB, chip8.SNEVxByte}, Run: block562},
+		0x56a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block56a},
+		0x56c: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block56c},
+		0x56e: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block56e},
+		0x570: {Insts: []chip8.Instruction{chip8.XOR, chip8.JPAddr}, Run: block570},
+		0x574: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.SNEVxByte}, Run: block574},
+		0x57a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block57a},
+		0x57c: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.DRW, chip8.LDVxByte, chip8.AND, chip8.LDVxByte, chip8.OR}, Run: block57c},
+		0x58c: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.SNEVxByte}, Run: block58c},
+		0x592: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block592},
+		0x594: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.DRW, chip8.LDVxByte, chip8.AND, chip8.LDVxByte, chip8.OR}, Run: block594},
+		0x5a4: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.SNEVxByte}, Run: block5a4},
+		0x5aa: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block5aa},
+		0x5ac: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.DRW, chip8.LDVxByte, chip8.AND, chip8.LDVxByte, chip8.OR}, Run: block5ac},
+		0x5bc: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.SNEVxByte}, Run: block5bc},
+		0x5c2: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block5c2},
+		0x5c4: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.DRW, chip8.LDVxByte, chip8.AND, chip8.LDVxByte, chip8.OR}, Run: block5c4},
+		0x5d4: {Insts: []chip8.Instruction{chip8.RND, chip8.AND, chip8.SEVxByte}, Run: block5d4},
+		0x5da: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block5da},
+		0x5dc: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.XOR, chip8.XOR, chip8.JPAddr}, Run: block5dc},
+		0x5e4: {Insts: []chip8.Instruction{chip8.DRW, chip8.SHL, chip8.SNEVxByte}, Run: block5e4},
+		0x5ea: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block5ea},
+		0x5ec: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte, chip8.JPAddr}, Run: block5ec},
+		0x5f2: {Insts: []chip8.Instruction{chip8.SHL, chip8.SNEVxByte}, Run: block5f2},
+		0x5f6: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block5f6},
+		0x5f8: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte, chip8.JPAddr}, Run: block5f8},
+		0x5fe: {Insts: []chip8.Instruction{chip8.SHL, chip8.SNEVxByte}, Run: block5fe},
+		0x602: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block602},
+		0x604: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte, chip8.JPAddr}, Run: block604},
+		0x60a: {Insts: []chip8.Instruction{chip8.SHL, chip8.SNEVxByte}, Run: block60a},
+		0x60e: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block60e},
+		0x610: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte}, Run: block610},
+		0x614: {Insts: []chip8.Instruction{chip8.DRW, chip8.LDVxByte, chip8.AND, chip8.OR}, Run: block614},
+		0x61e: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxVy, chip8.LDVxByte, chip8.AND, chip8.LDVxVy, chip8.LDVxVy}, Run: block61e},
+		0x62c: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxByte, chip8.AND, chip8.SEVxByte}, Run: block62c},
+		0x634: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block634},
+		0x636: {Insts: []chip8.Instruction{chip8.DRW, chip8.SNEVxByte}, Run: block636},
+		0x63a: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block63a},
+		0x63c: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block63c},
+		0x63e: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block63e},
+		0x640: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block640},
+		0x642: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block642},
+		0x644: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block644},
+		0x646: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block646},
+		0x648: {Insts: []chip8.Instruction{chip8.DRW}, Run: block648},
+		0x64c: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxDT, chip8.SEVxByte}, Run: block64c},
+		0x652: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block652},
+		0x654: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block654},
+		0x656: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block656},
+		0x658: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.SHL, chip8.SNEVxByte}, Run: block658},
+		0x65e: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block65e},
+		0x660: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.SUB, chip8.SNEVxByte}, Run: block660},
+		0x666: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block666},
+		0x668: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block668},
+		0x66a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block66a},
+		0x66c: {Insts: []chip8.Instruction{chip8.XOR, chip8.LDVxVy, chip8.SUB, chip8.SNEVxByte}, Run: block66c},
+		0x674: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block674},
+		0x676: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block676},
+		0x678: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block678},
+		0x67a: {Insts: []chip8.Instruction{chip8.XOR, chip8.JPAddr}, Run: block67a},
+		0x67e: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.SUB, chip8.SNEVxByte}, Run: block67e},
+		0x684: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block684},
+		0x686: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block686},
+		0x688: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block688},
+		0x68a: {Insts: []chip8.Instruction{chip8.XOR, chip8.LDVxVy, chip8.SUB, chip8.SNEVxByte}, Run: block68a},
+		0x692: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block692},
+		0x694: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block694},
+		0x696: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block696},
+		0x698: {Insts: []chip8.Instruction{chip8.XOR, chip8.JPAddr}, Run: block698},
+		0x69c: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.SNEVxByte}, Run: block69c},
+		0x6a2: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block6a2},
+		0x6a4: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.DRW, chip8.XOR, chip8.LDVxByte, chip8.AND, chip8.LDVxByte, chip8.OR}, Run: block6a4},
+		0x6b6: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.SNEVxByte}, Run: block6b6},
+		0x6bc: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block6bc},
+		0x6be: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.DRW, chip8.XOR, chip8.LDVxByte, chip8.AND, chip8.LDVxByte, chip8.OR}, Run: block6be},
+		0x6d0: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.SNEVxByte}, Run: block6d0},
+		0x6d6: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block6d6},
+		0x6d8: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.DRW, chip8.XOR, chip8.LDVxByte, chip8.AND, chip8.LDVxByte, chip8.OR}, Run: block6d8},
+		0x6ea: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.SNEVxByte}, Run: block6ea},
+		0x6f0: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block6f0},
+		0x6f2: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.DRW, chip8.XOR, chip8.LDVxByte, chip8.AND, chip8.LDVxByte, chip8.OR}, Run: block6f2},
+		0x704: {Insts: []chip8.Instruction{chip8.RND, chip8.AND, chip8.SEVxByte}, Run: block704},
+		0x70a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block70a},
+		0x70c: {Insts: []chip8.Instruction{chip8.XOR, chip8.LDVxByte, chip8.XOR, chip8.XOR, chip8.JPAddr}, Run: block70c},
+		0x716: {Insts: []chip8.Instruction{chip8.DRW, chip8.SHL, chip8.SNEVxByte}, Run: block716},
+		0x71c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block71c},
+		0x71e: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte, chip8.JPAddr}, Run: block71e},
+		0x724: {Insts: []chip8.Instruction{chip8.SHL, chip8.SNEVxByte}, Run: block724},
+		0x728: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block728},
+		0x72a: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte, chip8.JPAddr}, Run: block72a},
+		0x730: {Insts: []chip8.Instruction{chip8.SHL, chip8.SNEVxByte}, Run: block730},
+		0x734: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block734},
+		0x736: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte, chip8.JPAddr}, Run: block736},
+		0x73c: {Insts: []chip8.Instruction{chip8.SHL, chip8.SNEVxByte}, Run: block73c},
+		0x740: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block740},
+		0x742: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte}, Run: block742},
+		0x746: {Insts: []chip8.Instruction{chip8.DRW, chip8.LDVxByte, chip8.AND, chip8.OR}, Run: block746},
+		0x750: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxByte, chip8.AND, chip8.SHL, chip8.LDVxVy, chip8.ADDVxVy, chip8.LDVxByte, chip8.AND, chip8.SNEVxByte}, Run: block750},
+		0x762: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block762},
+		0x764: {Insts: []chip8.Instruction{chip8.SHL, chip8.SHL, chip8.LDIAddr, chip8.ADDIVx, chip8.DRW, chip8.LDVxVy}, Run: block764},
+		0x772: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block772},
+		0x774: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.ADDIVx, chip8.ADDIVx, chip8.ADDIVx, chip8.LDVxI, chip8.LDIAddr, chip8.ADDIVx, chip8.ADDIVx, chip8.ADDIVx}, Run: block774},
+		0x78c: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SEVxByte}, Run: block78c},
+		0x790: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block790},
+		0x794: {Insts: []chip8.Instruction{chip8.XOR, chip8.XOR, chip8.LDVxByte}, Run: block794},
+		0x79a: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxVy}, Run: block79a},
+		0x7a0: {Insts: []chip8.Instruction{chip8.AND, chip8.SHL, chip8.LDIAddr, chip8.ADDIVx, chip8.DRW, chip8.ADDVxByte, chip8.SEVxByte}, Run: block7a0},
+		0x7ae: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block7ae},
+		0x7b0: {Insts: []chip8.Instruction{chip8.XOR, chip8.ADDVxByte, chip8.SNEVxByte}, Run: block7b0},
+		0x7b8: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block7b8},
+		0x7ba: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.ADDVxByte}, Run: block7ba},
+		0x7be: {Insts: []chip8.Instruction{chip8.SHR, chip8.SHR, chip8.SHL, chip8.SHL, chip8.SHL, chip8.SHL, chip8.LDIAddr, chip8.ADDIVx, chip8.ADDIVx, chip8.ADDIVx}, Run: block7be},
+		0x7d6: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxI, chip8.SHR, chip8.LDIVx}, Run: block7d6},
+		0x7de: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block7de},
+		0x7e0: {Insts: []chip8.Instruction{chip8.SKNP}, Run: block7e0},
+		0x7e2: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block7e2},
+		0x7e6: {Insts: []chip8.Instruction{chip8.LDVxI, chip8.LDVxByte, chip8.XOR, chip8.LDVxVy, chip8.LDVxVy}, Run: block7e6},
+		0x7f0: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SUB, chip8.SNEVxByte}, Run: block7f0},
+		0x7f6: {Insts: []chip8.Instruction{chip8.SUB}, Run: block7f6},
+		0x7f8: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block7f8},
+		0x7fa: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block7fa},
+		0x7fc: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SUB, chip8.SNEVxByte}, Run: block7fc},
+		0x802: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block802},
+		0x804: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxVy, chip8.ADDVxVy, chip8.JPAddr}, Run: block804},
+		0x80c: {Insts: []chip8.Instruction{chip8.LDFVx, chip8.DRW, chip8.ADDVxByte, chip8.XOR, chip8.LDVxVy, chip8.LDVxVy}, Run: block80c},
+		0x818: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SUB, chip8.SNEVxByte}, Run: block818},
+		0x81e: {Insts: []chip8.Instruction{chip8.SUB}, Run: block81e},
+		0x820: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block820},
+		0x822: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block822},
+		0x824: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SUB, chip8.SNEVxByte}, Run: block824},
+		0x82a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block82a},
+		0x82c: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxVy, chip8.ADDVxVy, chip8.JPAddr}, Run: block82c},
+		0x834: {Insts: []chip8.Instruction{chip8.LDFVx, chip8.DRW, chip8.ADDVxByte, chip8.XOR, chip8.LDVxVy, chip8.LDVxVy}, Run: block834},
+		0x840: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SUB, chip8.SNEVxByte}, Run: block840},
+		0x846: {Insts: []chip8.Instruction{chip8.SUB}, Run: block846},
+		0x848: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block848},
+		0x84a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block84a},
+		0x84c: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxVy, chip8.ADDVxVy, chip8.JPAddr}, Run: block84c},
+		0x854: {Insts: []chip8.Instruction{chip8.LDFVx, chip8.DRW, chip8.ADDVxByte, chip8.XOR, chip8.LDVxVy, chip8.LDVxVy}, Run: block854},
+		0x860: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SUB, chip8.SNEVxByte}, Run: block860},
+		0x866: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block866},
+		0x868: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.ADDVxVy, chip8.JPAddr}, Run: block868},
+		0x86e: {Insts: []chip8.Instruction{chip8.LDFVx, chip8.DRW, chip8.ADDVxByte, chip8.LDFVx, chip8.DRW}, Run: block86e},
+		0x87a: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxI, chip8.ADDVxVy, chip8.SEVxByte}, Run: block87a},
+		0x882: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block882},
+		0x884: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDIVx}, Run: block884},
+		0x88a: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxI, chip8.LDVxVy, chip8.SUB, chip8.SNEVxByte}, Run: block88a},
+		0x896: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block896},
+		0x898: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block898},
+		0x89a: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.SUB, chip8.SNEVxByte}, Run: block89a},
+		0x8a2: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDIVx}, Run: block8a2},
+		0x8a8: {Insts: []chip8.Instruction{chip8.XOR, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block8a8},
+		0x8b0: {Insts: []chip8.Instruction{chip8.SKNP}, Run: block8b0},
+		0x8b2: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block8b2},
+		0x8b4: {Insts: []chip8.Instruction{chip8.ADDVxVy, chip8.SEVxByte}, Run: block8b4},
+		0x8b8: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block8b8},
+		0x8ba: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxVy, chip8.SEVxByte}, Run: block8ba},
+		0x8c0: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block8c0},
+		0x8c4: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block8c4},
+	},
+}
+
+func block200(c *chip8.Context) {
+	// 0x200: 0x121a JP 0x21a
+	*c.PC = 0x21a
+}
+
+func block21a(c *chip8.Context) {
+	// 0x21a: 0x8003 XOR V0, V0
+	c.V[0x0] ^= c.V[0x0]
+	// 0x21c: 0x8113 XOR V1, V1
+	c.V[0x1] ^= c.V[0x1]
+	// 0x21e: 0xa8c8 LD I, 0x8c8
+	*c.I = 0x8c8
+	// 0x220: 0xf155 LD [I], V1
+	c.Exec(0x220)
+	*c.PC = 0x222
+}
+
+func block222(c *chip8.Context) {
+	// 0x222: 0x6005 LD V0, 0x05
+	c.V[0x0] = 0x05
+	// 0x224: 0xa8cc LD I, 0x8cc
+	*c.I = 0x8cc
+	// 0x226: 0xf055 LD [I], V0
+	c.Exec(0x226)
+	*c.PC = 0x228
+}
+
+func block228(c *chip8.Context) {
+	// 0x228: 0x8773 XOR V7, V7
+	c.V[0x7] ^= c.V[0x7]
+	*c.PC = 0x22a
+}
+
+func block22a(c *chip8.Context) {
+	// 0x22a: 0x8663 XOR V6, V6
+	c.V[0x6] ^= c.V[0x6]
+	*c.PC = 0x22c
+}
+
+func block22e(c *chip8.Context) {
+	// 0x22e: 0x00e0 CLS
+	c.Exec(0x22e)
+	*c.PC = 0x230
+}
+
+func block232(c *chip8.Context) {
+	// 0x232: 0x6e40 LD Ve, 0x40
+	c.V[0xe] = 0x40
+	// 0x234: 0x87e2 AND V7, Ve
+	c.V[0x7] &= c.V[0xe]
+	// 0x236: 0x6e27 LD Ve, 0x27
+	c.V[0xe] = 0x27
+	// 0x238: 0x87e1 OR V7, Ve
+	c.V[0x7] |= c.V[0xe]
+	// 0x23a: 0x681a LD V8, 0x1a
+	c.V[0x8] = 0x1a
+	// 0x23c: 0x690c LD V9, 0x0c
+	c.V[0x9] = 0x0c
+	// 0x23e: 0x6a38 LD Va, 0x38
+	c.V[0xa] = 0x38
+	// 0x240: 0x6b00 LD Vb, 0x00
+	c.V[0xb] = 0x00
+	// 0x242: 0x6c02 LD Vc, 0x02
+	c.V[0xc] = 0x02
+	// 0x244: 0x6d1a LD Vd, 0x1a
+	c.V[0xd] = 0x1a
+	*c.PC = 0x246
+}
+
+func block248(c *chip8.Context) {
+	// 0x248: 0xa8ed LD I, 0x8ed
+	*c.I = 0x8ed
+	// 0x24a: 0xdab4 DRW Va, Vb, 0x4
+	c.Exec(0x24a)
+	// 0x24c: 0xdcd4 DRW Vc, Vd, 0x4
+	c.Exec(0x24c)
+	*c.PC = 0x24e
+}
+
+func block250(c *chip8.Context) {
+	// 0x250: 0x3e00 SE Ve, 0x00
+	if c.V[0xe] == 0x00 {
+		*c.PC = 0x254
+		return
+	}
+	*c.PC = 0x252
+}
+
+func block252(c *chip8.Context) {
+	// 0x252: 0x127c JP 0x27c
+	*c.PC = 0x27c
+}
+
+func block254(c *chip8.Context) {
+	// 0x254: 0xa8cc LD I, 0x8cc
+	*c.I = 0x8cc
+	// 0x256: 0xf065 LD V0, [I]
+	c.Exec(0x256)
+	// 0x258: 0x8500 LD V5, V0
+	c.V[0x5] = c.V[0x0]
+	// 0x25a: 0xc4ff RND V4, byte
+	c.Exec(0x25a)
+	// 0x25c: 0x8452 AND V4, V5
+	c.V[0x4] &= c.V[0x5]
+	*c.PC = 0x25e
+}
+
+func block260(c *chip8.Context) {
+	// 0x260: 0xc4ff RND V4, byte
+	c.Exec(0x260)
+	// 0x262: 0x8452 AND V4, V5
+	c.V[0x4] &= c.V[0x5]
+	*c.PC = 0x264
+}
+
+func block266(c *chip8.Context) {
+	// 0x266: 0x6001 LD V0, 0x01
+	c.V[0x0] = 0x01
+	// 0x268: 0xe0a1 SKNP V0
+	if !c.Pressed(c.V[0x0]) {
+		*c.PC = 0x26c
+		return
+	}
+	*c.PC = 0x26a
+}
+
+func block26c(c *chip8.Context) {
+	// 0x26c: 0x36f7 SE V6, 0xf7
+	if c.V[0x6] == 0xf7 {
+		*c.PC = 0x270
+		return
+	}
+	*c.PC = 0x26e
+}
+
+func block26e(c *chip8.Context) {
+	// 0x26e: 0x124e JP 0x24e
+	*c.PC = 0x24e
+}
+
+func block270(c *chip8.Context) {
+	// 0x270: 0x8e60 LD Ve, V6
+	c.V[0xe] = c.V[0x6]
+	*c.PC = 0x272
+}
+
+func block274(c *chip8.Context) {
+	// 0x274: 0x6e64 LD Ve, 0x64
+	c.V[0xe] = 0x64
+	*c.PC = 0x276
+}
+
+func block27a(c *chip8.Context) {
+	// 0x27a: 0x122a JP 0x22a
+	*c.PC = 0x22a
+}
+
+func block27c(c *chip8.Context) {
+	// 0x27c: 0xf007 LD V0, DT
+	c.V[0x0] = *c.DT
+	// 0x27e: 0x4000 SNE V0, 0x00
+	if c.V[0x0] != 0x00 {
+		*c.PC = 0x282
+		return
+	}
+	*c.PC = 0x280
+}
+
+func block280(c *chip8.Context) {
+	// 0x280: 0x1310 JP 0x310
+	*c.PC = 0x310
+}
+
+func block282(c *chip8.Context) {
+	// 0x282: 0x8080 LD V0, V8
+	c.V[0x0] = c.V[0x8]
+	// 0x284: 0x8006 SHR V0, V0
+	c.Exec(0x284)
+	// 0x286: 0x81a0 LD V1, Va
+	c.V[0x1] = c.V[0xa]
+	// 0x288: 0x8106 SHR V1, V0
+	c.Exec(0x288)
+	// 0x28a: 0x8015 SUB V0, V1
+	c.Exec(0x28a)
+	// 0x28c: 0x4000 SNE V0, 0x00
+	if c.V[0x0] != 0x00 {
+		*c.PC = 0x290
+		return
+	}
+	*c.PC = 0x28e
+}
+
+func block28e(c *chip8.Context) {
+	// 0x28e: 0x129a JP 0x29a
+	*c.PC = 0x29a
+}
+
+func block290(c *chip8.Context) {
+	// 0x290: 0x4001 SNE V0, 0x01
+	if c.V[0x0] != 0x01 {
+		*c.PC = 0x294
+		return
+	}
+	*c.PC = 0x292
+}
+
+func block292(c *chip8.Context) {
+	// 0x292: 0x129a JP 0x29a
+	*c.PC = 0x29a
+}
+
+func block294(c *chip8.Context) {
+	// 0x294: 0x40ff SNE V0, 0xff
+	if c.V[0x0] != 0xff {
+		*c.PC = 0x298
+		return
+	}
+	*c.PC = 0x296
+}
+
+func block296(c *chip8.Context) {
+	// 0x296: 0x129a JP 0x29a
+	*c.PC = 0x29a
+}
+
+func block298(c *chip8.Context) {
+	// 0x298: 0x12c8 JP 0x2c8
+	*c.PC = 0x2c8
+}
+
+func block29a(c *chip8.Context) {
+	// 0x29a: 0x8090 LD V0, V9
+	c.V[0x0] = c.V[0x9]
+	// 0x29c: 0x8006 SHR V0, V0
+	c.Exec(0x29c)
+	// 0x29e: 0x81b0 LD V1, Vb
+	c.V[0x1] = c.V[0xb]
+	// 0x2a0: 0x8106 SHR V1, V0
+	c.Exec(0x2a0)
+	// 0x2a2: 0x8015 SUB V0, V1
+	c.Exec(0x2a2)
+	// 0x2a4: 0x4000 SNE V0, 0x00
+	if c.V[0x0] != 0x00 {
+		*c.PC = 0x2a8
+		return
+	}
+	*c.PC = 0x2a6
+}
+
+func block2a6(c *chip8.Context) {
+	// 0x2a6: 0x12b2 JP 0x2b2
+	*c.PC = 0x2b2
+}
+
+func block2a8(c *chip8.Context) {
+	// 0x2a8: 0x4001 SNE V0, 0x01
+	if c.V[0x0] != 0x01 {
+		*c.PC = 0x2ac
+		return
+	}
+	*c.PC = 0x2aa
+}
+
+func block2aa(c *chip8.Context) {
+	// 0x2aa: 0x12b2 JP 0x2b2
+	*c.PC = 0x2b2
+}
+
+func block2ac(c *chip8.Context) {
+	// 0x2ac: 0x40ff SNE V0, 0xff
+	if c.V[0x0] != 0xff {
+		*c.PC = 0x2b0
+		return
+	}
+	*c.PC = 0x2ae
+}
+
+func block2ae(c *chip8.Context) {
+	// 0x2ae: 0x12b2 JP 0x2b2
+	*c.PC = 0x2b2
+}
+
+func block2b0(c *chip8.Context) {
+	// 0x2b0: 0x12c8 JP 0x2c8
+	*c.PC = 0x2c8
+}
+
+func block2b2(c *chip8.Context) {
+	// 0x2b2: 0xa8ed LD I, 0x8ed
+	*c.I = 0x8ed
+	// 0x2b4: 0xdab4 DRW Va, Vb, 0x4
+	c.Exec(0x2b4)
+	// 0x2b6: 0x6a38 LD Va, 0x38
+	c.V[0xa] = 0x38
+	// 0x2b8: 0x6b00 LD Vb, 0x00
+	c.V[0xb] = 0x00
+	// 0x2ba: 0xdab4 DRW Va, Vb, 0x4
+	c.Exec(0x2ba)
+	// 0x2bc: 0x6ef3 LD Ve, 0xf3
+	c.V[0xe] = 0xf3
+	// 0x2be: 0x87e2 AND V7, Ve
+	c.V[0x7] &= c.V[0xe]
+	// 0x2c0: 0x6e04 LD Ve, 0x04
+	c.V[0xe] = 0x04
+	// 0x2c2: 0x87e1 OR V7, Ve
+	c.V[0x7] |= c.V[0xe]
+	// 0x2c4: 0x6e32 LD Ve, 0x32
+	c.V[0xe] = 0x32
+	*c.PC = 0x2c6
+}
+
+func block2c8(c *chip8.Context) {
+	// 0x2c8: 0x8080 LD V0, V8
+	c.V[0x0] = c.V[0x8]
+	// 0x2ca: 0x8006 SHR V0, V0
+	c.Exec(0x2ca)
+	// 0x2cc: 0x81c0 LD V1, Vc
+	c.V[0x1] = c.V[0xc]
+	// 0x2ce: 0x8106 SHR V1, V0
+	c.Exec(0x2ce)
+	// 0x2d0: 0x8015 SUB V0, V1
+	c.Exec(0x2d0)
+	// 0x2d2: 0x4000 SNE V0, 0x00
+	if c.V[0x0] != 0x00 {
+		*c.PC = 0x2d6
+		return
+	}
+	*c.PC = 0x2d4
+}
+
+func block2d4(c *chip8.Context) {
+	// 0x2d4: 0x12e0 JP 0x2e0
+	*c.PC = 0x2e0
+}
+
+func block2d6(c *chip8.Context) {
+	// 0x2d6: 0x4001 SNE V0, 0x01
+	if c.V[0x0] != 0x01 {
+		*c.PC = 0x2da
+		return
+	}
+	*c.PC = 0x2d8
+}
+
+func block2d8(c *chip8.Context) {
+	// 0x2d8: 0x12e0 JP 0x2e0
+	*c.PC = 0x2e0
+}
+
+func block2da(c *chip8.Context) {
+	// 0x2da: 0x40ff SNE V0, 0xff
+	if c.V[0x0] != 0xff {
+		*c.PC = 0x2de
+		return
+	}
+	*c.PC = 0x2dc
+}
+
+func block2dc(c *chip8.Context) {
+	// 0x2dc: 0x12e0 JP 0x2e0
+	*c.PC = 0x2e0
+}
+
+func block2de(c *chip8.Context) {
+	// 0x2de: 0x1254 JP 0x254
+	*c.PC = 0x254
+}
+
+func block2e0(c *chip8.Context) {
+	// 0x2e0: 0x8090 LD V0, V9
+	c.V[0x0] = c.V[0x9]
+	// 0x2e2: 0x8006 SHR V0, V0
+	c.Exec(0x2e2)
+	// 0x2e4: 0x81d0 LD V1, Vd
+	c.V[0x1] = c.V[0xd]
+	// 0x2e6: 0x8106 SHR V1, V0
+	c.Exec(0x2e6)
+	// 0x2e8: 0x8015 SUB V0, V1
+	c.Exec(0x2e8)
+	// 0x2ea: 0x4000 SNE V0, 0x00
+	if c.V[0x0] != 0x00 {
+		*c.PC = 0x2ee
+		return
+	}
+	*c.PC = 0x2ec
+}
+
+func block2ec(c *chip8.Context) {
+	// 0x2ec: 0x12f8 JP 0x2f8
+	*c.PC = 0x2f8
+}
+
+func block2ee(c *chip8.Context) {
+	// 0x2ee: 0x4001 SNE V0, 0x01
+	if c.V[0x0] != 0x01 {
+		*c.PC = 0x2f2
+		return
+	}
+	*c.PC = 0x2f0
+}
+
+func block2f0(c *chip8.Context) {
+	// 0x2f0: 0x12f8 JP 0x2f8
+	*c.PC = 0x2f8
+}
+
+func block2f2(c *chip8.Context) {
+	// 0x2f2: 0x40ff SNE V0, 0xff
+	if c.V[0x0] != 0xff {
+		*c.PC = 0x2f6
+		return
+	}
+	*c.PC = 0x2f4
+}
+
+func block2f4(c *chip8.Context) {
+	// 0x2f4: 0x12f8 JP 0x2f8
+	*c.PC = 0x2f8
+}
+
+func block2f6(c *chip8.Context) {
+	// 0x2f6: 0x1254 JP 0x254
+	*c.PC = 0x254
+}
+
+func block2f8(c *chip8.Context) {
+	// 0x2f8: 0xa8ed LD I, 0x8ed
+	*c.I = 0x8ed
+	// 0x2fa: 0xdcd4 DRW Vc, Vd, 0x4
+	c.Exec(0x2fa)
+	// 0x2fc: 0x6c02 LD Vc, 0x02
+	c.V[0xc] = 0x02
+	// 0x2fe: 0x6d1a LD Vd, 0x1a
+	c.V[0xd] = 0x1a
+	// 0x300: 0xdcd4 DRW Vc, Vd, 0x4
+	c.Exec(0x300)
+	// 0x302: 0x6ecf LD Ve, 0xcf
+	c.V[0xe] = 0xcf
+	// 0x304: 0x87e2 AND V7, Ve
+	c.V[0x7] &= c.V[0xe]
+	// 0x306: 0x6e20 LD Ve, 0x20
+	c.V[0xe] = 0x20
+	// 0x308: 0x87e1 OR V7, Ve
+	c.V[0x7] |= c.V[0xe]
+	// 0x30a: 0x6e19 LD Ve, 0x19
+	c.V[0xe] = 0x19
+	*c.PC = 0x30c
+}
+
+func block30e(c *chip8.Context) {
+	// 0x30e: 0x1254 JP 0x254
+	*c.PC = 0x254
+}
+
+func block310(c *chip8.Context) {
+	// 0x310: 0x603f LD V0, 0x3f
+	c.V[0x0] = 0x3f
+	*c.PC = 0x312
+}
+
+func block316(c *chip8.Context) {
+	// 0x316: 0xa8ed LD I, 0x8ed
+	*c.I = 0x8ed
+	// 0x318: 0xdab4 DRW Va, Vb, 0x4
+	c.Exec(0x318)
+	// 0x31a: 0xdcd4 DRW Vc, Vd, 0x4
+	c.Exec(0x31a)
+	// 0x31c: 0x6e40 LD Ve, 0x40
+	c.V[0xe] = 0x40
+	// 0x31e: 0x87e3 XOR V7, Ve
+	c.V[0x7] ^= c.V[0xe]
+	// 0x320: 0x8070 LD V0, V7
+	c.V[0x0] = c.V[0x7]
+	// 0x322: 0x80e2 AND V0, Ve
+	c.V[0x0] &= c.V[0xe]
+	// 0x324: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x328
+		return
+	}
+	*c.PC = 0x326
+}
+
+func block326(c *chip8.Context) {
+	// 0x326: 0x1232 JP 0x232
+	*c.PC = 0x232
+}
+
+func block328(c *chip8.Context) {
+	// 0x328: 0x8e60 LD Ve, V6
+	c.V[0xe] = c.V[0x6]
+	*c.PC = 0x32a
+}
+
+func block32e(c *chip8.Context) {
+	// 0x32e: 0x00e0 CLS
+	c.Exec(0x32e)
+	// 0x330: 0x6611 LD V6, 0x11
+	c.V[0x6] = 0x11
+	// 0x332: 0x670a LD V7, 0x0a
+	c.V[0x7] = 0x0a
+	// 0x334: 0xa8ca LD I, 0x8ca
+	*c.I = 0x8ca
+	*c.PC = 0x336
+}
+
+func block338(c *chip8.Context) {
+	// 0x338: 0x6611 LD V6, 0x11
+	c.V[0x6] = 0x11
+	// 0x33a: 0x6710 LD V7, 0x10
+	c.V[0x7] = 0x10
+	// 0x33c: 0xa8c8 LD I, 0x8c8
+	*c.I = 0x8c8
+	*c.PC = 0x33e
+}
+
+func block340(c *chip8.Context) {
+	// 0x340: 0x6400 LD V4, 0x00
+	c.V[0x4] = 0x00
+	// 0x342: 0x6508 LD V5, 0x08
+	c.V[0x5] = 0x08
+	// 0x344: 0x6600 LD V6, 0x00
+	c.V[0x6] = 0x00
+	// 0x346: 0x670f LD V7, 0x0f
+	c.V[0x7] = 0x0f
+	*c.PC = 0x348
+}
+
+func block348(c *chip8.Context) {
+	// 0x348: 0xab19 LD I, 0xb19
+	*c.I = 0xb19
+	// 0x34a: 0xd469 DRW V4, V6, 0x9
+	c.Exec(0x34a)
+	// 0x34c: 0xab22 LD I, 0xb22
+	*c.I = 0xb22
+	// 0x34e: 0xd569 DRW V5, V6, 0x9
+	c.Exec(0x34e)
+	// 0x350: 0x6003 LD V0, 0x03
+	c.V[0x0] = 0x03
+	*c.PC = 0x352
+}
+
+func block354(c *chip8.Context) {
+	// 0x354: 0x3e00 SE Ve, 0x00
+	if c.V[0xe] == 0x00 {
+		*c.PC = 0x358
+		return
+	}
+	*c.PC = 0x356
+}
+
+func block356(c *chip8.Context) {
+	// 0x356: 0x13c6 JP 0x3c6
+	*c.PC = 0x3c6
+}
+
+func block358(c *chip8.Context) {
+	// 0x358: 0xab19 LD I, 0xb19
+	*c.I = 0xb19
+	// 0x35a: 0xd469 DRW V4, V6, 0x9
+	c.Exec(0x35a)
+	// 0x35c: 0xab22 LD I, 0xb22
+	*c.I = 0xb22
+	// 0x35e: 0xd569 DRW V5, V6, 0x9
+	c.Exec(0x35e)
+	// 0x360: 0x7402 ADD V4, 0x02
+	c.V[0x4] += 0x02
+	// 0x362: 0x7502 ADD V5, 0x02
+	c.V[0x5] += 0x02
+	// 0x364: 0x3430 SE V4, 0x30
+	if c.V[0x4] == 0x30 {
+		*c.PC = 0x368
+		return
+	}
+	*c.PC = 0x366
+}
+
+func block366(c *chip8.Context) {
+	// 0x366: 0x1348 JP 0x348
+	*c.PC = 0x348
+}
+
+func block368(c *chip8.Context) {
+	// 0x368: 0xab19 LD I, 0xb19
+	*c.I = 0xb19
+	// 0x36a: 0xd469 DRW V4, V6, 0x9
+	c.Exec(0x36a)
+	// 0x36c: 0xab22 LD I, 0xb22
+	*c.I = 0xb22
+	// 0x36e: 0xd569 DRW V5, V6, 0x9
+	c.Exec(0x36e)
+	// 0x370: 0x6003 LD V0, 0x03
+	c.V[0x0] = 0x03
+	*c.PC = 0x372
+}
+
+func block374(c *chip8.Context) {
+	// 0x374: 0x3e00 SE Ve, 0x00
+	if c.V[0xe] == 0x00 {
+		*c.PC = 0x378
+		return
+	}
+	*c.PC = 0x376
+}
+
+func block376(c *chip8.Context) {
+	// 0x376: 0x13c6 JP 0x3c6
+	*c.PC = 0x3c6
+}
+
+func block378(c *chip8.Context) {
+	// 0x378: 0xab19 LD I, 0xb19
+	*c.I = 0xb19
+	// 0x37a: 0xd469 DRW V4, V6, 0x9
+	c.Exec(0x37a)
+	// 0x37c: 0xab22 LD I, 0xb22
+	*c.I = 0xb22
+	// 0x37e: 0xd569 DRW V5, V6, 0x9
+	c.Exec(0x37e)
+	// 0x380: 0x7602 ADD V6, 0x02
+	c.V[0x6] += 0x02
+	// 0x382: 0x3616 SE V6, 0x16
+	if c.V[0x6] == 0x16 {
+		*c.PC = 0x386
+		return
+	}
+	*c.PC = 0x384
+}
+
+func block384(c *chip8.Context) {
+	// 0x384: 0x1368 JP 0x368
+	*c.PC = 0x368
+}
+
+func block386(c *chip8.Context) {
+	// 0x386: 0xab19 LD I, 0xb19
+	*c.I = 0xb19
+	// 0x388: 0xd469 DRW V4, V6, 0x9
+	c.Exec(0x388)
+	// 0x38a: 0xab22 LD I, 0xb22
+	*c.I = 0xb22
+	// 0x38c: 0xd569 DRW V5, V6, 0x9
+	c.Exec(0x38c)
+	// 0x38e: 0x6003 LD V0, 0x03
+	c.V[0x0] = 0x03
+	*c.PC = 0x390
+}
+
+func block392(c *chip8.Context) {
+	// 0x392: 0x3e00 SE Ve, 0x00
+	if c.V[0xe] == 0x00 {
+		*c.PC = 0x396
+		return
+	}
+	*c.PC = 0x394
+}
+
+func block394(c *chip8.Context) {
+	// 0x394: 0x13c6 JP 0x3c6
+	*c.PC = 0x3c6
+}
+
+func block396(c *chip8.Context) {
+	// 0x396: 0xab19 LD I, 0xb19
+	*c.I = 0xb19
+	// 0x398: 0xd469 DRW V4, V6, 0x9
+	c.Exec(0x398)
+	// 0x39a: 0xab22 LD I, 0xb22
+	*c.I = 0xb22
+	// 0x39c: 0xd569 DRW V5, V6, 0x9
+	c.Exec(0x39c)
+	// 0x39e: 0x74fe ADD V4, 0xfe
+	c.V[0x4] += 0xfe
+	// 0x3a0: 0x75fe ADD V5, 0xfe
+	c.V[0x5] += 0xfe
+	// 0x3a2: 0x3400 SE V4, 0x00
+	if c.V[0x4] == 0x00 {
+		*c.PC = 0x3a6
+		return
+	}
+	*c.PC = 0x3a4
+}
+
+func block3a4(c *chip8.Context) {
+	// 0x3a4: 0x1386 JP 0x386
+	*c.PC = 0x386
+}
+
+func block3a6(c *chip8.Context) {
+	// 0x3a6: 0xab19 LD I, 0xb19
+	*c.I = 0xb19
+	// 0x3a8: 0xd469 DRW V4, V6, 0x9
+	c.Exec(0x3a8)
+	// 0x3aa: 0xab22 LD I, 0xb22
+	*c.I = 0xb22
+	// 0x3ac: 0xd569 DRW V5, V6, 0x9
+	c.Exec(0x3ac)
+	// 0x3ae: 0x6003 LD V0, 0x03
+	c.V[0x0] = 0x03
+	*c.PC = 0x3b0
+}
+
+func block3b2(c *chip8.Context) {
+	// 0x3b2: 0x3e00 SE Ve, 0x00
+	if c.V[0xe] == 0x00 {
+		*c.PC = 0x3b6
+		return
+	}
+	*c.PC = 0x3b4
+}
+
+func block3b4(c *chip8.Context) {
+	// 0x3b4: 0x13c6 JP 0x3c6
+	*c.PC = 0x3c6
+}
+
+func block3b6(c *chip8.Context) {
+	// 0x3b6: 0xab19 LD I, 0xb19
+	*c.I = 0xb19
+	// 0x3b8: 0xd469 DRW V4, V6, 0x9
+	c.Exec(0x3b8)
+	// 0x3ba: 0xab22 LD I, 0xb22
+	*c.I = 0xb22
+	// 0x3bc: 0xd569 DRW V5, V6, 0x9
+	c.Exec(0x3bc)
+	// 0x3be: 0x76fe ADD V6, 0xfe
+	c.V[0x6] += 0xfe
+	// 0x3c0: 0x3600 SE V6, 0x00
+	if c.V[0x6] == 0x00 {
+		*c.PC = 0x3c4
+		return
+	}
+	*c.PC = 0x3c2
+}
+
+func block3c2(c *chip8.Context) {
+	// 0x3c2: 0x13a6 JP 0x3a6
+	*c.PC = 0x3a6
+}
+
+func block3c4(c *chip8.Context) {
+	// 0x3c4: 0x1348 JP 0x348
+	*c.PC = 0x348
+}
+
+func block3c6(c *chip8.Context) {
+	// 0x3c6: 0xab22 LD I, 0xb22
+	*c.I = 0xb22
+	// 0x3c8: 0xd569 DRW V5, V6, 0x9
+	c.Exec(0x3c8)
+	// 0x3ca: 0xab2b LD I, 0xb2b
+	*c.I = 0xb2b
+	// 0x3cc: 0xd569 DRW V5, V6, 0x9
+	c.Exec(0x3cc)
+	// 0x3ce: 0x121a JP 0x21a
+	*c.PC = 0x21a
+}
+
+func block3d0(c *chip8.Context) {
+	// 0x3d0: 0x8370 LD V3, V7
+	c.V[0x3] = c.V[0x7]
+	// 0x3d2: 0x6e03 LD Ve, 0x03
+	c.V[0xe] = 0x03
+	// 0x3d4: 0x83e2 AND V3, Ve
+	c.V[0x3] &= c.V[0xe]
+	// 0x3d6: 0x8480 LD V4, V8
+	c.V[0x4] = c.V[0x8]
+	// 0x3d8: 0x8590 LD V5, V9
+	c.V[0x5] = c.V[0x9]
+	// 0x3da: 0x6e06 LD Ve, 0x06
+	c.V[0xe] = 0x06
+	// 0x3dc: 0xeea1 SKNP Ve
+	if !c.Pressed(c.V[0xe]) {
+		*c.PC = 0x3e0
+		return
+	}
+	*c.PC = 0x3de
+}
+
+func block3de(c *chip8.Context) {
+	// 0x3de: 0x1432 JP 0x432
+	*c.PC = 0x432
+}
+
+func block3e0(c *chip8.Context) {
+	// 0x3e0: 0x6e03 LD Ve, 0x03
+	c.V[0xe] = 0x03
+	// 0x3e2: 0xeea1 SKNP Ve
+	if !c.Pressed(c.V[0xe]) {
+		*c.PC = 0x3e6
+		return
+	}
+	*c.PC = 0x3e4
+}
+
+func block3e4(c *chip8.Context) {
+	// 0x3e4: 0x144a JP 0x44a
+	*c.PC = 0x44a
+}
+
+func block3e6(c *chip8.Context) {
+	// 0x3e6: 0x6e08 LD Ve, 0x08
+	c.V[0xe] = 0x08
+	// 0x3e8: 0xeea1 SKNP Ve
+	if !c.Pressed(c.V[0xe]) {
+		*c.PC = 0x3ec
+		return
+	}
+	*c.PC = 0x3ea
+}
+
+func block3ea(c *chip8.Context) {
+	// 0x3ea: 0x1462 JP 0x462
+	*c.PC = 0x462
+}
+
+func block3ec(c *chip8.Context) {
+	// 0x3ec: 0x6e07 LD Ve, 0x07
+	c.V[0xe] = 0x07
+	// 0x3ee: 0xeea1 SKNP Ve
+	if !c.Pressed(c.V[0xe]) {
+		*c.PC = 0x3f2
+		return
+	}
+	*c.PC = 0x3f0
+}
+
+func block3f0(c *chip8.Context) {
+	// 0x3f0: 0x147a JP 0x47a
+	*c.PC = 0x47a
+}
+
+func block3f2(c *chip8.Context) {
+	// 0x3f2: 0x4303 SNE V3, 0x03
+	if c.V[0x3] != 0x03 {
+		*c.PC = 0x3f6
+		return
+	}
+	*c.PC = 0x3f4
+}
+
+func block3f4(c *chip8.Context) {
+	// 0x3f4: 0x7502 ADD V5, 0x02
+	c.V[0x5] += 0x02
+	*c.PC = 0x3f6
+}
+
+func block3f6(c *chip8.Context) {
+	// 0x3f6: 0x4300 SNE V3, 0x00
+	if c.V[0x3] != 0x00 {
+		*c.PC = 0x3fa
+		return
+	}
+	*c.PC = 0x3f8
+}
+
+func block3f8(c *chip8.Context) {
+	// 0x3f8: 0x75fe ADD V5, 0xfe
+	c.V[0x5] += 0xfe
+	*c.PC = 0x3fa
+}
+
+func block3fa(c *chip8.Context) {
+	// 0x3fa: 0x4302 SNE V3, 0x02
+	if c.V[0x3] != 0x02 {
+		*c.PC = 0x3fe
+		return
+	}
+	*c.PC = 0x3fc
+}
+
+func block3fc(c *chip8.Context) {
+	// 0x3fc: 0x7402 ADD V4, 0x02
+	c.V[0x4] += 0x02
+	*c.PC = 0x3fe
+}
+
+func block3fe(c *chip8.Context) {
+	// 0x3fe: 0x4301 SNE V3, 0x01
+	if c.V[0x3] != 0x01 {
+		*c.PC = 0x402
+		return
+	}
+	*c.PC = 0x400
+}
+
+func block400(c *chip8.Context) {
+	// 0x400: 0x74fe ADD V4, 0xfe
+	c.V[0x4] += 0xfe
+	*c.PC = 0x402
+}
+
+func block402(c *chip8.Context) {
+	// 0x402: 0x8040 LD V0, V4
+	c.V[0x0] = c.V[0x4]
+	// 0x404: 0x8150 LD V1, V5
+	c.V[0x1] = c.V[0x5]
+	*c.PC = 0x406
+}
+
+func block408(c *chip8.Context) {
+	// 0x408: 0x8200 LD V2, V0
+	c.V[0x2] = c.V[0x0]
+	// 0x40a: 0x6e08 LD Ve, 0x08
+	c.V[0xe] = 0x08
+	// 0x40c: 0x80e2 AND V0, Ve
+	c.V[0x0] &= c.V[0xe]
+	*c.PC = 0x40e
+}
+
+func block40e(c *chip8.Context) {
+	// 0x40e: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x412
+		return
+	}
+	*c.PC = 0x410
+}
+
+func block410(c *chip8.Context) {
+	// 0x410: 0x1492 JP 0x492
+	*c.PC = 0x492
+}
+
+func block412(c *chip8.Context) {
+	// 0x412: 0x6e07 LD Ve, 0x07
+	c.V[0xe] = 0x07
+	// 0x414: 0x8020 LD V0, V2
+	c.V[0x0] = c.V[0x2]
+	// 0x416: 0x82e2 AND V2, Ve
+	c.V[0x2] &= c.V[0xe]
+	// 0x418: 0x4205 SNE V2, 0x05
+	if c.V[0x2] != 0x05 {
+		*c.PC = 0x41c
+		return
+	}
+	*c.PC = 0x41a
+}
+
+func block41a(c *chip8.Context) {
+	// 0x41a: 0x149a JP 0x49a
+	*c.PC = 0x49a
+}
+
+func block41c(c *chip8.Context) {
+	// 0x41c: 0x4206 SNE V2, 0x06
+	if c.V[0x2] != 0x06 {
+		*c.PC = 0x420
+		return
+	}
+	*c.PC = 0x41e
+}
+
+func block41e(c *chip8.Context) {
+	// 0x41e: 0x14b2 JP 0x4b2
+	*c.PC = 0x4b2
+}
+
+func block420(c *chip8.Context) {
+	// 0x420: 0x4207 SNE V2, 0x07
+	if c.V[0x2] != 0x07 {
+		*c.PC = 0x424
+		return
+	}
+	*c.PC = 0x422
+}
+
+func block422(c *chip8.Context) {
+	// 0x422: 0x14ec JP 0x4ec
+	*c.PC = 0x4ec
+}
+
+func block426(c *chip8.Context) {
+	// 0x426: 0x6efc LD Ve, 0xfc
+	c.V[0xe] = 0xfc
+	// 0x428: 0x87e2 AND V7, Ve
+	c.V[0x7] &= c.V[0xe]
+	// 0x42a: 0x8731 OR V7, V3
+	c.V[0x7] |= c.V[0x3]
+	// 0x42c: 0x8840 LD V8, V4
+	c.V[0x8] = c.V[0x4]
+	// 0x42e: 0x8950 LD V9, V5
+	c.V[0x9] = c.V[0x5]
+	// 0x430: 0x1750 JP 0x750
+	*c.PC = 0x750
+}
+
+func block432(c *chip8.Context) {
+	// 0x432: 0x8040 LD V0, V4
+	c.V[0x0] = c.V[0x4]
+	// 0x434: 0x8150 LD V1, V5
+	c.V[0x1] = c.V[0x5]
+	// 0x436: 0x7102 ADD V1, 0x02
+	c.V[0x1] += 0x02
+	*c.PC = 0x438
+}
+
+func block43a(c *chip8.Context) {
+	// 0x43a: 0x8200 LD V2, V0
+	c.V[0x2] = c.V[0x0]
+	// 0x43c: 0x6e08 LD Ve, 0x08
+	c.V[0xe] = 0x08
+	// 0x43e: 0x80e2 AND V0, Ve
+	c.V[0x0] &= c.V[0xe]
+	// 0x440: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x444
+		return
+	}
+	*c.PC = 0x442
+}
+
+func block442(c *chip8.Context) {
+	// 0x442: 0x13f2 JP 0x3f2
+	*c.PC = 0x3f2
+}
+
+func block444(c *chip8.Context) {
+	// 0x444: 0x6303 LD V3, 0x03
+	c.V[0x3] = 0x03
+	// 0x446: 0x7502 ADD V5, 0x02
+	c.V[0x5] += 0x02
+	// 0x448: 0x140e JP 0x40e
+	*c.PC = 0x40e
+}
+
+func block44a(c *chip8.Context) {
+	// 0x44a: 0x8040 LD V0, V4
+	c.V[0x0] = c.V[0x4]
+	// 0x44c: 0x8150 LD V1, V5
+	c.V[0x1] = c.V[0x5]
+	// 0x44e: 0x71fe ADD V1, 0xfe
+	c.V[0x1] += 0xfe
+	*c.PC = 0x450
+}
+
+func block452(c *chip8.Context) {
+	// 0x452: 0x8200 LD V2, V0
+	c.V[0x2] = c.V[0x0]
+	// 0x454: 0x6e08 LD Ve, 0x08
+	c.V[0xe] = 0x08
+	// 0x456: 0x80e2 AND V0, Ve
+	c.V[0x0] &= c.V[0xe]
+	// 0x458: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x45c
+		return
+	}
+	*c.PC = 0x45a
+}
+
+func block45a(c *chip8.Context) {
+	// 0x45a: 0x13f2 JP 0x3f2
+	*c.PC = 0x3f2
+}
+
+func block45c(c *chip8.Context) {
+	// 0x45c: 0x6300 LD V3, 0x00
+	c.V[0x3] = 0x00
+	// 0x45e: 0x75fe ADD V5, 0xfe
+	c.V[0x5] += 0xfe
+	// 0x460: 0x140e JP 0x40e
+	*c.PC = 0x40e
+}
+
+func block462(c *chip8.Context) {
+	// 0x462: 0x8040 LD V0, V4
+	c.V[0x0] = c.V[0x4]
+	// 0x464: 0x8150 LD V1, V5
+	c.V[0x1] = c.V[0x5]
+	// 0x466: 0x7002 ADD V0, 0x02
+	c.V[0x0] += 0x02
+	*c.PC = 0x468
+}
+
+func block46a(c *chip8.Context) {
+	// 0x46a: 0x8200 LD V2, V0
+	c.V[0x2] = c.V[0x0]
+	// 0x46c: 0x6e08 LD Ve, 0x08
+	c.V[0xe] = 0x08
+	// 0x46e: 0x80e2 AND V0, Ve
+	c.V[0x0] &= c.V[0xe]
+	// 0x470: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x474
+		return
+	}
+	*c.PC = 0x472
+}
+
+func block472(c *chip8.Context) {
+	// 0x472: 0x13f2 JP 0x3f2
+	*c.PC = 0x3f2
+}
+
+func block474(c *chip8.Context) {
+	// 0x474: 0x6302 LD V3, 0x02
+	c.V[0x3] = 0x02
+	// 0x476: 0x7402 ADD V4, 0x02
+	c.V[0x4] += 0x02
+	// 0x478: 0x140e JP 0x40e
+	*c.PC = 0x40e
+}
+
+func block47a(c *chip8.Context) {
+	// 0x47a: 0x8040 LD V0, V4
+	c.V[0x0] = c.V[0x4]
+	// 0x47c: 0x8150 LD V1, V5
+	c.V[0x1] = c.V[0x5]
+	// 0x47e: 0x70fe ADD V0, 0xfe
+	c.V[0x0] += 0xfe
+	*c.PC = 0x480
+}
+
+func block482(c *chip8.Context) {
+	// 0x482: 0x8200 LD V2, V0
+	c.V[0x2] = c.V[0x0]
+	// 0x484: 0x6e08 LD Ve, 0x08
+	c.V[0xe] = 0x08
+	// 0x486: 0x80e2 AND V0, Ve
+	c.V[0x0] &= c.V[0xe]
+	// 0x488: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x48c
+		return
+	}
+	*c.PC = 0x48a
+}
+
+func block48a(c *chip8.Context) {
+	// 0x48a: 0x13f2 JP 0x3f2
+	*c.PC = 0x3f2
+}
+
+func block48c(c *chip8.Context) {
+	// 0x48c: 0x6301 LD V3, 0x01
+	c.V[0x3] = 0x01
+	// 0x48e: 0x74fe ADD V4, 0xfe
+	c.V[0x4] += 0xfe
+	// 0x490: 0x140e JP 0x40e
+	*c.PC = 0x40e
+}
+
+func block494(c *chip8.Context) {
+	// 0x494: 0xd894 DRW V8, V9, 0x4
+	c.Exec(0x494)
+	// 0x496: 0x8ef0 LD Ve, Vf
+	c.V[0xe] = c.V[0xf]
+	*c.PC = 0x498
+}
+
+func block49a(c *chip8.Context) {
+	// 0x49a: 0x6ef0 LD Ve, 0xf0
+	c.V[0xe] = 0xf0
+	// 0x49c: 0x80e2 AND V0, Ve
+	c.V[0x0] &= c.V[0xe]
+	// 0x49e: 0x8031 OR V0, V3
+	c.V[0x0] |= c.V[0x3]
+	// 0x4a0: 0xf055 LD [I], V0
+	c.Exec(0x4a0)
+	*c.PC = 0x4a2
+}
+
+func block4a2(c *chip8.Context) {
+	// 0x4a2: 0xa8f1 LD I, 0x8f1
+	*c.I = 0x8f1
+	// 0x4a4: 0xd454 DRW V4, V5, 0x4
+	c.Exec(0x4a4)
+	// 0x4a6: 0x7601 ADD V6, 0x01
+	c.V[0x6] += 0x01
+	// 0x4a8: 0x6105 LD V1, 0x05
+	c.V[0x1] = 0x05
+	// 0x4aa: 0xf007 LD V0, DT
+	c.V[0x0] = *c.DT
+	// 0x4ac: 0x4000 SNE V0, 0x00
+	if c.V[0x0] != 0x00 {
+		*c.PC = 0x4b0
+		return
+	}
+	*c.PC = 0x4ae
+}
+
+func block4ae(c *chip8.Context) {
+	// 0x4ae: 0xf118 LD ST, V1
+	*c.ST = c.V[0x1]
+	*c.PC = 0x4b0
+}
+
+func block4b0(c *chip8.Context) {
+	// 0x4b0: 0x1424 JP 0x424
+	*c.PC = 0x424
+}
+
+func block4b2(c *chip8.Context) {
+	// 0x4b2: 0x6ef0 LD Ve, 0xf0
+	c.V[0xe] = 0xf0
+	// 0x4b4: 0x80e2 AND V0, Ve
+	c.V[0x0] &= c.V[0xe]
+	// 0x4b6: 0x8031 OR V0, V3
+	c.V[0x0] |= c.V[0x3]
+	// 0x4b8: 0xf055 LD [I], V0
+	c.Exec(0x4b8)
+	*c.PC = 0x4ba
+}
+
+func block4ba(c *chip8.Context) {
+	// 0x4ba: 0xa8f5 LD I, 0x8f5
+	*c.I = 0x8f5
+	// 0x4bc: 0xd454 DRW V4, V5, 0x4
+	c.Exec(0x4bc)
+	// 0x4be: 0x7604 ADD V6, 0x04
+	c.V[0x6] += 0x04
+	// 0x4c0: 0x80a0 LD V0, Va
+	c.V[0x0] = c.V[0xa]
+	// 0x4c2: 0x81b0 LD V1, Vb
+	c.V[0x1] = c.V[0xb]
+	*c.PC = 0x4c4
+}
+
+func block4c6(c *chip8.Context) {
+	// 0x4c6: 0x6ef0 LD Ve, 0xf0
+	c.V[0xe] = 0xf0
+	// 0x4c8: 0x80e2 AND V0, Ve
+	c.V[0x0] &= c.V[0xe]
+	// 0x4ca: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x4ce
+		return
+	}
+	*c.PC = 0x4cc
+}
+
+func block4cc(c *chip8.Context) {
+	// 0x4cc: 0x14d2 JP 0x4d2
+	*c.PC = 0x4d2
+}
+
+func block4ce(c *chip8.Context) {
+	// 0x4ce: 0x6e0c LD Ve, 0x0c
+	c.V[0xe] = 0x0c
+	// 0x4d0: 0x87e3 XOR V7, Ve
+	c.V[0x7] ^= c.V[0xe]
+	*c.PC = 0x4d2
+}
+
+func block4d2(c *chip8.Context) {
+	// 0x4d2: 0x80c0 LD V0, Vc
+	c.V[0x0] = c.V[0xc]
+	// 0x4d4: 0x81d0 LD V1, Vd
+	c.V[0x1] = c.V[0xd]
+	*c.PC = 0x4d6
+}
+
+func block4d8(c *chip8.Context) {
+	// 0x4d8: 0x6ef0 LD Ve, 0xf0
+	c.V[0xe] = 0xf0
+	// 0x4da: 0x80e2 AND V0, Ve
+	c.V[0x0] &= c.V[0xe]
+	// 0x4dc: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x4e0
+		return
+	}
+	*c.PC = 0x4de
+}
+
+func block4de(c *chip8.Context) {
+	// 0x4de: 0x14e4 JP 0x4e4
+	*c.PC = 0x4e4
+}
+
+func block4e0(c *chip8.Context) {
+	// 0x4e0: 0x6e30 LD Ve, 0x30
+	c.V[0xe] = 0x30
+	// 0x4e2: 0x87e3 XOR V7, Ve
+	c.V[0x7] ^= c.V[0xe]
+	*c.PC = 0x4e4
+}
+
+func block4e4(c *chip8.Context) {
+	// 0x4e4: 0x60ff LD V0, 0xff
+	c.V[0x0] = 0xff
+	// 0x4e6: 0xf018 LD ST, V0
+	*c.ST = c.V[0x0]
+	// 0x4e8: 0xf015 LD DT, V0
+	*c.DT = c.V[0x0]
+	// 0x4ea: 0x1424 JP 0x424
+	*c.PC = 0x424
+}
+
+func block4ec(c *chip8.Context) {
+	// 0x4ec: 0x4301 SNE V3, 0x01
+	if c.V[0x3] != 0x01 {
+		*c.PC = 0x4f0
+		return
+	}
+	*c.PC = 0x4ee
+}
+
+func block4ee(c *chip8.Context) {
+	// 0x4ee: 0x643a LD V4, 0x3a
+	c.V[0x4] = 0x3a
+	*c.PC = 0x4f0
+}
+
+func block4f0(c *chip8.Context) {
+	// 0x4f0: 0x4302 SNE V3, 0x02
+	if c.V[0x3] != 0x02 {
+		*c.PC = 0x4f4
+		return
+	}
+	*c.PC = 0x4f2
+}
+
+func block4f2(c *chip8.Context) {
+	// 0x4f2: 0x6400 LD V4, 0x00
+	c.V[0x4] = 0x00
+	*c.PC = 0x4f4
+}
+
+func block4f4(c *chip8.Context) {
+	// 0x4f4: 0x1424 JP 0x424
+	*c.PC = 0x424
+}
+
+func block4f6(c *chip8.Context) {
+	// 0x4f6: 0x8270 LD V2, V7
+	c.V[0x2] = c.V[0x7]
+	// 0x4f8: 0x8370 LD V3, V7
+	c.V[0x3] = c.V[0x7]
+	// 0x4fa: 0x6e0c LD Ve, 0x0c
+	c.V[0xe] = 0x0c
+	// 0x4fc: 0x82e2 AND V2, Ve
+	c.V[0x2] &= c.V[0xe]
+	// 0x4fe: 0x80a0 LD V0, Va
+	c.V[0x0] = c.V[0xa]
+	// 0x500: 0x81b0 LD V1, Vb
+	c.V[0x1] = c.V[0xb]
+	*c.PC = 0x502
+}
+
+func block504(c *chip8.Context) {
+	// 0x504: 0xa8ed LD I, 0x8ed
+	*c.I = 0x8ed
+	// 0x506: 0x6ef0 LD Ve, 0xf0
+	c.V[0xe] = 0xf0
+	// 0x508: 0x80e2 AND V0, Ve
+	c.V[0x0] &= c.V[0xe]
+	// 0x50a: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x50e
+		return
+	}
+	*c.PC = 0x50c
+}
+
+func block50c(c *chip8.Context) {
+	// 0x50c: 0x1524 JP 0x524
+	*c.PC = 0x524
+}
+
+func block50e(c *chip8.Context) {
+	// 0x50e: 0xdab4 DRW Va, Vb, 0x4
+	c.Exec(0x50e)
+	// 0x510: 0x420c SNE V2, 0x0c
+	if c.V[0x2] != 0x0c {
+		*c.PC = 0x514
+		return
+	}
+	*c.PC = 0x512
+}
+
+func block512(c *chip8.Context) {
+	// 0x512: 0x7b02 ADD Vb, 0x02
+	c.V[0xb] += 0x02
+	*c.PC = 0x514
+}
+
+func block514(c *chip8.Context) {
+	// 0x514: 0x4200 SNE V2, 0x00
+	if c.V[0x2] != 0x00 {
+		*c.PC = 0x518
+		return
+	}
+	*c.PC = 0x516
+}
+
+func block516(c *chip8.Context) {
+	// 0x516: 0x7bfe ADD Vb, 0xfe
+	c.V[0xb] += 0xfe
+	*c.PC = 0x518
+}
+
+func block518(c *chip8.Context) {
+	// 0x518: 0x4208 SNE V2, 0x08
+	if c.V[0x2] != 0x08 {
+		*c.PC = 0x51c
+		return
+	}
+	*c.PC = 0x51a
+}
+
+func block51a(c *chip8.Context) {
+	// 0x51a: 0x7a02 ADD Va, 0x02
+	c.V[0xa] += 0x02
+	*c.PC = 0x51c
+}
+
+func block51c(c *chip8.Context) {
+	// 0x51c: 0x4204 SNE V2, 0x04
+	if c.V[0x2] != 0x04 {
+		*c.PC = 0x520
+		return
+	}
+	*c.PC = 0x51e
+}
+
+func block51e(c *chip8.Context) {
+	// 0x51e: 0x7afe ADD Va, 0xfe
+	c.V[0xa] += 0xfe
+	*c.PC = 0x520
+}
+
+func block520(c *chip8.Context) {
+	// 0x520: 0xdab4 DRW Va, Vb, 0x4
+	c.Exec(0x520)
+	*c.PC = 0x522
+}
+
+func block524(c *chip8.Context) {
+	// 0x524: 0x6e80 LD Ve, 0x80
+	c.V[0xe] = 0x80
+	// 0x526: 0xf107 LD V1, DT
+	c.V[0x1] = *c.DT
+	// 0x528: 0x3100 SE V1, 0x00
+	if c.V[0x1] == 0x00 {
+		*c.PC = 0x52c
+		return
+	}
+	*c.PC = 0x52a
+}
+
+func block52a(c *chip8.Context) {
+	// 0x52a: 0x15d4 JP 0x5d4
+	*c.PC = 0x5d4
+}
+
+func block52c(c *chip8.Context) {
+	// 0x52c: 0x3400 SE V4, 0x00
+	if c.V[0x4] == 0x00 {
+		*c.PC = 0x530
+		return
+	}
+	*c.PC = 0x52e
+}
+
+func block52e(c *chip8.Context) {
+	// 0x52e: 0x15d4 JP 0x5d4
+	*c.PC = 0x5d4
+}
+
+func block530(c *chip8.Context) {
+	// 0x530: 0x8100 LD V1, V0
+	c.V[0x1] = c.V[0x0]
+	// 0x532: 0x830e SHL V3, V0
+	c.Exec(0x532)
+	// 0x534: 0x3f00 SE Vf, 0x00
+	if c.V[0xf] == 0x00 {
+		*c.PC = 0x538
+		return
+	}
+	*c.PC = 0x536
+}
+
+func block536(c *chip8.Context) {
+	// 0x536: 0x1556 JP 0x556
+	*c.PC = 0x556
+}
+
+func block538(c *chip8.Context) {
+	// 0x538: 0x8390 LD V3, V9
+	c.V[0x3] = c.V[0x9]
+	// 0x53a: 0x83b5 SUB V3, Vb
+	c.Exec(0x53a)
+	// 0x53c: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x540
+		return
+	}
+	*c.PC = 0x53e
+}
+
+func block53e(c *chip8.Context) {
+	// 0x53e: 0x158c JP 0x58c
+	*c.PC = 0x58c
+}
+
+func block540(c *chip8.Context) {
+	// 0x540: 0x3300 SE V3, 0x00
+	if c.V[0x3] == 0x00 {
+		*c.PC = 0x544
+		return
+	}
+	*c.PC = 0x542
+}
+
+func block542(c *chip8.Context) {
+	// 0x542: 0x1574 JP 0x574
+	*c.PC = 0x574
+}
+
+func block544(c *chip8.Context) {
+	// 0x544: 0x87e3 XOR V7, Ve
+	c.V[0x7] ^= c.V[0xe]
+	// 0x546: 0x8380 LD V3, V8
+	c.V[0x3] = c.V[0x8]
+	// 0x548: 0x83a5 SUB V3, Va
+	c.Exec(0x548)
+	// 0x54a: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x54e
+		return
+	}
+	*c.PC = 0x54c
+}
+
+func block54c(c *chip8.Context) {
+	// 0x54c: 0x15bc JP 0x5bc
+	*c.PC = 0x5bc
+}
+
+func block54e(c *chip8.Context) {
+	// 0x54e: 0x3300 SE V3, 0x00
+	if c.V[0x3] == 0x00 {
+		*c.PC = 0x552
+		return
+	}
+	*c.PC = 0x550
+}
+
+func block550(c *chip8.Context) {
+	// 0x550: 0x15a4 JP 0x5a4
+	*c.PC = 0x5a4
+}
+
+func block552(c *chip8.Context) {
+	// 0x552: 0x87e3 XOR V7, Ve
+	c.V[0x7] ^= c.V[0xe]
+	// 0x554: 0x15d4 JP 0x5d4
+	*c.PC = 0x5d4
+}
+
+func block556(c *chip8.Context) {
+	// 0x556: 0x8380 LD V3, V8
+	c.V[0x3] = c.V[0x8]
+	// 0x558: 0x83a5 SUB V3, Va
+	c.Exec(0x558)
+	// 0x55a: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x55e
+		return
+	}
+	*c.PC = 0x55c
+}
+
+func block55c(c *chip8.Context) {
+	// 0x55c: 0x15bc JP 0x5bc
+	*c.PC = 0x5bc
+}
+
+func block55e(c *chip8.Context) {
+	// 0x55e: 0x3300 SE V3, 0x00
+	if c.V[0x3] == 0x00 {
+		*c.PC = 0x562
+		return
+	}
+	*c.PC = 0x560
+}
+
+func block560(c *chip8.Context) {
+	// 0x560: 0x15a4 JP 0x5a4
+	*c.PC = 0x5a4
+}
+
+func block562(c *chip8.Context) {
+	// 0x562: 0x87e3 XOR V7, Ve
+	c.V[0x7] ^= c.V[0xe]
+	// 0x564: 0x8390 LD V3, V9
+	c.V[0x3] = c.V[0x9]
+	// 0x566: 0x83b5 SUB V3, Vb
+	c.Exec(0x566)
+	// 0x568: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x56c
+		return
+	}
+	*c.PC = 0x56a
+}
+
+func block56a(c *chip8.Context) {
+	// 0x56a: 0x158c JP 0x58c
+	*c.PC = 0x58c
+}
+
+func block56c(c *chip8.Context) {
+	// 0x56c: 0x3300 SE V3, 0x00
+	if c.V[0x3] == 0x00 {
+		*c.PC = 0x570
+		return
+	}
+	*c.PC = 0x56e
+}
+
+func block56e(c *chip8.Context) {
+	// 0x56e: 0x1574 JP 0x574
+	*c.PC = 0x574
+}
+
+func block570(c *chip8.Context) {
+	// 0x570: 0x87e3 XOR V7, Ve
+	c.V[0x7] ^= c.V[0xe]
+	// 0x572: 0x15d4 JP 0x5d4
+	*c.PC = 0x5d4
+}
+
+func block574(c *chip8.Context) {
+	// 0x574: 0x6340 LD V3, 0x40
+	c.V[0x3] = 0x40
+	// 0x576: 0x8132 AND V1, V3
+	c.V[0x1] &= c.V[0x3]
+	// 0x578: 0x4100 SNE V1, 0x00
+	if c.V[0x1] != 0x00 {
+		*c.PC = 0x57c
+		return
+	}
+	*c.PC = 0x57a
+}
+
+func block57a(c *chip8.Context) {
+	// 0x57a: 0x15d4 JP 0x5d4
+	*c.PC = 0x5d4
+}
+
+func block57c(c *chip8.Context) {
+	// 0x57c: 0xdab4 DRW Va, Vb, 0x4
+	c.Exec(0x57c)
+	// 0x57e: 0x7b02 ADD Vb, 0x02
+	c.V[0xb] += 0x02
+	// 0x580: 0xdab4 DRW Va, Vb, 0x4
+	c.Exec(0x580)
+	// 0x582: 0x6ef3 LD Ve, 0xf3
+	c.V[0xe] = 0xf3
+	// 0x584: 0x87e2 AND V7, Ve
+	c.V[0x7] &= c.V[0xe]
+	// 0x586: 0x620c LD V2, 0x0c
+	c.V[0x2] = 0x0c
+	// 0x588: 0x8721 OR V7, V2
+	c.V[0x7] |= c.V[0x2]
+	*c.PC = 0x58a
+}
+
+func block58c(c *chip8.Context) {
+	// 0x58c: 0x6310 LD V3, 0x10
+	c.V[0x3] = 0x10
+	// 0x58e: 0x8132 AND V1, V3
+	c.V[0x1] &= c.V[0x3]
+	// 0x590: 0x4100 SNE V1, 0x00
+	if c.V[0x1] != 0x00 {
+		*c.PC = 0x594
+		return
+	}
+	*c.PC = 0x592
+}
+
+func block592(c *chip8.Context) {
+	// 0x592: 0x15d4 JP 0x5d4
+	*c.PC = 0x5d4
+}
+
+func block594(c *chip8.Context) {
+	// 0x594: 0xdab4 DRW Va, Vb, 0x4
+	c.Exec(0x594)
+	// 0x596: 0x7bfe ADD Vb, 0xfe
+	c.V[0xb] += 0xfe
+	// 0x598: 0xdab4 DRW Va, Vb, 0x4
+	c.Exec(0x598)
+	// 0x59a: 0x6ef3 LD Ve, 0xf3
+	c.V[0xe] = 0xf3
+	// 0x59c: 0x87e2 AND V7, Ve
+	c.V[0x7] &= c.V[0xe]
+	// 0x59e: 0x6200 LD V2, 0x00
+	c.V[0x2] = 0x00
+	// 0x5a0: 0x8721 OR V7, V2
+	c.V[0x7] |= c.V[0x2]
+	*c.PC = 0x5a2
+}
+
+func block5a4(c *chip8.Context) {
+	// 0x5a4: 0x6320 LD V3, 0x20
+	c.V[0x3] = 0x20
+	// 0x5a6: 0x8132 AND V1, V3
+	c.V[0x1] &= c.V[0x3]
+	// 0x5a8: 0x4100 SNE V1, 0x00
+	if c.V[0x1] != 0x00 {
+		*c.PC = 0x5ac
+		return
+	}
+	*c.PC = 0x5aa
+}
+
+func block5aa(c *chip8.Context) {
+	// 0x5aa: 0x15d4 JP 0x5d4
+	*c.PC = 0x5d4
+}
+
+func block5ac(c *chip8.Context) {
+	// 0x5ac: 0xdab4 DRW Va, Vb, 0x4
+	c.Exec(0x5ac)
+	// 0x5ae: 0x7a02 ADD Va, 0x02
+	c.V[0xa] += 0x02
+	// 0x5b0: 0xdab4 DRW Va, Vb, 0x4
+	c.Exec(0x5b0)
+	// 0x5b2: 0x6ef3 LD Ve, 0xf3
+	c.V[0xe] = 0xf3
+	// 0x5b4: 0x87e2 AND V7, Ve
+	c.V[0x7] &= c.V[0xe]
+	// 0x5b6: 0x6208 LD V2, 0x08
+	c.V[0x2] = 0x08
+	// 0x5b8: 0x8721 OR V7, V2
+	c.V[0x7] |= c.V[0x2]
+	*c.PC = 0x5ba
+}
+
+func block5bc(c *chip8.Context) {
+	// 0x5bc: 0x6380 LD V3, 0x80
+	c.V[0x3] = 0x80
+	// 0x5be: 0x8132 AND V1, V3
+	c.V[0x1] &= c.V[0x3]
+	// 0x5c0: 0x4100 SNE V1, 0x00
+	if c.V[0x1] != 0x00 {
+		*c.PC = 0x5c4
+		return
+	}
+	*c.PC = 0x5c2
+}
+
+func block5c2(c *chip8.Context) {
+	// 0x5c2: 0x15d4 JP 0x5d4
+	*c.PC = 0x5d4
+}
+
+func block5c4(c *chip8.Context) {
+	// 0x5c4: 0xdab4 DRW Va, Vb, 0x4
+	c.Exec(0x5c4)
+	// 0x5c6: 0x7afe ADD Va, 0xfe
+	c.V[0xa] += 0xfe
+	// 0x5c8: 0xdab4 DRW Va, Vb, 0x4
+	c.Exec(0x5c8)
+	// 0x5ca: 0x6ef3 LD Ve, 0xf3
+	c.V[0xe] = 0xf3
+	// 0x5cc: 0x87e2 AND V7, Ve
+	c.V[0x7] &= c.V[0xe]
+	// 0x5ce: 0x6204 LD V2, 0x04
+	c.V[0x2] = 0x04
+	// 0x5d0: 0x8721 OR V7, V2
+	c.V[0x7] |= c.V[0x2]
+	*c.PC = 0x5d2
+}
+
+func block5d4(c *chip8.Context) {
+	// 0x5d4: 0xc1f0 RND V1, byte
+	c.Exec(0x5d4)
+	// 0x5d6: 0x8012 AND V0, V1
+	c.V[0x0] &= c.V[0x1]
+	// 0x5d8: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x5dc
+		return
+	}
+	*c.PC = 0x5da
+}
+
+func block5da(c *chip8.Context) {
+	// 0x5da: 0x15e4 JP 0x5e4
+	*c.PC = 0x5e4
+}
+
+func block5dc(c *chip8.Context) {
+	// 0x5dc: 0x6e0c LD Ve, 0x0c
+	c.V[0xe] = 0x0c
+	// 0x5de: 0x87e3 XOR V7, Ve
+	c.V[0x7] ^= c.V[0xe]
+	// 0x5e0: 0x82e3 XOR V2, Ve
+	c.V[0x2] ^= c.V[0xe]
+	// 0x5e2: 0x150e JP 0x50e
+	*c.PC = 0x50e
+}
+
+func block5e4(c *chip8.Context) {
+	// 0x5e4: 0xdab4 DRW Va, Vb, 0x4
+	c.Exec(0x5e4)
+	// 0x5e6: 0x800e SHL V0, V0
+	c.Exec(0x5e6)
+	// 0x5e8: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x5ec
+		return
+	}
+	*c.PC = 0x5ea
+}
+
+func block5ea(c *chip8.Context) {
+	// 0x5ea: 0x15f2 JP 0x5f2
+	*c.PC = 0x5f2
+}
+
+func block5ec(c *chip8.Context) {
+	// 0x5ec: 0x6204 LD V2, 0x04
+	c.V[0x2] = 0x04
+	// 0x5ee: 0x7afe ADD Va, 0xfe
+	c.V[0xa] += 0xfe
+	// 0x5f0: 0x1614 JP 0x614
+	*c.PC = 0x614
+}
+
+func block5f2(c *chip8.Context) {
+	// 0x5f2: 0x800e SHL V0, V0
+	c.Exec(0x5f2)
+	// 0x5f4: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x5f8
+		return
+	}
+	*c.PC = 0x5f6
+}
+
+func block5f6(c *chip8.Context) {
+	// 0x5f6: 0x15fe JP 0x5fe
+	*c.PC = 0x5fe
+}
+
+func block5f8(c *chip8.Context) {
+	// 0x5f8: 0x620c LD V2, 0x0c
+	c.V[0x2] = 0x0c
+	// 0x5fa: 0x7b02 ADD Vb, 0x02
+	c.V[0xb] += 0x02
+	// 0x5fc: 0x1614 JP 0x614
+	*c.PC = 0x614
+}
+
+func block5fe(c *chip8.Context) {
+	// 0x5fe: 0x800e SHL V0, V0
+	c.Exec(0x5fe)
+	// 0x600: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x604
+		return
+	}
+	*c.PC = 0x602
+}
+
+func block602(c *chip8.Context) {
+	// 0x602: 0x160a JP 0x60a
+	*c.PC = 0x60a
+}
+
+func block604(c *chip8.Context) {
+	// 0x604: 0x6208 LD V2, 0x08
+	c.V[0x2] = 0x08
+	// 0x606: 0x7a02 ADD Va, 0x02
+	c.V[0xa] += 0x02
+	// 0x608: 0x1614 JP 0x614
+	*c.PC = 0x614
+}
+
+func block60a(c *chip8.Context) {
+	// 0x60a: 0x800e SHL V0, V0
+	c.Exec(0x60a)
+	// 0x60c: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x610
+		return
+	}
+	*c.PC = 0x60e
+}
+
+func block60e(c *chip8.Context) {
+	// 0x60e: 0x15dc JP 0x5dc
+	*c.PC = 0x5dc
+}
+
+func block610(c *chip8.Context) {
+	// 0x610: 0x6200 LD V2, 0x00
+	c.V[0x2] = 0x00
+	// 0x612: 0x7bfe ADD Vb, 0xfe
+	c.V[0xb] += 0xfe
+	*c.PC = 0x614
+}
+
+func block614(c *chip8.Context) {
+	// 0x614: 0xdab4 DRW Va, Vb, 0x4
+	c.Exec(0x614)
+	// 0x616: 0x6ef3 LD Ve, 0xf3
+	c.V[0xe] = 0xf3
+	// 0x618: 0x87e2 AND V7, Ve
+	c.V[0x7] &= c.V[0xe]
+	// 0x61a: 0x8721 OR V7, V2
+	c.V[0x7] |= c.V[0x2]
+	*c.PC = 0x61c
+}
+
+func block61e(c *chip8.Context) {
+	// 0x61e: 0x8270 LD V2, V7
+	c.V[0x2] = c.V[0x7]
+	// 0x620: 0x8370 LD V3, V7
+	c.V[0x3] = c.V[0x7]
+	// 0x622: 0x6e30 LD Ve, 0x30
+	c.V[0xe] = 0x30
+	// 0x624: 0x82e2 AND V2, Ve
+	c.V[0x2] &= c.V[0xe]
+	// 0x626: 0x80c0 LD V0, Vc
+	c.V[0x0] = c.V[0xc]
+	// 0x628: 0x81d0 LD V1, Vd
+	c.V[0x1] = c.V[0xd]
+	*c.PC = 0x62a
+}
+
+func block62c(c *chip8.Context) {
+	// 0x62c: 0xa8ed LD I, 0x8ed
+	*c.I = 0x8ed
+	// 0x62e: 0x6ef0 LD Ve, 0xf0
+	c.V[0xe] = 0xf0
+	// 0x630: 0x80e2 AND V0, Ve
+	c.V[0x0] &= c.V[0xe]
+	// 0x632: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x636
+		return
+	}
+	*c.PC = 0x634
+}
+
+func block634(c *chip8.Context) {
+	// 0x634: 0x164c JP 0x64c
+	*c.PC = 0x64c
+}
+
+func block636(c *chip8.Context) {
+	// 0x636: 0xdcd4 DRW Vc, Vd, 0x4
+	c.Exec(0x636)
+	// 0x638: 0x4230 SNE V2, 0x30
+	if c.V[0x2] != 0x30 {
+		*c.PC = 0x63c
+		return
+	}
+	*c.PC = 0x63a
+}
+
+func block63a(c *chip8.Context) {
+	// 0x63a: 0x7d02 ADD Vd, 0x02
+	c.V[0xd] += 0x02
+	*c.PC = 0x63c
+}
+
+func block63c(c *chip8.Context) {
+	// 0x63c: 0x4200 SNE V2, 0x00
+	if c.V[0x2] != 0x00 {
+		*c.PC = 0x640
+		return
+	}
+	*c.PC = 0x63e
+}
+
+func block63e(c *chip8.Context) {
+	// 0x63e: 0x7dfe ADD Vd, 0xfe
+	c.V[0xd] += 0xfe
+	*c.PC = 0x640
+}
+
+func block640(c *chip8.Context) {
+	// 0x640: 0x4220 SNE V2, 0x20
+	if c.V[0x2] != 0x20 {
+		*c.PC = 0x644
+		return
+	}
+	*c.PC = 0x642
+}
+
+func block642(c *chip8.Context) {
+	// 0x642: 0x7c02 ADD Vc, 0x02
+	c.V[0xc] += 0x02
+	*c.PC = 0x644
+}
+
+func block644(c *chip8.Context) {
+	// 0x644: 0x4210 SNE V2, 0x10
+	if c.V[0x2] != 0x10 {
+		*c.PC = 0x648
+		return
+	}
+	*c.PC = 0x646
+}
+
+func block646(c *chip8.Context) {
+	// 0x646: 0x7cfe ADD Vc, 0xfe
+	c.V[0xc] += 0xfe
+	*c.PC = 0x648
+}
+
+func block648(c *chip8.Context) {
+	// 0x648: 0xdcd4 DRW Vc, Vd, 0x4
+	c.Exec(0x648)
+	*c.PC = 0x64a
+}
+
+func block64c(c *chip8.Context) {
+	// 0x64c: 0x6e80 LD Ve, 0x80
+	c.V[0xe] = 0x80
+	// 0x64e: 0xf107 LD V1, DT
+	c.V[0x1] = *c.DT
+	// 0x650: 0x3100 SE V1, 0x00
+	if c.V[0x1] == 0x00 {
+		*c.PC = 0x654
+		return
+	}
+	*c.PC = 0x652
+}
+
+func block652(c *chip8.Context) {
+	// 0x652: 0x1704 JP 0x704
+	*c.PC = 0x704
+}
+
+func block654(c *chip8.Context) {
+	// 0x654: 0x3400 SE V4, 0x00
+	if c.V[0x4] == 0x00 {
+		*c.PC = 0x658
+		return
+	}
+	*c.PC = 0x656
+}
+
+func block656(c *chip8.Context) {
+	// 0x656: 0x1704 JP 0x704
+	*c.PC = 0x704
+}
+
+func block658(c *chip8.Context) {
+	// 0x658: 0x8100 LD V1, V0
+	c.V[0x1] = c.V[0x0]
+	// 0x65a: 0x830e SHL V3, V0
+	c.Exec(0x65a)
+	// 0x65c: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x660
+		return
+	}
+	*c.PC = 0x65e
+}
+
+func block65e(c *chip8.Context) {
+	// 0x65e: 0x167e JP 0x67e
+	*c.PC = 0x67e
+}
+
+func block660(c *chip8.Context) {
+	// 0x660: 0x8390 LD V3, V9
+	c.V[0x3] = c.V[0x9]
+	// 0x662: 0x83d5 SUB V3, Vd
+	c.Exec(0x662)
+	// 0x664: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x668
+		return
+	}
+	*c.PC = 0x666
+}
+
+func block666(c *chip8.Context) {
+	// 0x666: 0x16b6 JP 0x6b6
+	*c.PC = 0x6b6
+}
+
+func block668(c *chip8.Context) {
+	// 0x668: 0x3300 SE V3, 0x00
+	if c.V[0x3] == 0x00 {
+		*c.PC = 0x66c
+		return
+	}
+	*c.PC = 0x66a
+}
+
+func block66a(c *chip8.Context) {
+	// 0x66a: 0x169c JP 0x69c
+	*c.PC = 0x69c
+}
+
+func block66c(c *chip8.Context) {
+	// 0x66c: 0x87e3 XOR V7, Ve
+	c.V[0x7] ^= c.V[0xe]
+	// 0x66e: 0x8380 LD V3, V8
+	c.V[0x3] = c.V[0x8]
+	// 0x670: 0x83c5 SUB V3, Vc
+	c.Exec(0x670)
+	// 0x672: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x676
+		return
+	}
+	*c.PC = 0x674
+}
+
+func block674(c *chip8.Context) {
+	// 0x674: 0x16ea JP 0x6ea
+	*c.PC = 0x6ea
+}
+
+func block676(c *chip8.Context) {
+	// 0x676: 0x3300 SE V3, 0x00
+	if c.V[0x3] == 0x00 {
+		*c.PC = 0x67a
+		return
+	}
+	*c.PC = 0x678
+}
+
+func block678(c *chip8.Context) {
+	// 0x678: 0x16d0 JP 0x6d0
+	*c.PC = 0x6d0
+}
+
+func block67a(c *chip8.Context) {
+	// 0x67a: 0x87e3 XOR V7, Ve
+	c.V[0x7] ^= c.V[0xe]
+	// 0x67c: 0x1704 JP 0x704
+	*c.PC = 0x704
+}
+
+func block67e(c *chip8.Context) {
+	// 0x67e: 0x8380 LD V3, V8
+	c.V[0x3] = c.V[0x8]
+	// 0x680: 0x83c5 SUB V3, Vc
+	c.Exec(0x680)
+	// 0x682: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x686
+		return
+	}
+	*c.PC = 0x684
+}
+
+func block684(c *chip8.Context) {
+	// 0x684: 0x16ea JP 0x6ea
+	*c.PC = 0x6ea
+}
+
+func block686(c *chip8.Context) {
+	// 0x686: 0x3300 SE V3, 0x00
+	if c.V[0x3] == 0x00 {
+		*c.PC = 0x68a
+		return
+	}
+	*c.PC = 0x688
+}
+
+func block688(c *chip8.Context) {
+	// 0x688: 0x16d0 JP 0x6d0
+	*c.PC = 0x6d0
+}
+
+func block68a(c *chip8.Context) {
+	// 0x68a: 0x87e3 XOR V7, Ve
+	c.V[0x7] ^= c.V[0xe]
+	// 0x68c: 0x8390 LD V3, V9
+	c.V[0x3] = c.V[0x9]
+	// 0x68e: 0x83d5 SUB V3, Vd
+	c.Exec(0x68e)
+	// 0x690: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x694
+		return
+	}
+	*c.PC = 0x692
+}
+
+func block692(c *chip8.Context) {
+	// 0x692: 0x16b6 JP 0x6b6
+	*c.PC = 0x6b6
+}
+
+func block694(c *chip8.Context) {
+	// 0x694: 0x3300 SE V3, 0x00
+	if c.V[0x3] == 0x00 {
+		*c.PC = 0x698
+		return
+	}
+	*c.PC = 0x696
+}
+
+func block696(c *chip8.Context) {
+	// 0x696: 0x169c JP 0x69c
+	*c.PC = 0x69c
+}
+
+func block698(c *chip8.Context) {
+	// 0x698: 0x87e3 XOR V7, Ve
+	c.V[0x7] ^= c.V[0xe]
+	// 0x69a: 0x1704 JP 0x704
+	*c.PC = 0x704
+}
+
+func block69c(c *chip8.Context) {
+	// 0x69c: 0x6340 LD V3, 0x40
+	c.V[0x3] = 0x40
+	// 0x69e: 0x8132 AND V1, V3
+	c.V[0x1] &= c.V[0x3]
+	// 0x6a0: 0x4100 SNE V1, 0x00
+	if c.V[0x1] != 0x00 {
+		*c.PC = 0x6a4
+		return
+	}
+	*c.PC = 0x6a2
+}
+
+func block6a2(c *chip8.Context) {
+	// 0x6a2: 0x1704 JP 0x704
+	*c.PC = 0x704
+}
+
+func block6a4(c *chip8.Context) {
+	// 0x6a4: 0xdcd4 DRW Vc, Vd, 0x4
+	c.Exec(0x6a4)
+	// 0x6a6: 0x7d02 ADD Vd, 0x02
+	c.V[0xd] += 0x02
+	// 0x6a8: 0xdcd4 DRW Vc, Vd, 0x4
+	c.Exec(0x6a8)
+	// 0x6aa: 0x87e3 XOR V7, Ve
+	c.V[0x7] ^= c.V[0xe]
+	// 0x6ac: 0x6ecf LD Ve, 0xcf
+	c.V[0xe] = 0xcf
+	// 0x6ae: 0x87e2 AND V7, Ve
+	c.V[0x7] &= c.V[0xe]
+	// 0x6b0: 0x6230 LD V2, 0x30
+	c.V[0x2] = 0x30
+	// 0x6b2: 0x8721 OR V7, V2
+	c.V[0x7] |= c.V[0x2]
+	*c.PC = 0x6b4
+}
+
+func block6b6(c *chip8.Context) {
+	// 0x6b6: 0x6310 LD V3, 0x10
+	c.V[0x3] = 0x10
+	// 0x6b8: 0x8132 AND V1, V3
+	c.V[0x1] &= c.V[0x3]
+	// 0x6ba: 0x4100 SNE V1, 0x00
+	if c.V[0x1] != 0x00 {
+		*c.PC = 0x6be
+		return
+	}
+	*c.PC = 0x6bc
+}
+
+func block6bc(c *chip8.Context) {
+	// 0x6bc: 0x1704 JP 0x704
+	*c.PC = 0x704
+}
+
+func block6be(c *chip8.Context) {
+	// 0x6be: 0xdcd4 DRW Vc, Vd, 0x4
+	c.Exec(0x6be)
+	// 0x6c0: 0x7dfe ADD Vd, 0xfe
+	c.V[0xd] += 0xfe
+	// 0x6c2: 0xdcd4 DRW Vc, Vd, 0x4
+	c.Exec(0x6c2)
+	// 0x6c4: 0x87e3 XOR V7, Ve
+	c.V[0x7] ^= c.V[0xe]
+	// 0x6c6: 0x6ecf LD Ve, 0xcf
+	c.V[0xe] = 0xcf
+	// 0x6c8: 0x87e2 AND V7, Ve
+	c.V[0x7] &= c.V[0xe]
+	// 0x6ca: 0x6200 LD V2, 0x00
+	c.V[0x2] = 0x00
+	// 0x6cc: 0x8721 OR V7, V2
+	c.V[0x7] |= c.V[0x2]
+	*c.PC = 0x6ce
+}
+
+func block6d0(c *chip8.Context) {
+	// 0x6d0: 0x6320 LD V3, 0x20
+	c.V[0x3] = 0x20
+	// 0x6d2: 0x8132 AND V1, V3
+	c.V[0x1] &= c.V[0x3]
+	// 0x6d4: 0x4100 SNE V1, 0x00
+	if c.V[0x1] != 0x00 {
+		*c.PC = 0x6d8
+		return
+	}
+	*c.PC = 0x6d6
+}
+
+func block6d6(c *chip8.Context) {
+	// 0x6d6: 0x1704 JP 0x704
+	*c.PC = 0x704
+}
+
+func block6d8(c *chip8.Context) {
+	// 0x6d8: 0xdcd4 DRW Vc, Vd, 0x4
+	c.Exec(0x6d8)
+	// 0x6da: 0x7c02 ADD Vc, 0x02
+	c.V[0xc] += 0x02
+	// 0x6dc: 0xdcd4 DRW Vc, Vd, 0x4
+	c.Exec(0x6dc)
+	// 0x6de: 0x87e3 XOR V7, Ve
+	c.V[0x7] ^= c.V[0xe]
+	// 0x6e0: 0x6ecf LD Ve, 0xcf
+	c.V[0xe] = 0xcf
+	// 0x6e2: 0x87e2 AND V7, Ve
+	c.V[0x7] &= c.V[0xe]
+	// 0x6e4: 0x6220 LD V2, 0x20
+	c.V[0x2] = 0x20
+	// 0x6e6: 0x8721 OR V7, V2
+	c.V[0x7] |= c.V[0x2]
+	*c.PC = 0x6e8
+}
+
+func block6ea(c *chip8.Context) {
+	// 0x6ea: 0x6380 LD V3, 0x80
+	c.V[0x3] = 0x80
+	// 0x6ec: 0x8132 AND V1, V3
+	c.V[0x1] &= c.V[0x3]
+	// 0x6ee: 0x4100 SNE V1, 0x00
+	if c.V[0x1] != 0x00 {
+		*c.PC = 0x6f2
+		return
+	}
+	*c.PC = 0x6f0
+}
+
+func block6f0(c *chip8.Context) {
+	// 0x6f0: 0x1704 JP 0x704
+	*c.PC = 0x704
+}
+
+func block6f2(c *chip8.Context) {
+	// 0x6f2: 0xdcd4 DRW Vc, Vd, 0x4
+	c.Exec(0x6f2)
+	// 0x6f4: 0x7cfe ADD Vc, 0xfe
+	c.V[0xc] += 0xfe
+	// 0x6f6: 0xdcd4 DRW Vc, Vd, 0x4
+	c.Exec(0x6f6)
+	// 0x6f8: 0x87e3 XOR V7, Ve
+	c.V[0x7] ^= c.V[0xe]
+	// 0x6fa: 0x6ecf LD Ve, 0xcf
+	c.V[0xe] = 0xcf
+	// 0x6fc: 0x87e2 AND V7, Ve
+	c.V[0x7] &= c.V[0xe]
+	// 0x6fe: 0x6210 LD V2, 0x10
+	c.V[0x2] = 0x10
+	// 0x700: 0x8721 OR V7, V2
+	c.V[0x7] |= c.V[0x2]
+	*c.PC = 0x702
+}
+
+func block704(c *chip8.Context) {
+	// 0x704: 0xc1f0 RND V1, byte
+	c.Exec(0x704)
+	// 0x706: 0x8012 AND V0, V1
+	c.V[0x0] &= c.V[0x1]
+	// 0x708: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x70c
+		return
+	}
+	*c.PC = 0x70a
+}
+
+func block70a(c *chip8.Context) {
+	// 0x70a: 0x1716 JP 0x716
+	*c.PC = 0x716
+}
+
+func block70c(c *chip8.Context) {
+	// 0x70c: 0x87e3 XOR V7, Ve
+	c.V[0x7] ^= c.V[0xe]
+	// 0x70e: 0x6e30 LD Ve, 0x30
+	c.V[0xe] = 0x30
+	// 0x710: 0x87e3 XOR V7, Ve
+	c.V[0x7] ^= c.V[0xe]
+	// 0x712: 0x82e3 XOR V2, Ve
+	c.V[0x2] ^= c.V[0xe]
+	// 0x714: 0x1636 JP 0x636
+	*c.PC = 0x636
+}
+
+func block716(c *chip8.Context) {
+	// 0x716: 0xdcd4 DRW Vc, Vd, 0x4
+	c.Exec(0x716)
+	// 0x718: 0x800e SHL V0, V0
+	c.Exec(0x718)
+	// 0x71a: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x71e
+		return
+	}
+	*c.PC = 0x71c
+}
+
+func block71c(c *chip8.Context) {
+	// 0x71c: 0x1724 JP 0x724
+	*c.PC = 0x724
+}
+
+func block71e(c *chip8.Context) {
+	// 0x71e: 0x6290 LD V2, 0x90
+	c.V[0x2] = 0x90
+	// 0x720: 0x7cfe ADD Vc, 0xfe
+	c.V[0xc] += 0xfe
+	// 0x722: 0x1746 JP 0x746
+	*c.PC = 0x746
+}
+
+func block724(c *chip8.Context) {
+	// 0x724: 0x800e SHL V0, V0
+	c.Exec(0x724)
+	// 0x726: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x72a
+		return
+	}
+	*c.PC = 0x728
+}
+
+func block728(c *chip8.Context) {
+	// 0x728: 0x1730 JP 0x730
+	*c.PC = 0x730
+}
+
+func block72a(c *chip8.Context) {
+	// 0x72a: 0x6230 LD V2, 0x30
+	c.V[0x2] = 0x30
+	// 0x72c: 0x7d02 ADD Vd, 0x02
+	c.V[0xd] += 0x02
+	// 0x72e: 0x1746 JP 0x746
+	*c.PC = 0x746
+}
+
+func block730(c *chip8.Context) {
+	// 0x730: 0x800e SHL V0, V0
+	c.Exec(0x730)
+	// 0x732: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x736
+		return
+	}
+	*c.PC = 0x734
+}
+
+func block734(c *chip8.Context) {
+	// 0x734: 0x173c JP 0x73c
+	*c.PC = 0x73c
+}
+
+func block736(c *chip8.Context) {
+	// 0x736: 0x62a0 LD V2, 0xa0
+	c.V[0x2] = 0xa0
+	// 0x738: 0x7c02 ADD Vc, 0x02
+	c.V[0xc] += 0x02
+	// 0x73a: 0x1746 JP 0x746
+	*c.PC = 0x746
+}
+
+func block73c(c *chip8.Context) {
+	// 0x73c: 0x800e SHL V0, V0
+	c.Exec(0x73c)
+	// 0x73e: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x742
+		return
+	}
+	*c.PC = 0x740
+}
+
+func block740(c *chip8.Context) {
+	// 0x740: 0x170c JP 0x70c
+	*c.PC = 0x70c
+}
+
+func block742(c *chip8.Context) {
+	// 0x742: 0x6200 LD V2, 0x00
+	c.V[0x2] = 0x00
+	// 0x744: 0x7dfe ADD Vd, 0xfe
+	c.V[0xd] += 0xfe
+	*c.PC = 0x746
+}
+
+func block746(c *chip8.Context) {
+	// 0x746: 0xdcd4 DRW Vc, Vd, 0x4
+	c.Exec(0x746)
+	// 0x748: 0x6e4f LD Ve, 0x4f
+	c.V[0xe] = 0x4f
+	// 0x74a: 0x87e2 AND V7, Ve
+	c.V[0x7] &= c.V[0xe]
+	// 0x74c: 0x8721 OR V7, V2
+	c.V[0x7] |= c.V[0x2]
+	*c.PC = 0x74e
+}
+
+func block750(c *chip8.Context) {
+	// 0x750: 0x8070 LD V0, V7
+	c.V[0x0] = c.V[0x7]
+	// 0x752: 0x6e03 LD Ve, 0x03
+	c.V[0xe] = 0x03
+	// 0x754: 0x80e2 AND V0, Ve
+	c.V[0x0] &= c.V[0xe]
+	// 0x756: 0x800e SHL V0, V0
+	c.Exec(0x756)
+	// 0x758: 0x8180 LD V1, V8
+	c.V[0x1] = c.V[0x8]
+	// 0x75a: 0x8194 ADD V1, V9
+	c.Exec(0x75a)
+	// 0x75c: 0x6e02 LD Ve, 0x02
+	c.V[0xe] = 0x02
+	// 0x75e: 0x81e2 AND V1, Ve
+	c.V[0x1] &= c.V[0xe]
+	// 0x760: 0x4100 SNE V1, 0x00
+	if c.V[0x1] != 0x00 {
+		*c.PC = 0x764
+		return
+	}
+	*c.PC = 0x762
+}
+
+func block762(c *chip8.Context) {
+	// 0x762: 0x7001 ADD V0, 0x01
+	c.V[0x0] += 0x01
+	*c.PC = 0x764
+}
+
+func block764(c *chip8.Context) {
+	// 0x764: 0x800e SHL V0, V0
+	c.Exec(0x764)
+	// 0x766: 0x800e SHL V0, V0
+	c.Exec(0x766)
+	// 0x768: 0xa8cd LD I, 0x8cd
+	*c.I = 0x8cd
+	// 0x76a: 0xf01e ADD I, V0
+	*c.I += uint16(c.V[0x0])
+	// 0x76c: 0xd894 DRW V8, V9, 0x4
+	c.Exec(0x76c)
+	// 0x76e: 0x8ef0 LD Ve, Vf
+	c.V[0xe] = c.V[0xf]
+	*c.PC = 0x770
+}
+
+func block772(c *chip8.Context) {
+	// 0x772: 0x6e00 LD Ve, 0x00
+	c.V[0xe] = 0x00
+	*c.PC = 0x774
+}
+
+func block774(c *chip8.Context) {
+	// 0x774: 0xa919 LD I, 0x919
+	*c.I = 0x919
+	// 0x776: 0xfe1e ADD I, Ve
+	*c.I += uint16(c.V[0xe])
+	// 0x778: 0xfe1e ADD I, Ve
+	*c.I += uint16(c.V[0xe])
+	// 0x77a: 0xfe1e ADD I, Ve
+	*c.I += uint16(c.V[0xe])
+	// 0x77c: 0xfe1e ADD I, Ve
+	*c.I += uint16(c.V[0xe])
+	// 0x77e: 0xf365 LD V3, [I]
+	c.Exec(0x77e)
+	// 0x780: 0xab34 LD I, 0xb34
+	*c.I = 0xb34
+	// 0x782: 0xfe1e ADD I, Ve
+	*c.I += uint16(c.V[0xe])
+	// 0x784: 0xfe1e ADD I, Ve
+	*c.I += uint16(c.V[0xe])
+	// 0x786: 0xfe1e ADD I, Ve
+	*c.I += uint16(c.V[0xe])
+	*c.PC = 0x788
+}
+
+func block78c(c *chip8.Context) {
+	// 0x78c: 0x7e01 ADD Ve, 0x01
+	c.V[0xe] += 0x01
+	// 0x78e: 0x3e80 SE Ve, 0x80
+	if c.V[0xe] == 0x80 {
+		*c.PC = 0x792
+		return
+	}
+	*c.PC = 0x790
+}
+
+func block790(c *chip8.Context) {
+	// 0x790: 0x1774 JP 0x774
+	*c.PC = 0x774
+}
+
+func block794(c *chip8.Context) {
+	// 0x794: 0x8223 XOR V2, V2
+	c.V[0x2] ^= c.V[0x2]
+	// 0x796: 0x8333 XOR V3, V3
+	c.V[0x3] ^= c.V[0x3]
+	// 0x798: 0x6e0f LD Ve, 0x0f
+	c.V[0xe] = 0x0f
+	*c.PC = 0x79a
+}
+
+func block79a(c *chip8.Context) {
+	// 0x79a: 0x8020 LD V0, V2
+	c.V[0x0] = c.V[0x2]
+	// 0x79c: 0x8130 LD V1, V3
+	c.V[0x1] = c.V[0x3]
+	*c.PC = 0x79e
+}
+
+func block7a0(c *chip8.Context) {
+	// 0x7a0: 0x80e2 AND V0, Ve
+	c.V[0x0] &= c.V[0xe]
+	// 0x7a2: 0x800e SHL V0, V0
+	c.Exec(0x7a2)
+	// 0x7a4: 0xa8f9 LD I, 0x8f9
+	*c.I = 0x8f9
+	// 0x7a6: 0xf01e ADD I, V0
+	*c.I += uint16(c.V[0x0])
+	// 0x7a8: 0xd232 DRW V2, V3, 0x2
+	c.Exec(0x7a8)
+	// 0x7aa: 0x7202 ADD V2, 0x02
+	c.V[0x2] += 0x02
+	// 0x7ac: 0x3240 SE V2, 0x40
+	if c.V[0x2] == 0x40 {
+		*c.PC = 0x7b0
+		return
+	}
+	*c.PC = 0x7ae
+}
+
+func block7ae(c *chip8.Context) {
+	// 0x7ae: 0x179a JP 0x79a
+	*c.PC = 0x79a
+}
+
+func block7b0(c *chip8.Context) {
+	// 0x7b0: 0x8223 XOR V2, V2
+	c.V[0x2] ^= c.V[0x2]
+	// 0x7b2: 0x7302 ADD V3, 0x02
+	c.V[0x3] += 0x02
+	// 0x7b4: 0x4320 SNE V3, 0x20
+	if c.V[0x3] != 0x20 {
+		*c.PC = 0x7b8
+		return
+	}
+	*c.PC = 0x7b6
+}
+
+func block7b8(c *chip8.Context) {
+	// 0x7b8: 0x179a JP 0x79a
+	*c.PC = 0x79a
+}
+
+func block7ba(c *chip8.Context) {
+	// 0x7ba: 0x7002 ADD V0, 0x02
+	c.V[0x0] += 0x02
+	// 0x7bc: 0x7102 ADD V1, 0x02
+	c.V[0x1] += 0x02
+	*c.PC = 0x7be
+}
+
+func block7be(c *chip8.Context) {
+	// 0x7be: 0x8006 SHR V0, V0
+	c.Exec(0x7be)
+	// 0x7c0: 0x8106 SHR V1, V0
+	c.Exec(0x7c0)
+	// 0x7c2: 0x810e SHL V1, V0
+	c.Exec(0x7c2)
+	// 0x7c4: 0x810e SHL V1, V0
+	c.Exec(0x7c4)
+	// 0x7c6: 0x810e SHL V1, V0
+	c.Exec(0x7c6)
+	// 0x7c8: 0x810e SHL V1, V0
+	c.Exec(0x7c8)
+	// 0x7ca: 0xab34 LD I, 0xb34
+	*c.I = 0xb34
+	// 0x7cc: 0xf11e ADD I, V1
+	*c.I += uint16(c.V[0x1])
+	// 0x7ce: 0xf11e ADD I, V1
+	*c.I += uint16(c.V[0x1])
+	// 0x7d0: 0xf01e ADD I, V0
+	*c.I += uint16(c.V[0x0])
+	*c.PC = 0x7d2
+}
+
+func block7d6(c *chip8.Context) {
+	// 0x7d6: 0xa8cc LD I, 0x8cc
+	*c.I = 0x8cc
+	// 0x7d8: 0xf065 LD V0, [I]
+	c.Exec(0x7d8)
+	// 0x7da: 0x8006 SHR V0, V0
+	c.Exec(0x7da)
+	// 0x7dc: 0xf055 LD [I], V0
+	c.Exec(0x7dc)
+	*c.PC = 0x7de
+}
+
+func block7de(c *chip8.Context) {
+	// 0x7de: 0x6001 LD V0, 0x01
+	c.V[0x0] = 0x01
+	*c.PC = 0x7e0
+}
+
+func block7e0(c *chip8.Context) {
+	// 0x7e0: 0xe0a1 SKNP V0
+	if !c.Pressed(c.V[0x0]) {
+		*c.PC = 0x7e4
+		return
+	}
+	*c.PC = 0x7e2
+}
+
+func block7e2(c *chip8.Context) {
+	// 0x7e2: 0x17e0 JP 0x7e0
+	*c.PC = 0x7e0
+}
+
+func block7e6(c *chip8.Context) {
+	// 0x7e6: 0xf165 LD V1, [I]
+	c.Exec(0x7e6)
+	// 0x7e8: 0x6e01 LD Ve, 0x01
+	c.V[0xe] = 0x01
+	// 0x7ea: 0x8443 XOR V4, V4
+	c.V[0x4] ^= c.V[0x4]
+	// 0x7ec: 0x8200 LD V2, V0
+	c.V[0x2] = c.V[0x0]
+	// 0x7ee: 0x8310 LD V3, V1
+	c.V[0x3] = c.V[0x1]
+	*c.PC = 0x7f0
+}
+
+func block7f0(c *chip8.Context) {
+	// 0x7f0: 0x6510 LD V5, 0x10
+	c.V[0x5] = 0x10
+	// 0x7f2: 0x8355 SUB V3, V5
+	c.Exec(0x7f2)
+	// 0x7f4: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x7f8
+		return
+	}
+	*c.PC = 0x7f6
+}
+
+func block7f6(c *chip8.Context) {
+	// 0x7f6: 0x82e5 SUB V2, Ve
+	c.Exec(0x7f6)
+	*c.PC = 0x7f8
+}
+
+func block7f8(c *chip8.Context) {
+	// 0x7f8: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x7fc
+		return
+	}
+	*c.PC = 0x7fa
+}
+
+func block7fa(c *chip8.Context) {
+	// 0x7fa: 0x180c JP 0x80c
+	*c.PC = 0x80c
+}
+
+func block7fc(c *chip8.Context) {
+	// 0x7fc: 0x6527 LD V5, 0x27
+	c.V[0x5] = 0x27
+	// 0x7fe: 0x8255 SUB V2, V5
+	c.Exec(0x7fe)
+	// 0x800: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x804
+		return
+	}
+	*c.PC = 0x802
+}
+
+func block802(c *chip8.Context) {
+	// 0x802: 0x180c JP 0x80c
+	*c.PC = 0x80c
+}
+
+func block804(c *chip8.Context) {
+	// 0x804: 0x8020 LD V0, V2
+	c.V[0x0] = c.V[0x2]
+	// 0x806: 0x8130 LD V1, V3
+	c.V[0x1] = c.V[0x3]
+	// 0x808: 0x84e4 ADD V4, Ve
+	c.Exec(0x808)
+	// 0x80a: 0x17f0 JP 0x7f0
+	*c.PC = 0x7f0
+}
+
+func block80c(c *chip8.Context) {
+	// 0x80c: 0xf429 LD F, V4
+	*c.I = uint16(c.V[0x4] * 5)
+	// 0x80e: 0xd675 DRW V6, V7, 0x5
+	c.Exec(0x80e)
+	// 0x810: 0x7606 ADD V6, 0x06
+	c.V[0x6] += 0x06
+	// 0x812: 0x8443 XOR V4, V4
+	c.V[0x4] ^= c.V[0x4]
+	// 0x814: 0x8200 LD V2, V0
+	c.V[0x2] = c.V[0x0]
+	// 0x816: 0x8310 LD V3, V1
+	c.V[0x3] = c.V[0x1]
+	*c.PC = 0x818
+}
+
+func block818(c *chip8.Context) {
+	// 0x818: 0x65e8 LD V5, 0xe8
+	c.V[0x5] = 0xe8
+	// 0x81a: 0x8355 SUB V3, V5
+	c.Exec(0x81a)
+	// 0x81c: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x820
+		return
+	}
+	*c.PC = 0x81e
+}
+
+func block81e(c *chip8.Context) {
+	// 0x81e: 0x82e5 SUB V2, Ve
+	c.Exec(0x81e)
+	*c.PC = 0x820
+}
+
+func block820(c *chip8.Context) {
+	// 0x820: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x824
+		return
+	}
+	*c.PC = 0x822
+}
+
+func block822(c *chip8.Context) {
+	// 0x822: 0x1834 JP 0x834
+	*c.PC = 0x834
+}
+
+func block824(c *chip8.Context) {
+	// 0x824: 0x6503 LD V5, 0x03
+	c.V[0x5] = 0x03
+	// 0x826: 0x8255 SUB V2, V5
+	c.Exec(0x826)
+	// 0x828: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x82c
+		return
+	}
+	*c.PC = 0x82a
+}
+
+func block82a(c *chip8.Context) {
+	// 0x82a: 0x1834 JP 0x834
+	*c.PC = 0x834
+}
+
+func block82c(c *chip8.Context) {
+	// 0x82c: 0x8020 LD V0, V2
+	c.V[0x0] = c.V[0x2]
+	// 0x82e: 0x8130 LD V1, V3
+	c.V[0x1] = c.V[0x3]
+	// 0x830: 0x84e4 ADD V4, Ve
+	c.Exec(0x830)
+	// 0x832: 0x1818 JP 0x818
+	*c.PC = 0x818
+}
+
+func block834(c *chip8.Context) {
+	// 0x834: 0xf429 LD F, V4
+	*c.I = uint16(c.V[0x4] * 5)
+	// 0x836: 0xd675 DRW V6, V7, 0x5
+	c.Exec(0x836)
+	// 0x838: 0x7606 ADD V6, 0x06
+	c.V[0x6] += 0x06
+	// 0x83a: 0x8443 XOR V4, V4
+	c.V[0x4] ^= c.V[0x4]
+	// 0x83c: 0x8200 LD V2, V0
+	c.V[0x2] = c.V[0x0]
+	// 0x83e: 0x8310 LD V3, V1
+	c.V[0x3] = c.V[0x1]
+	*c.PC = 0x840
+}
+
+func block840(c *chip8.Context) {
+	// 0x840: 0x6564 LD V5, 0x64
+	c.V[0x5] = 0x64
+	// 0x842: 0x8355 SUB V3, V5
+	c.Exec(0x842)
+	// 0x844: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x848
+		return
+	}
+	*c.PC = 0x846
+}
+
+func block846(c *chip8.Context) {
+	// 0x846: 0x82e5 SUB V2, Ve
+	c.Exec(0x846)
+	*c.PC = 0x848
+}
+
+func block848(c *chip8.Context) {
+	// 0x848: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x84c
+		return
+	}
+	*c.PC = 0x84a
+}
+
+func block84a(c *chip8.Context) {
+	// 0x84a: 0x1854 JP 0x854
+	*c.PC = 0x854
+}
+
+func block84c(c *chip8.Context) {
+	// 0x84c: 0x8020 LD V0, V2
+	c.V[0x0] = c.V[0x2]
+	// 0x84e: 0x8130 LD V1, V3
+	c.V[0x1] = c.V[0x3]
+	// 0x850: 0x84e4 ADD V4, Ve
+	c.Exec(0x850)
+	// 0x852: 0x1840 JP 0x840
+	*c.PC = 0x840
+}
+
+func block854(c *chip8.Context) {
+	// 0x854: 0xf429 LD F, V4
+	*c.I = uint16(c.V[0x4] * 5)
+	// 0x856: 0xd675 DRW V6, V7, 0x5
+	c.Exec(0x856)
+	// 0x858: 0x7606 ADD V6, 0x06
+	c.V[0x6] += 0x06
+	// 0x85a: 0x8443 XOR V4, V4
+	c.V[0x4] ^= c.V[0x4]
+	// 0x85c: 0x8200 LD V2, V0
+	c.V[0x2] = c.V[0x0]
+	// 0x85e: 0x8310 LD V3, V1
+	c.V[0x3] = c.V[0x1]
+	*c.PC = 0x860
+}
+
+func block860(c *chip8.Context) {
+	// 0x860: 0x650a LD V5, 0x0a
+	c.V[0x5] = 0x0a
+	// 0x862: 0x8355 SUB V3, V5
+	c.Exec(0x862)
+	// 0x864: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x868
+		return
+	}
+	*c.PC = 0x866
+}
+
+func block866(c *chip8.Context) {
+	// 0x866: 0x186e JP 0x86e
+	*c.PC = 0x86e
+}
+
+func block868(c *chip8.Context) {
+	// 0x868: 0x8130 LD V1, V3
+	c.V[0x1] = c.V[0x3]
+	// 0x86a: 0x84e4 ADD V4, Ve
+	c.Exec(0x86a)
+	// 0x86c: 0x1860 JP 0x860
+	*c.PC = 0x860
+}
+
+func block86e(c *chip8.Context) {
+	// 0x86e: 0xf429 LD F, V4
+	*c.I = uint16(c.V[0x4] * 5)
+	// 0x870: 0xd675 DRW V6, V7, 0x5
+	c.Exec(0x870)
+	// 0x872: 0x7606 ADD V6, 0x06
+	c.V[0x6] += 0x06
+	// 0x874: 0xf129 LD F, V1
+	*c.I = uint16(c.V[0x1] * 5)
+	// 0x876: 0xd675 DRW V6, V7, 0x5
+	c.Exec(0x876)
+	*c.PC = 0x878
+}
+
+func block87a(c *chip8.Context) {
+	// 0x87a: 0xa8c8 LD I, 0x8c8
+	*c.I = 0x8c8
+	// 0x87c: 0xf165 LD V1, [I]
+	c.Exec(0x87c)
+	// 0x87e: 0x81e4 ADD V1, Ve
+	c.Exec(0x87e)
+	// 0x880: 0x3f00 SE Vf, 0x00
+	if c.V[0xf] == 0x00 {
+		*c.PC = 0x884
+		return
+	}
+	*c.PC = 0x882
+}
+
+func block882(c *chip8.Context) {
+	// 0x882: 0x7001 ADD V0, 0x01
+	c.V[0x0] += 0x01
+	*c.PC = 0x884
+}
+
+func block884(c *chip8.Context) {
+	// 0x884: 0xa8c8 LD I, 0x8c8
+	*c.I = 0x8c8
+	// 0x886: 0xf155 LD [I], V1
+	c.Exec(0x886)
+	*c.PC = 0x888
+}
+
+func block88a(c *chip8.Context) {
+	// 0x88a: 0xa8c8 LD I, 0x8c8
+	*c.I = 0x8c8
+	// 0x88c: 0xf365 LD V3, [I]
+	c.Exec(0x88c)
+	// 0x88e: 0x8e00 LD Ve, V0
+	c.V[0xe] = c.V[0x0]
+	// 0x890: 0x8e25 SUB Ve, V2
+	c.Exec(0x890)
+	// 0x892: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x896
+		return
+	}
+	*c.PC = 0x894
+}
+
+func block896(c *chip8.Context) {
+	// 0x896: 0x3e00 SE Ve, 0x00
+	if c.V[0xe] == 0x00 {
+		*c.PC = 0x89a
+		return
+	}
+	*c.PC = 0x898
+}
+
+func block898(c *chip8.Context) {
+	// 0x898: 0x18a2 JP 0x8a2
+	*c.PC = 0x8a2
+}
+
+func block89a(c *chip8.Context) {
+	// 0x89a: 0x8e10 LD Ve, V1
+	c.V[0xe] = c.V[0x1]
+	// 0x89c: 0x8e35 SUB Ve, V3
+	c.Exec(0x89c)
+	// 0x89e: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x8a2
+		return
+	}
+	*c.PC = 0x8a0
+}
+
+func block8a2(c *chip8.Context) {
+	// 0x8a2: 0xa8ca LD I, 0x8ca
+	*c.I = 0x8ca
+	// 0x8a4: 0xf155 LD [I], V1
+	c.Exec(0x8a4)
+	*c.PC = 0x8a6
+}
+
+func block8a8(c *chip8.Context) {
+	// 0x8a8: 0x8ee3 XOR Ve, Ve
+	c.V[0xe] ^= c.V[0xe]
+	// 0x8aa: 0x620f LD V2, 0x0f
+	c.V[0x2] = 0x0f
+	// 0x8ac: 0x63ff LD V3, 0xff
+	c.V[0x3] = 0xff
+	// 0x8ae: 0x6110 LD V1, 0x10
+	c.V[0x1] = 0x10
+	*c.PC = 0x8b0
+}
+
+func block8b0(c *chip8.Context) {
+	// 0x8b0: 0xe2a1 SKNP V2
+	if !c.Pressed(c.V[0x2]) {
+		*c.PC = 0x8b4
+		return
+	}
+	*c.PC = 0x8b2
+}
+
+func block8b2(c *chip8.Context) {
+	// 0x8b2: 0x18c4 JP 0x8c4
+	*c.PC = 0x8c4
+}
+
+func block8b4(c *chip8.Context) {
+	// 0x8b4: 0x8134 ADD V1, V3
+	c.Exec(0x8b4)
+	// 0x8b6: 0x3100 SE V1, 0x00
+	if c.V[0x1] == 0x00 {
+		*c.PC = 0x8ba
+		return
+	}
+	*c.PC = 0x8b8
+}
+
+func block8b8(c *chip8.Context) {
+	// 0x8b8: 0x18b0 JP 0x8b0
+	*c.PC = 0x8b0
+}
+
+func block8ba(c *chip8.Context) {
+	// 0x8ba: 0x6110 LD V1, 0x10
+	c.V[0x1] = 0x10
+	// 0x8bc: 0x8034 ADD V0, V3
+	c.Exec(0x8bc)
+	// 0x8be: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x8c2
+		return
+	}
+	*c.PC = 0x8c0
+}
+
+func block8c0(c *chip8.Context) {
+	// 0x8c0: 0x18b0 JP 0x8b0
+	*c.PC = 0x8b0
+}
+
+func block8c4(c *chip8.Context) {
+	// 0x8c4: 0x6e01 LD Ve, 0x01
+	c.V[0xe] = 0x01
+	*c.PC = 0x8c6
+}
@@ -0,0 +1,524 @@
+// Code generated by go-chip8 recompile from BLITZ; DO NOT EDIT.
+
+package blitz
+
+import "github.com/morinokami/go-chip8/chip8"
+
+var Program = &chip8.Program{
+	ROM: "e54d22df013a1db0681a7b587beafc574f3bdcb2b23f8563f81b7be9d58b37e0",
+	Blocks: map[uint16]*chip8.Block{
+		0x200: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block200},
+		0x217: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block217},
+		0x221: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDIVx, chip8.ADDVxByte, chip8.SEVxByte}, Run: block221},
+		0x229: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block229},
+		0x22d: {Insts: []chip8.Instruction{chip8.CLS}, Run: block22d},
+		0x233: {Insts: []chip8.Instruction{chip8.CLS, chip8.LDVxVy, chip8.LDIAddr}, Run: block233},
+		0x239: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.RND, chip8.ADDVxVy, chip8.DRW, chip8.SEVxByte}, Run: block239},
+		0x243: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block243},
+		0x245: {Insts: []chip8.Instruction{chip8.DRW, chip8.JPAddr}, Run: block245},
+		0x249: {Insts: []chip8.Instruction{chip8.RND, chip8.ADDVxByte}, Run: block249},
+		0x24d: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.DRW, chip8.ADDVxByte, chip8.SEVxByte}, Run: block24d},
+		0x255: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block255},
+		0x257: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SEVxByte}, Run: block257},
+		0x25b: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block25b},
+		0x25d: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxVy, chip8.LDVxByte, chip8.LDVxByte}, Run: block25d},
+		0x265: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.SEVxByte}, Run: block265},
+		0x26b: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block26b},
+		0x26d: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block26d},
+		0x26f: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block26f},
+		0x271: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKP}, Run: block271},
+		0x275: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block275},
+		0x277: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxVy, chip8.ADDVxByte, chip8.LDVxVy, chip8.ADDVxByte}, Run: block277},
+		0x281: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.LDVxVy}, Run: block281},
+		0x287: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDDTVx}, Run: block287},
+		0x28b: {Insts: []chip8.Instruction{chip8.LDVxDT, chip8.SEVxByte}, Run: block28b},
+		0x28f: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block28f},
+		0x291: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block291},
+		0x293: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block293},
+		0x295: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.SEVxByte}, Run: block295},
+		0x299: {Insts: []chip8.Instruction{chip8.DRW}, Run: block299},
+		0x29b: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SEVxByte}, Run: block29b},
+		0x29f: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block29f},
+		0x2a1: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SEVxByte}, Run: block2a1},
+		0x2a5: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block2a5},
+		0x2a7: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2a7},
+		0x2a9: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2a9},
+		0x2ab: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.ADDVxByte, chip8.SEVxByte}, Run: block2ab},
+		0x2b3: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2b3},
+		0x2b5: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte}, Run: block2b5},
+		0x2b9: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2b9},
+		0x2bb: {Insts: []chip8.Instruction{chip8.CLS, chip8.ADDVxByte, chip8.JPAddr}, Run: block2bb},
+		0x2c1: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr}, Run: block2c1},
+		0x2cd: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDIVx, chip8.ADDVxByte, chip8.SEVxByte}, Run: block2cd},
+		0x2d5: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2d5},
+		0x2d7: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2d7},
+		0x2d9: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr}, Run: block2d9},
+		0x2e1: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDIVx, chip8.ADDVxByte, chip8.SEVxByte}, Run: block2e1},
+		0x2e9: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2e9},
+		0x2eb: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.ADDVxByte, chip8.SHR, chip8.LDIAddr, chip8.LDBVx}, Run: block2eb},
+		0x2f5: {Insts: []chip8.Instruction{chip8.LDVxI, chip8.LDVxByte, chip8.LDFVx, chip8.LDVxByte, chip8.DRW, chip8.ADDVxByte, chip8.LDFVx, chip8.DRW}, Run: block2f5},
+	},
+}
+
+func block200(c *chip8.Context) {
+	// 0x200: 0x1217 JP 0x217
+	*c.PC = 0x217
+}
+
+func block217(c *chip8.Context) {
+	// 0x217: 0xa341 LD I, 0x341
+	*c.I = 0x341
+	// 0x219: 0x6004 LD V0, 0x04
+	c.V[0x0] = 0x04
+	// 0x21b: 0x6109 LD V1, 0x09
+	c.V[0x1] = 0x09
+	// 0x21d: 0x620e LD V2, 0x0e
+	c.V[0x2] = 0x0e
+	// 0x21f: 0x6704 LD V7, 0x04
+	c.V[0x7] = 0x04
+	*c.PC = 0x221
+}
+
+func block221(c *chip8.Context) {
+	// 0x221: 0xd01e DRW V0, V1, 0xe
+	c.Exec(0x221)
+	// 0x223: 0xf21e ADD I, V2
+	*c.I += uint16(c.V[0x2])
+	// 0x225: 0x700c ADD V0, 0x0c
+	c.V[0x0] += 0x0c
+	// 0x227: 0x3040 SE V0, 0x40
+	if c.V[0x0] == 0x40 {
+		*c.PC = 0x22b
+		return
+	}
+	*c.PC = 0x229
+}
+
+func block229(c *chip8.Context) {
+	// 0x229: 0x1221 JP 0x221
+	*c.PC = 0x221
+}
+
+func block22d(c *chip8.Context) {
+	// 0x22d: 0x00e0 CLS
+	c.Exec(0x22d)
+	*c.PC = 0x22f
+}
+
+func block233(c *chip8.Context) {
+	// 0x233: 0x00e0 CLS
+	c.Exec(0x233)
+	// 0x235: 0x8e70 LD Ve, V7
+	c.V[0xe] = c.V[0x7]
+	// 0x237: 0xa31e LD I, 0x31e
+	*c.I = 0x31e
+	*c.PC = 0x239
+}
+
+func block239(c *chip8.Context) {
+	// 0x239: 0x6b1f LD Vb, 0x1f
+	c.V[0xb] = 0x1f
+	// 0x23b: 0xcc1f RND Vc, byte
+	c.Exec(0x23b)
+	// 0x23d: 0x8cc4 ADD Vc, Vc
+	c.Exec(0x23d)
+	// 0x23f: 0xdcb2 DRW Vc, Vb, 0x2
+	c.Exec(0x23f)
+	// 0x241: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x245
+		return
+	}
+	*c.PC = 0x243
+}
+
+func block243(c *chip8.Context) {
+	// 0x243: 0x1249 JP 0x249
+	*c.PC = 0x249
+}
+
+func block245(c *chip8.Context) {
+	// 0x245: 0xdcb2 DRW Vc, Vb, 0x2
+	c.Exec(0x245)
+	// 0x247: 0x1239 JP 0x239
+	*c.PC = 0x239
+}
+
+func block249(c *chip8.Context) {
+	// 0x249: 0xca07 RND Va, byte
+	c.Exec(0x249)
+	// 0x24b: 0x7a01 ADD Va, 0x01
+	c.V[0xa] += 0x01
+	*c.PC = 0x24d
+}
+
+func block24d(c *chip8.Context) {
+	// 0x24d: 0x7bfe ADD Vb, 0xfe
+	c.V[0xb] += 0xfe
+	// 0x24f: 0xdcb2 DRW Vc, Vb, 0x2
+	c.Exec(0x24f)
+	// 0x251: 0x7aff ADD Va, 0xff
+	c.V[0xa] += 0xff
+	// 0x253: 0x3a00 SE Va, 0x00
+	if c.V[0xa] == 0x00 {
+		*c.PC = 0x257
+		return
+	}
+	*c.PC = 0x255
+}
+
+func block255(c *chip8.Context) {
+	// 0x255: 0x124d JP 0x24d
+	*c.PC = 0x24d
+}
+
+func block257(c *chip8.Context) {
+	// 0x257: 0x7eff ADD Ve, 0xff
+	c.V[0xe] += 0xff
+	// 0x259: 0x3e00 SE Ve, 0x00
+	if c.V[0xe] == 0x00 {
+		*c.PC = 0x25d
+		return
+	}
+	*c.PC = 0x25b
+}
+
+func block25b(c *chip8.Context) {
+	// 0x25b: 0x1239 JP 0x239
+	*c.PC = 0x239
+}
+
+func block25d(c *chip8.Context) {
+	// 0x25d: 0x6b00 LD Vb, 0x00
+	c.V[0xb] = 0x00
+	// 0x25f: 0x8c70 LD Vc, V7
+	c.V[0xc] = c.V[0x7]
+	// 0x261: 0x6d00 LD Vd, 0x00
+	c.V[0xd] = 0x00
+	// 0x263: 0x6e00 LD Ve, 0x00
+	c.V[0xe] = 0x00
+	*c.PC = 0x265
+}
+
+func block265(c *chip8.Context) {
+	// 0x265: 0xa31b LD I, 0x31b
+	*c.I = 0x31b
+	// 0x267: 0xdde3 DRW Vd, Ve, 0x3
+	c.Exec(0x267)
+	// 0x269: 0x3f00 SE Vf, 0x00
+	if c.V[0xf] == 0x00 {
+		*c.PC = 0x26d
+		return
+	}
+	*c.PC = 0x26b
+}
+
+func block26b(c *chip8.Context) {
+	// 0x26b: 0x12c1 JP 0x2c1
+	*c.PC = 0x2c1
+}
+
+func block26d(c *chip8.Context) {
+	// 0x26d: 0x3b00 SE Vb, 0x00
+	if c.V[0xb] == 0x00 {
+		*c.PC = 0x271
+		return
+	}
+	*c.PC = 0x26f
+}
+
+func block26f(c *chip8.Context) {
+	// 0x26f: 0x1281 JP 0x281
+	*c.PC = 0x281
+}
+
+func block271(c *chip8.Context) {
+	// 0x271: 0x6005 LD V0, 0x05
+	c.V[0x0] = 0x05
+	// 0x273: 0xe09e SKP V0
+	if c.Pressed(c.V[0x0]) {
+		*c.PC = 0x277
+		return
+	}
+	*c.PC = 0x275
+}
+
+func block275(c *chip8.Context) {
+	// 0x275: 0x1287 JP 0x287
+	*c.PC = 0x287
+}
+
+func block277(c *chip8.Context) {
+	// 0x277: 0x6b01 LD Vb, 0x01
+	c.V[0xb] = 0x01
+	// 0x279: 0x88d0 LD V8, Vd
+	c.V[0x8] = c.V[0xd]
+	// 0x27b: 0x7802 ADD V8, 0x02
+	c.V[0x8] += 0x02
+	// 0x27d: 0x89e0 LD V9, Ve
+	c.V[0x9] = c.V[0xe]
+	// 0x27f: 0x7903 ADD V9, 0x03
+	c.V[0x9] += 0x03
+	*c.PC = 0x281
+}
+
+func block281(c *chip8.Context) {
+	// 0x281: 0xa31e LD I, 0x31e
+	*c.I = 0x31e
+	// 0x283: 0xd891 DRW V8, V9, 0x1
+	c.Exec(0x283)
+	// 0x285: 0x81f0 LD V1, Vf
+	c.V[0x1] = c.V[0xf]
+	*c.PC = 0x287
+}
+
+func block287(c *chip8.Context) {
+	// 0x287: 0x6005 LD V0, 0x05
+	c.V[0x0] = 0x05
+	// 0x289: 0xf015 LD DT, V0
+	*c.DT = c.V[0x0]
+	*c.PC = 0x28b
+}
+
+func block28b(c *chip8.Context) {
+	// 0x28b: 0xf007 LD V0, DT
+	c.V[0x0] = *c.DT
+	// 0x28d: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x291
+		return
+	}
+	*c.PC = 0x28f
+}
+
+func block28f(c *chip8.Context) {
+	// 0x28f: 0x128b JP 0x28b
+	*c.PC = 0x28b
+}
+
+func block291(c *chip8.Context) {
+	// 0x291: 0x3b01 SE Vb, 0x01
+	if c.V[0xb] == 0x01 {
+		*c.PC = 0x295
+		return
+	}
+	*c.PC = 0x293
+}
+
+func block293(c *chip8.Context) {
+	// 0x293: 0x12ab JP 0x2ab
+	*c.PC = 0x2ab
+}
+
+func block295(c *chip8.Context) {
+	// 0x295: 0xa31e LD I, 0x31e
+	*c.I = 0x31e
+	// 0x297: 0x3101 SE V1, 0x01
+	if c.V[0x1] == 0x01 {
+		*c.PC = 0x29b
+		return
+	}
+	*c.PC = 0x299
+}
+
+func block299(c *chip8.Context) {
+	// 0x299: 0xd891 DRW V8, V9, 0x1
+	c.Exec(0x299)
+	*c.PC = 0x29b
+}
+
+func block29b(c *chip8.Context) {
+	// 0x29b: 0x7901 ADD V9, 0x01
+	c.V[0x9] += 0x01
+	// 0x29d: 0x3920 SE V9, 0x20
+	if c.V[0x9] == 0x20 {
+		*c.PC = 0x2a1
+		return
+	}
+	*c.PC = 0x29f
+}
+
+func block29f(c *chip8.Context) {
+	// 0x29f: 0x12ab JP 0x2ab
+	*c.PC = 0x2ab
+}
+
+func block2a1(c *chip8.Context) {
+	// 0x2a1: 0x6b00 LD Vb, 0x00
+	c.V[0xb] = 0x00
+	// 0x2a3: 0x3100 SE V1, 0x00
+	if c.V[0x1] == 0x00 {
+		*c.PC = 0x2a7
+		return
+	}
+	*c.PC = 0x2a5
+}
+
+func block2a5(c *chip8.Context) {
+	// 0x2a5: 0x7cff ADD Vc, 0xff
+	c.V[0xc] += 0xff
+	*c.PC = 0x2a7
+}
+
+func block2a7(c *chip8.Context) {
+	// 0x2a7: 0x4c00 SNE Vc, 0x00
+	if c.V[0xc] != 0x00 {
+		*c.PC = 0x2ab
+		return
+	}
+	*c.PC = 0x2a9
+}
+
+func block2a9(c *chip8.Context) {
+	// 0x2a9: 0x12bb JP 0x2bb
+	*c.PC = 0x2bb
+}
+
+func block2ab(c *chip8.Context) {
+	// 0x2ab: 0xa31b LD I, 0x31b
+	*c.I = 0x31b
+	// 0x2ad: 0xdde3 DRW Vd, Ve, 0x3
+	c.Exec(0x2ad)
+	// 0x2af: 0x7d02 ADD Vd, 0x02
+	c.V[0xd] += 0x02
+	// 0x2b1: 0x3d40 SE Vd, 0x40
+	if c.V[0xd] == 0x40 {
+		*c.PC = 0x2b5
+		return
+	}
+	*c.PC = 0x2b3
+}
+
+func block2b3(c *chip8.Context) {
+	// 0x2b3: 0x12b9 JP 0x2b9
+	*c.PC = 0x2b9
+}
+
+func block2b5(c *chip8.Context) {
+	// 0x2b5: 0x6d00 LD Vd, 0x00
+	c.V[0xd] = 0x00
+	// 0x2b7: 0x7e01 ADD Ve, 0x01
+	c.V[0xe] += 0x01
+	*c.PC = 0x2b9
+}
+
+func block2b9(c *chip8.Context) {
+	// 0x2b9: 0x1265 JP 0x265
+	*c.PC = 0x265
+}
+
+func block2bb(c *chip8.Context) {
+	// 0x2bb: 0x00e0 CLS
+	c.Exec(0x2bb)
+	// 0x2bd: 0x7702 ADD V7, 0x02
+	c.V[0x7] += 0x02
+	// 0x2bf: 0x122d JP 0x22d
+	*c.PC = 0x22d
+}
+
+func block2c1(c *chip8.Context) {
+	// 0x2c1: 0xa31b LD I, 0x31b
+	*c.I = 0x31b
+	// 0x2c3: 0xdde3 DRW Vd, Ve, 0x3
+	c.Exec(0x2c3)
+	// 0x2c5: 0x6014 LD V0, 0x14
+	c.V[0x0] = 0x14
+	// 0x2c7: 0x6102 LD V1, 0x02
+	c.V[0x1] = 0x02
+	// 0x2c9: 0x620b LD V2, 0x0b
+	c.V[0x2] = 0x0b
+	// 0x2cb: 0xa320 LD I, 0x320
+	*c.I = 0x320
+	*c.PC = 0x2cd
+}
+
+func block2cd(c *chip8.Context) {
+	// 0x2cd: 0xd01b DRW V0, V1, 0xb
+	c.Exec(0x2cd)
+	// 0x2cf: 0xf21e ADD I, V2
+	*c.I += uint16(c.V[0x2])
+	// 0x2d1: 0x7008 ADD V0, 0x08
+	c.V[0x0] += 0x08
+	// 0x2d3: 0x302c SE V0, 0x2c
+	if c.V[0x0] == 0x2c {
+		*c.PC = 0x2d7
+		return
+	}
+	*c.PC = 0x2d5
+}
+
+func block2d5(c *chip8.Context) {
+	// 0x2d5: 0x12cd JP 0x2cd
+	*c.PC = 0x2cd
+}
+
+func block2d7(c *chip8.Context) {
+	// 0x2d7: 0x12d7 JP 0x2d7
+	*c.PC = 0x2d7
+}
+
+func block2d9(c *chip8.Context) {
+	// 0x2d9: 0x600a LD V0, 0x0a
+	c.V[0x0] = 0x0a
+	// 0x2db: 0x610d LD V1, 0x0d
+	c.V[0x1] = 0x0d
+	// 0x2dd: 0x6205 LD V2, 0x05
+	c.V[0x2] = 0x05
+	// 0x2df: 0xa307 LD I, 0x307
+	*c.I = 0x307
+	*c.PC = 0x2e1
+}
+
+func block2e1(c *chip8.Context) {
+	// 0x2e1: 0xd015 DRW V0, V1, 0x5
+	c.Exec(0x2e1)
+	// 0x2e3: 0xf21e ADD I, V2
+	*c.I += uint16(c.V[0x2])
+	// 0x2e5: 0x7008 ADD V0, 0x08
+	c.V[0x0] += 0x08
+	// 0x2e7: 0x302a SE V0, 0x2a
+	if c.V[0x0] == 0x2a {
+		*c.PC = 0x2eb
+		return
+	}
+	*c.PC = 0x2e9
+}
+
+func block2e9(c *chip8.Context) {
+	// 0x2e9: 0x12e1 JP 0x2e1
+	*c.PC = 0x2e1
+}
+
+func block2eb(c *chip8.Context) {
+	// 0x2eb: 0x8070 LD V0, V7
+	c.V[0x0] = c.V[0x7]
+	// 0x2ed: 0x70fe ADD V0, 0xfe
+	c.V[0x0] += 0xfe
+	// 0x2ef: 0x8006 SHR V0, V0
+	c.Exec(0x2ef)
+	// 0x2f1: 0xa387 LD I, 0x387
+	*c.I = 0x387
+	// 0x2f3: 0xf033 LD B, V0
+	c.Exec(0x2f3)
+	*c.PC = 0x2f5
+}
+
+func block2f5(c *chip8.Context) {
+	// 0x2f5: 0xf265 LD V2, [I]
+	c.Exec(0x2f5)
+	// 0x2f7: 0x602d LD V0, 0x2d
+	c.V[0x0] = 0x2d
+	// 0x2f9: 0xf129 LD F, V1
+	*c.I = uint16(c.V[0x1] * 5)
+	// 0x2fb: 0x610d LD V1, 0x0d
+	c.V[0x1] = 0x0d
+	// 0x2fd: 0xd015 DRW V0, V1, 0x5
+	c.Exec(0x2fd)
+	// 0x2ff: 0x7005 ADD V0, 0x05
+	c.V[0x0] += 0x05
+	// 0x301: 0xf229 LD F, V2
+	*c.I = uint16(c.V[0x2] * 5)
+	// 0x303: 0xd015 DRW V0, V1, 0x5
+	c.Exec(0x303)
+	*c.PC = 0x305
+}
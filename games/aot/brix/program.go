@@ -0,0 +1,577 @@
+// Code generated by go-chip8 recompile from BRIX; DO NOT EDIT.
+
+package brix
+
+import "github.com/morinokami/go-chip8/chip8"
+
+var Program = &chip8.Program{
+	ROM: "c435e310ed832846a10f6d19e103910400a97dce27745370cb18207f24baee39",
+	Blocks: map[uint16]*chip8.Block{
+		0x200: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block200},
+		0x206: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block206},
+		0x208: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.ADDVxByte, chip8.SEVxByte}, Run: block208},
+		0x210: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block210},
+		0x212: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SEVxByte}, Run: block212},
+		0x216: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block216},
+		0x218: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr, chip8.DRW}, Run: block218},
+		0x222: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr, chip8.DRW, chip8.ADDVxByte, chip8.LDIAddr, chip8.DRW}, Run: block222},
+		0x230: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDDTVx}, Run: block230},
+		0x234: {Insts: []chip8.Instruction{chip8.LDVxDT, chip8.SEVxByte}, Run: block234},
+		0x238: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block238},
+		0x23a: {Insts: []chip8.Instruction{chip8.RND, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr, chip8.DRW}, Run: block23a},
+		0x246: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.LDVxByte, chip8.SKNP}, Run: block246},
+		0x24e: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block24e},
+		0x250: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block250},
+		0x254: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block254},
+		0x256: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.DRW, chip8.LDIAddr, chip8.DRW, chip8.ADDVxVy, chip8.ADDVxVy, chip8.LDVxByte, chip8.AND, chip8.LDVxByte}, Run: block256},
+		0x26e: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block26e},
+		0x270: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block270},
+		0x272: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block272},
+		0x274: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block274},
+		0x276: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block276},
+		0x278: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block278},
+		0x27a: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block27a},
+		0x27c: {Insts: []chip8.Instruction{chip8.DRW, chip8.SEVxByte}, Run: block27c},
+		0x280: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block280},
+		0x282: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block282},
+		0x284: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block284},
+		0x286: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SUB, chip8.SEVxByte}, Run: block286},
+		0x28c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block28c},
+		0x28e: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDSTVx, chip8.LDVxVy, chip8.LDVxByte, chip8.AND, chip8.LDIAddr, chip8.DRW, chip8.LDVxByte, chip8.XOR}, Run: block28e},
+		0x2a2: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block2a2},
+		0x2a6: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2a6},
+		0x2a8: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2a8},
+		0x2aa: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2aa},
+		0x2ac: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxVy, chip8.SUB, chip8.SEVxByte}, Run: block2ac},
+		0x2b4: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2b4},
+		0x2b6: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SUB, chip8.SEVxByte}, Run: block2b6},
+		0x2bc: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2bc},
+		0x2be: {Insts: []chip8.Instruction{chip8.SUB, chip8.SEVxByte}, Run: block2be},
+		0x2c2: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2c2},
+		0x2c4: {Insts: []chip8.Instruction{chip8.SUB, chip8.SEVxByte}, Run: block2c4},
+		0x2c8: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2c8},
+		0x2ca: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDSTVx, chip8.LDIAddr, chip8.ADDVxByte, chip8.LDVxVy, chip8.ADDVxVy, chip8.LDVxByte, chip8.DRW, chip8.SEVxByte}, Run: block2ca},
+		0x2dc: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2dc},
+		0x2de: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2de},
+		0x2e0: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SNEVxByte}, Run: block2e0},
+		0x2e4: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2e4},
+		0x2e6: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2e6},
+		0x2e8: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SNEVxByte}, Run: block2e8},
+		0x2ec: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2ec},
+		0x2ee: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDSTVx, chip8.LDVxByte, chip8.JPAddr}, Run: block2ee},
+		0x2f6: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDBVx}, Run: block2f6},
+		0x2fa: {Insts: []chip8.Instruction{chip8.LDVxI, chip8.LDFVx, chip8.LDVxByte, chip8.LDVxByte, chip8.DRW, chip8.ADDVxByte, chip8.LDFVx, chip8.DRW}, Run: block2fa},
+	},
+}
+
+func block200(c *chip8.Context) {
+	// 0x200: 0x6e05 LD Ve, 0x05
+	c.V[0xe] = 0x05
+	// 0x202: 0x6500 LD V5, 0x00
+	c.V[0x5] = 0x00
+	// 0x204: 0x6b06 LD Vb, 0x06
+	c.V[0xb] = 0x06
+	*c.PC = 0x206
+}
+
+func block206(c *chip8.Context) {
+	// 0x206: 0x6a00 LD Va, 0x00
+	c.V[0xa] = 0x00
+	*c.PC = 0x208
+}
+
+func block208(c *chip8.Context) {
+	// 0x208: 0xa30c LD I, 0x30c
+	*c.I = 0x30c
+	// 0x20a: 0xdab1 DRW Va, Vb, 0x1
+	c.Exec(0x20a)
+	// 0x20c: 0x7a04 ADD Va, 0x04
+	c.V[0xa] += 0x04
+	// 0x20e: 0x3a40 SE Va, 0x40
+	if c.V[0xa] == 0x40 {
+		*c.PC = 0x212
+		return
+	}
+	*c.PC = 0x210
+}
+
+func block210(c *chip8.Context) {
+	// 0x210: 0x1208 JP 0x208
+	*c.PC = 0x208
+}
+
+func block212(c *chip8.Context) {
+	// 0x212: 0x7b02 ADD Vb, 0x02
+	c.V[0xb] += 0x02
+	// 0x214: 0x3b12 SE Vb, 0x12
+	if c.V[0xb] == 0x12 {
+		*c.PC = 0x218
+		return
+	}
+	*c.PC = 0x216
+}
+
+func block216(c *chip8.Context) {
+	// 0x216: 0x1206 JP 0x206
+	*c.PC = 0x206
+}
+
+func block218(c *chip8.Context) {
+	// 0x218: 0x6c20 LD Vc, 0x20
+	c.V[0xc] = 0x20
+	// 0x21a: 0x6d1f LD Vd, 0x1f
+	c.V[0xd] = 0x1f
+	// 0x21c: 0xa310 LD I, 0x310
+	*c.I = 0x310
+	// 0x21e: 0xdcd1 DRW Vc, Vd, 0x1
+	c.Exec(0x21e)
+	*c.PC = 0x220
+}
+
+func block222(c *chip8.Context) {
+	// 0x222: 0x6000 LD V0, 0x00
+	c.V[0x0] = 0x00
+	// 0x224: 0x6100 LD V1, 0x00
+	c.V[0x1] = 0x00
+	// 0x226: 0xa312 LD I, 0x312
+	*c.I = 0x312
+	// 0x228: 0xd011 DRW V0, V1, 0x1
+	c.Exec(0x228)
+	// 0x22a: 0x7008 ADD V0, 0x08
+	c.V[0x0] += 0x08
+	// 0x22c: 0xa30e LD I, 0x30e
+	*c.I = 0x30e
+	// 0x22e: 0xd011 DRW V0, V1, 0x1
+	c.Exec(0x22e)
+	*c.PC = 0x230
+}
+
+func block230(c *chip8.Context) {
+	// 0x230: 0x6040 LD V0, 0x40
+	c.V[0x0] = 0x40
+	// 0x232: 0xf015 LD DT, V0
+	*c.DT = c.V[0x0]
+	*c.PC = 0x234
+}
+
+func block234(c *chip8.Context) {
+	// 0x234: 0xf007 LD V0, DT
+	c.V[0x0] = *c.DT
+	// 0x236: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x23a
+		return
+	}
+	*c.PC = 0x238
+}
+
+func block238(c *chip8.Context) {
+	// 0x238: 0x1234 JP 0x234
+	*c.PC = 0x234
+}
+
+func block23a(c *chip8.Context) {
+	// 0x23a: 0xc60f RND V6, byte
+	c.Exec(0x23a)
+	// 0x23c: 0x671e LD V7, 0x1e
+	c.V[0x7] = 0x1e
+	// 0x23e: 0x6801 LD V8, 0x01
+	c.V[0x8] = 0x01
+	// 0x240: 0x69ff LD V9, 0xff
+	c.V[0x9] = 0xff
+	// 0x242: 0xa30e LD I, 0x30e
+	*c.I = 0x30e
+	// 0x244: 0xd671 DRW V6, V7, 0x1
+	c.Exec(0x244)
+	*c.PC = 0x246
+}
+
+func block246(c *chip8.Context) {
+	// 0x246: 0xa310 LD I, 0x310
+	*c.I = 0x310
+	// 0x248: 0xdcd1 DRW Vc, Vd, 0x1
+	c.Exec(0x248)
+	// 0x24a: 0x6004 LD V0, 0x04
+	c.V[0x0] = 0x04
+	// 0x24c: 0xe0a1 SKNP V0
+	if !c.Pressed(c.V[0x0]) {
+		*c.PC = 0x250
+		return
+	}
+	*c.PC = 0x24e
+}
+
+func block24e(c *chip8.Context) {
+	// 0x24e: 0x7cfe ADD Vc, 0xfe
+	c.V[0xc] += 0xfe
+	*c.PC = 0x250
+}
+
+func block250(c *chip8.Context) {
+	// 0x250: 0x6006 LD V0, 0x06
+	c.V[0x0] = 0x06
+	// 0x252: 0xe0a1 SKNP V0
+	if !c.Pressed(c.V[0x0]) {
+		*c.PC = 0x256
+		return
+	}
+	*c.PC = 0x254
+}
+
+func block254(c *chip8.Context) {
+	// 0x254: 0x7c02 ADD Vc, 0x02
+	c.V[0xc] += 0x02
+	*c.PC = 0x256
+}
+
+func block256(c *chip8.Context) {
+	// 0x256: 0x603f LD V0, 0x3f
+	c.V[0x0] = 0x3f
+	// 0x258: 0x8c02 AND Vc, V0
+	c.V[0xc] &= c.V[0x0]
+	// 0x25a: 0xdcd1 DRW Vc, Vd, 0x1
+	c.Exec(0x25a)
+	// 0x25c: 0xa30e LD I, 0x30e
+	*c.I = 0x30e
+	// 0x25e: 0xd671 DRW V6, V7, 0x1
+	c.Exec(0x25e)
+	// 0x260: 0x8684 ADD V6, V8
+	c.Exec(0x260)
+	// 0x262: 0x8794 ADD V7, V9
+	c.Exec(0x262)
+	// 0x264: 0x603f LD V0, 0x3f
+	c.V[0x0] = 0x3f
+	// 0x266: 0x8602 AND V6, V0
+	c.V[0x6] &= c.V[0x0]
+	// 0x268: 0x611f LD V1, 0x1f
+	c.V[0x1] = 0x1f
+	*c.PC = 0x26a
+}
+
+func block26e(c *chip8.Context) {
+	// 0x26e: 0x12ac JP 0x2ac
+	*c.PC = 0x2ac
+}
+
+func block270(c *chip8.Context) {
+	// 0x270: 0x4600 SNE V6, 0x00
+	if c.V[0x6] != 0x00 {
+		*c.PC = 0x274
+		return
+	}
+	*c.PC = 0x272
+}
+
+func block272(c *chip8.Context) {
+	// 0x272: 0x6801 LD V8, 0x01
+	c.V[0x8] = 0x01
+	*c.PC = 0x274
+}
+
+func block274(c *chip8.Context) {
+	// 0x274: 0x463f SNE V6, 0x3f
+	if c.V[0x6] != 0x3f {
+		*c.PC = 0x278
+		return
+	}
+	*c.PC = 0x276
+}
+
+func block276(c *chip8.Context) {
+	// 0x276: 0x68ff LD V8, 0xff
+	c.V[0x8] = 0xff
+	*c.PC = 0x278
+}
+
+func block278(c *chip8.Context) {
+	// 0x278: 0x4700 SNE V7, 0x00
+	if c.V[0x7] != 0x00 {
+		*c.PC = 0x27c
+		return
+	}
+	*c.PC = 0x27a
+}
+
+func block27a(c *chip8.Context) {
+	// 0x27a: 0x6901 LD V9, 0x01
+	c.V[0x9] = 0x01
+	*c.PC = 0x27c
+}
+
+func block27c(c *chip8.Context) {
+	// 0x27c: 0xd671 DRW V6, V7, 0x1
+	c.Exec(0x27c)
+	// 0x27e: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x282
+		return
+	}
+	*c.PC = 0x280
+}
+
+func block280(c *chip8.Context) {
+	// 0x280: 0x12aa JP 0x2aa
+	*c.PC = 0x2aa
+}
+
+func block282(c *chip8.Context) {
+	// 0x282: 0x471f SNE V7, 0x1f
+	if c.V[0x7] != 0x1f {
+		*c.PC = 0x286
+		return
+	}
+	*c.PC = 0x284
+}
+
+func block284(c *chip8.Context) {
+	// 0x284: 0x12aa JP 0x2aa
+	*c.PC = 0x2aa
+}
+
+func block286(c *chip8.Context) {
+	// 0x286: 0x6005 LD V0, 0x05
+	c.V[0x0] = 0x05
+	// 0x288: 0x8075 SUB V0, V7
+	c.Exec(0x288)
+	// 0x28a: 0x3f00 SE Vf, 0x00
+	if c.V[0xf] == 0x00 {
+		*c.PC = 0x28e
+		return
+	}
+	*c.PC = 0x28c
+}
+
+func block28c(c *chip8.Context) {
+	// 0x28c: 0x12aa JP 0x2aa
+	*c.PC = 0x2aa
+}
+
+func block28e(c *chip8.Context) {
+	// 0x28e: 0x6001 LD V0, 0x01
+	c.V[0x0] = 0x01
+	// 0x290: 0xf018 LD ST, V0
+	*c.ST = c.V[0x0]
+	// 0x292: 0x8060 LD V0, V6
+	c.V[0x0] = c.V[0x6]
+	// 0x294: 0x61fc LD V1, 0xfc
+	c.V[0x1] = 0xfc
+	// 0x296: 0x8012 AND V0, V1
+	c.V[0x0] &= c.V[0x1]
+	// 0x298: 0xa30c LD I, 0x30c
+	*c.I = 0x30c
+	// 0x29a: 0xd071 DRW V0, V7, 0x1
+	c.Exec(0x29a)
+	// 0x29c: 0x60fe LD V0, 0xfe
+	c.V[0x0] = 0xfe
+	// 0x29e: 0x8903 XOR V9, V0
+	c.V[0x9] ^= c.V[0x0]
+	*c.PC = 0x2a0
+}
+
+func block2a2(c *chip8.Context) {
+	// 0x2a2: 0x7501 ADD V5, 0x01
+	c.V[0x5] += 0x01
+	*c.PC = 0x2a4
+}
+
+func block2a6(c *chip8.Context) {
+	// 0x2a6: 0x4560 SNE V5, 0x60
+	if c.V[0x5] != 0x60 {
+		*c.PC = 0x2aa
+		return
+	}
+	*c.PC = 0x2a8
+}
+
+func block2a8(c *chip8.Context) {
+	// 0x2a8: 0x12de JP 0x2de
+	*c.PC = 0x2de
+}
+
+func block2aa(c *chip8.Context) {
+	// 0x2aa: 0x1246 JP 0x246
+	*c.PC = 0x246
+}
+
+func block2ac(c *chip8.Context) {
+	// 0x2ac: 0x69ff LD V9, 0xff
+	c.V[0x9] = 0xff
+	// 0x2ae: 0x8060 LD V0, V6
+	c.V[0x0] = c.V[0x6]
+	// 0x2b0: 0x80c5 SUB V0, Vc
+	c.Exec(0x2b0)
+	// 0x2b2: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x2b6
+		return
+	}
+	*c.PC = 0x2b4
+}
+
+func block2b4(c *chip8.Context) {
+	// 0x2b4: 0x12ca JP 0x2ca
+	*c.PC = 0x2ca
+}
+
+func block2b6(c *chip8.Context) {
+	// 0x2b6: 0x6102 LD V1, 0x02
+	c.V[0x1] = 0x02
+	// 0x2b8: 0x8015 SUB V0, V1
+	c.Exec(0x2b8)
+	// 0x2ba: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x2be
+		return
+	}
+	*c.PC = 0x2bc
+}
+
+func block2bc(c *chip8.Context) {
+	// 0x2bc: 0x12e0 JP 0x2e0
+	*c.PC = 0x2e0
+}
+
+func block2be(c *chip8.Context) {
+	// 0x2be: 0x8015 SUB V0, V1
+	c.Exec(0x2be)
+	// 0x2c0: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x2c4
+		return
+	}
+	*c.PC = 0x2c2
+}
+
+func block2c2(c *chip8.Context) {
+	// 0x2c2: 0x12ee JP 0x2ee
+	*c.PC = 0x2ee
+}
+
+func block2c4(c *chip8.Context) {
+	// 0x2c4: 0x8015 SUB V0, V1
+	c.Exec(0x2c4)
+	// 0x2c6: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x2ca
+		return
+	}
+	*c.PC = 0x2c8
+}
+
+func block2c8(c *chip8.Context) {
+	// 0x2c8: 0x12e8 JP 0x2e8
+	*c.PC = 0x2e8
+}
+
+func block2ca(c *chip8.Context) {
+	// 0x2ca: 0x6020 LD V0, 0x20
+	c.V[0x0] = 0x20
+	// 0x2cc: 0xf018 LD ST, V0
+	*c.ST = c.V[0x0]
+	// 0x2ce: 0xa30e LD I, 0x30e
+	*c.I = 0x30e
+	// 0x2d0: 0x7eff ADD Ve, 0xff
+	c.V[0xe] += 0xff
+	// 0x2d2: 0x80e0 LD V0, Ve
+	c.V[0x0] = c.V[0xe]
+	// 0x2d4: 0x8004 ADD V0, V0
+	c.Exec(0x2d4)
+	// 0x2d6: 0x6100 LD V1, 0x00
+	c.V[0x1] = 0x00
+	// 0x2d8: 0xd011 DRW V0, V1, 0x1
+	c.Exec(0x2d8)
+	// 0x2da: 0x3e00 SE Ve, 0x00
+	if c.V[0xe] == 0x00 {
+		*c.PC = 0x2de
+		return
+	}
+	*c.PC = 0x2dc
+}
+
+func block2dc(c *chip8.Context) {
+	// 0x2dc: 0x1230 JP 0x230
+	*c.PC = 0x230
+}
+
+func block2de(c *chip8.Context) {
+	// 0x2de: 0x12de JP 0x2de
+	*c.PC = 0x2de
+}
+
+func block2e0(c *chip8.Context) {
+	// 0x2e0: 0x78ff ADD V8, 0xff
+	c.V[0x8] += 0xff
+	// 0x2e2: 0x48fe SNE V8, 0xfe
+	if c.V[0x8] != 0xfe {
+		*c.PC = 0x2e6
+		return
+	}
+	*c.PC = 0x2e4
+}
+
+func block2e4(c *chip8.Context) {
+	// 0x2e4: 0x68ff LD V8, 0xff
+	c.V[0x8] = 0xff
+	*c.PC = 0x2e6
+}
+
+func block2e6(c *chip8.Context) {
+	// 0x2e6: 0x12ee JP 0x2ee
+	*c.PC = 0x2ee
+}
+
+func block2e8(c *chip8.Context) {
+	// 0x2e8: 0x7801 ADD V8, 0x01
+	c.V[0x8] += 0x01
+	// 0x2ea: 0x4802 SNE V8, 0x02
+	if c.V[0x8] != 0x02 {
+		*c.PC = 0x2ee
+		return
+	}
+	*c.PC = 0x2ec
+}
+
+func block2ec(c *chip8.Context) {
+	// 0x2ec: 0x6801 LD V8, 0x01
+	c.V[0x8] = 0x01
+	*c.PC = 0x2ee
+}
+
+func block2ee(c *chip8.Context) {
+	// 0x2ee: 0x6004 LD V0, 0x04
+	c.V[0x0] = 0x04
+	// 0x2f0: 0xf018 LD ST, V0
+	*c.ST = c.V[0x0]
+	// 0x2f2: 0x69ff LD V9, 0xff
+	c.V[0x9] = 0xff
+	// 0x2f4: 0x1270 JP 0x270
+	*c.PC = 0x270
+}
+
+func block2f6(c *chip8.Context) {
+	// 0x2f6: 0xa314 LD I, 0x314
+	*c.I = 0x314
+	// 0x2f8: 0xf533 LD B, V5
+	c.Exec(0x2f8)
+	*c.PC = 0x2fa
+}
+
+func block2fa(c *chip8.Context) {
+	// 0x2fa: 0xf265 LD V2, [I]
+	c.Exec(0x2fa)
+	// 0x2fc: 0xf129 LD F, V1
+	*c.I = uint16(c.V[0x1] * 5)
+	// 0x2fe: 0x6337 LD V3, 0x37
+	c.V[0x3] = 0x37
+	// 0x300: 0x6400 LD V4, 0x00
+	c.V[0x4] = 0x00
+	// 0x302: 0xd345 DRW V3, V4, 0x5
+	c.Exec(0x302)
+	// 0x304: 0x7305 ADD V3, 0x05
+	c.V[0x3] += 0x05
+	// 0x306: 0xf229 LD F, V2
+	*c.I = uint16(c.V[0x2] * 5)
+	// 0x308: 0xd345 DRW V3, V4, 0x5
+	c.Exec(0x308)
+	*c.PC = 0x30a
+}
@@ -0,0 +1,286 @@
+// Code generated by go-chip8 recompile from CONNECT4; DO NOT EDIT.
+
+package connect4
+
+import "github.com/morinokami/go-chip8/chip8"
+
+var Program = &chip8.Program{
+	ROM: "871349b9cac53b5f99aabd3e25a71ad9979b85f1e7664049ad62fe288d1a0557",
+	Blocks: map[uint16]*chip8.Block{
+		0x200: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block200},
+		0x21a: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxI, chip8.LDIAddr, chip8.LDIVx}, Run: block21a},
+		0x222: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block222},
+		0x234: {Insts: []chip8.Instruction{chip8.DRW, chip8.DRW, chip8.ADDVxByte, chip8.SEVxByte}, Run: block234},
+		0x23c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block23c},
+		0x23e: {Insts: []chip8.Instruction{chip8.DRW, chip8.DRW, chip8.ADDVxByte, chip8.LDVxByte, chip8.LDIAddr, chip8.DRW, chip8.DRW, chip8.LDIAddr, chip8.DRW}, Run: block23e},
+		0x252: {Insts: []chip8.Instruction{chip8.DRW, chip8.SNEVxByte}, Run: block252},
+		0x256: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block256},
+		0x258: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block258},
+		0x25a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block25a},
+		0x25c: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.ADDVxByte, chip8.SEVxByte}, Run: block25c},
+		0x262: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block262},
+		0x264: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.JPAddr}, Run: block264},
+		0x26a: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block26a},
+		0x26c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block26c},
+		0x26e: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.ADDVxByte, chip8.SEVxByte}, Run: block26e},
+		0x274: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block274},
+		0x276: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte}, Run: block276},
+		0x27a: {Insts: []chip8.Instruction{chip8.DRW, chip8.JPAddr}, Run: block27a},
+		0x27e: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.SNEVxByte}, Run: block27e},
+		0x286: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block286},
+		0x288: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.ADDVxByte, chip8.LDIVx}, Run: block288},
+		0x28e: {Insts: []chip8.Instruction{chip8.XOR, chip8.LDIAddr, chip8.SEVxByte}, Run: block28e},
+		0x294: {Insts: []chip8.Instruction{chip8.LDIAddr}, Run: block294},
+		0x296: {Insts: []chip8.Instruction{chip8.DRW}, Run: block296},
+		0x298: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.JPAddr}, Run: block298},
+	},
+}
+
+func block200(c *chip8.Context) {
+	// 0x200: 0x121a JP 0x21a
+	*c.PC = 0x21a
+}
+
+func block21a(c *chip8.Context) {
+	// 0x21a: 0xa2bb LD I, 0x2bb
+	*c.I = 0x2bb
+	// 0x21c: 0xf665 LD V6, [I]
+	c.Exec(0x21c)
+	// 0x21e: 0xa2b4 LD I, 0x2b4
+	*c.I = 0x2b4
+	// 0x220: 0xf655 LD [I], V6
+	c.Exec(0x220)
+	*c.PC = 0x222
+}
+
+func block222(c *chip8.Context) {
+	// 0x222: 0x6900 LD V9, 0x00
+	c.V[0x9] = 0x00
+	// 0x224: 0x6801 LD V8, 0x01
+	c.V[0x8] = 0x01
+	// 0x226: 0x6b00 LD Vb, 0x00
+	c.V[0xb] = 0x00
+	// 0x228: 0x6d0f LD Vd, 0x0f
+	c.V[0xd] = 0x0f
+	// 0x22a: 0x6e1f LD Ve, 0x1f
+	c.V[0xe] = 0x1f
+	// 0x22c: 0xa2a5 LD I, 0x2a5
+	*c.I = 0x2a5
+	// 0x22e: 0x600d LD V0, 0x0d
+	c.V[0x0] = 0x0d
+	// 0x230: 0x6132 LD V1, 0x32
+	c.V[0x1] = 0x32
+	// 0x232: 0x6200 LD V2, 0x00
+	c.V[0x2] = 0x00
+	*c.PC = 0x234
+}
+
+func block234(c *chip8.Context) {
+	// 0x234: 0xd02f DRW V0, V2, 0xf
+	c.Exec(0x234)
+	// 0x236: 0xd12f DRW V1, V2, 0xf
+	c.Exec(0x236)
+	// 0x238: 0x720f ADD V2, 0x0f
+	c.V[0x2] += 0x0f
+	// 0x23a: 0x321e SE V2, 0x1e
+	if c.V[0x2] == 0x1e {
+		*c.PC = 0x23e
+		return
+	}
+	*c.PC = 0x23c
+}
+
+func block23c(c *chip8.Context) {
+	// 0x23c: 0x1234 JP 0x234
+	*c.PC = 0x234
+}
+
+func block23e(c *chip8.Context) {
+	// 0x23e: 0xd021 DRW V0, V2, 0x1
+	c.Exec(0x23e)
+	// 0x240: 0xd121 DRW V1, V2, 0x1
+	c.Exec(0x240)
+	// 0x242: 0x7201 ADD V2, 0x01
+	c.V[0x2] += 0x01
+	// 0x244: 0x600a LD V0, 0x0a
+	c.V[0x0] = 0x0a
+	// 0x246: 0xa29f LD I, 0x29f
+	*c.I = 0x29f
+	// 0x248: 0xd021 DRW V0, V2, 0x1
+	c.Exec(0x248)
+	// 0x24a: 0xd121 DRW V1, V2, 0x1
+	c.Exec(0x24a)
+	// 0x24c: 0xa29f LD I, 0x29f
+	*c.I = 0x29f
+	// 0x24e: 0xdde1 DRW Vd, Ve, 0x1
+	c.Exec(0x24e)
+	*c.PC = 0x250
+}
+
+func block252(c *chip8.Context) {
+	// 0x252: 0xdde1 DRW Vd, Ve, 0x1
+	c.Exec(0x252)
+	// 0x254: 0x4c05 SNE Vc, 0x05
+	if c.V[0xc] != 0x05 {
+		*c.PC = 0x258
+		return
+	}
+	*c.PC = 0x256
+}
+
+func block256(c *chip8.Context) {
+	// 0x256: 0x127e JP 0x27e
+	*c.PC = 0x27e
+}
+
+func block258(c *chip8.Context) {
+	// 0x258: 0x3c04 SE Vc, 0x04
+	if c.V[0xc] == 0x04 {
+		*c.PC = 0x25c
+		return
+	}
+	*c.PC = 0x25a
+}
+
+func block25a(c *chip8.Context) {
+	// 0x25a: 0x126a JP 0x26a
+	*c.PC = 0x26a
+}
+
+func block25c(c *chip8.Context) {
+	// 0x25c: 0x7bff ADD Vb, 0xff
+	c.V[0xb] += 0xff
+	// 0x25e: 0x7dfb ADD Vd, 0xfb
+	c.V[0xd] += 0xfb
+	// 0x260: 0x3d0a SE Vd, 0x0a
+	if c.V[0xd] == 0x0a {
+		*c.PC = 0x264
+		return
+	}
+	*c.PC = 0x262
+}
+
+func block262(c *chip8.Context) {
+	// 0x262: 0x127a JP 0x27a
+	*c.PC = 0x27a
+}
+
+func block264(c *chip8.Context) {
+	// 0x264: 0x6b06 LD Vb, 0x06
+	c.V[0xb] = 0x06
+	// 0x266: 0x6d2d LD Vd, 0x2d
+	c.V[0xd] = 0x2d
+	// 0x268: 0x127a JP 0x27a
+	*c.PC = 0x27a
+}
+
+func block26a(c *chip8.Context) {
+	// 0x26a: 0x3c06 SE Vc, 0x06
+	if c.V[0xc] == 0x06 {
+		*c.PC = 0x26e
+		return
+	}
+	*c.PC = 0x26c
+}
+
+func block26c(c *chip8.Context) {
+	// 0x26c: 0x1298 JP 0x298
+	*c.PC = 0x298
+}
+
+func block26e(c *chip8.Context) {
+	// 0x26e: 0x7b01 ADD Vb, 0x01
+	c.V[0xb] += 0x01
+	// 0x270: 0x7d05 ADD Vd, 0x05
+	c.V[0xd] += 0x05
+	// 0x272: 0x3d32 SE Vd, 0x32
+	if c.V[0xd] == 0x32 {
+		*c.PC = 0x276
+		return
+	}
+	*c.PC = 0x274
+}
+
+func block274(c *chip8.Context) {
+	// 0x274: 0x127a JP 0x27a
+	*c.PC = 0x27a
+}
+
+func block276(c *chip8.Context) {
+	// 0x276: 0x6b00 LD Vb, 0x00
+	c.V[0xb] = 0x00
+	// 0x278: 0x6d0f LD Vd, 0x0f
+	c.V[0xd] = 0x0f
+	*c.PC = 0x27a
+}
+
+func block27a(c *chip8.Context) {
+	// 0x27a: 0xdde1 DRW Vd, Ve, 0x1
+	c.Exec(0x27a)
+	// 0x27c: 0x1250 JP 0x250
+	*c.PC = 0x250
+}
+
+func block27e(c *chip8.Context) {
+	// 0x27e: 0xa2b4 LD I, 0x2b4
+	*c.I = 0x2b4
+	// 0x280: 0xfb1e ADD I, Vb
+	*c.I += uint16(c.V[0xb])
+	// 0x282: 0xf065 LD V0, [I]
+	c.Exec(0x282)
+	// 0x284: 0x40fc SNE V0, 0xfc
+	if c.V[0x0] != 0xfc {
+		*c.PC = 0x288
+		return
+	}
+	*c.PC = 0x286
+}
+
+func block286(c *chip8.Context) {
+	// 0x286: 0x1298 JP 0x298
+	*c.PC = 0x298
+}
+
+func block288(c *chip8.Context) {
+	// 0x288: 0x8a00 LD Va, V0
+	c.V[0xa] = c.V[0x0]
+	// 0x28a: 0x70fb ADD V0, 0xfb
+	c.V[0x0] += 0xfb
+	// 0x28c: 0xf055 LD [I], V0
+	c.Exec(0x28c)
+	*c.PC = 0x28e
+}
+
+func block28e(c *chip8.Context) {
+	// 0x28e: 0x8983 XOR V9, V8
+	c.V[0x9] ^= c.V[0x8]
+	// 0x290: 0xa29e LD I, 0x29e
+	*c.I = 0x29e
+	// 0x292: 0x3900 SE V9, 0x00
+	if c.V[0x9] == 0x00 {
+		*c.PC = 0x296
+		return
+	}
+	*c.PC = 0x294
+}
+
+func block294(c *chip8.Context) {
+	// 0x294: 0xa2a1 LD I, 0x2a1
+	*c.I = 0x2a1
+	*c.PC = 0x296
+}
+
+func block296(c *chip8.Context) {
+	// 0x296: 0xdda4 DRW Vd, Va, 0x4
+	c.Exec(0x296)
+	*c.PC = 0x298
+}
+
+func block298(c *chip8.Context) {
+	// 0x298: 0xa29f LD I, 0x29f
+	*c.I = 0x29f
+	// 0x29a: 0xdde1 DRW Vd, Ve, 0x1
+	c.Exec(0x29a)
+	// 0x29c: 0x1250 JP 0x250
+	*c.PC = 0x250
+}
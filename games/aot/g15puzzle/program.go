@@ -0,0 +1,427 @@
+// Code generated by go-chip8 recompile from 15PUZZLE; DO NOT EDIT.
+
+package g15puzzle
+
+import "github.com/morinokami/go-chip8/chip8"
+
+var Program = &chip8.Program{
+	ROM: "15ce3e542f758840d2b4fb0161a2bc3f0e4947d29816ea2ea32c7b13a79b7039",
+	Blocks: map[uint16]*chip8.Block{
+		0x200: {Insts: []chip8.Instruction{chip8.CLS, chip8.LDVxByte, chip8.SNEVxByte}, Run: block200},
+		0x206: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block206},
+		0x208: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxByte, chip8.LDIVx}, Run: block208},
+		0x20e: {Insts: []chip8.Instruction{chip8.CLS}, Run: block20e},
+		0x21a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block21a},
+		0x21c: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block21c},
+		0x222: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block222},
+		0x226: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.SNEVxByte}, Run: block226},
+		0x22e: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block22e},
+		0x230: {Insts: []chip8.Instruction{chip8.LDFVx, chip8.DRW}, Run: block230},
+		0x234: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.ADDVxByte, chip8.LDVxByte, chip8.AND, chip8.SEVxByte}, Run: block234},
+		0x23e: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block23e},
+		0x240: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte, chip8.JPAddr}, Run: block240},
+		0x246: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.LDVxByte, chip8.AND, chip8.SNEVxVy}, Run: block246},
+		0x252: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block252},
+		0x256: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxVy}, Run: block256},
+		0x25c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block25c},
+		0x25e: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.LDVxByte, chip8.AND, chip8.SNEVxVy}, Run: block25e},
+		0x26a: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block26a},
+		0x26e: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxVy}, Run: block26e},
+		0x274: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block274},
+		0x276: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.LDVxByte, chip8.AND, chip8.SNEVxVy}, Run: block276},
+		0x282: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block282},
+		0x286: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxVy}, Run: block286},
+		0x28c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block28c},
+		0x28e: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.LDVxByte, chip8.AND, chip8.SNEVxVy}, Run: block28e},
+		0x29a: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block29a},
+		0x29e: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxVy}, Run: block29e},
+		0x2a4: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2a4},
+		0x2a6: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.LDIAddr, chip8.ADDIVx, chip8.LDIVx}, Run: block2a6},
+		0x2b2: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDIAddr, chip8.ADDIVx, chip8.LDIVx}, Run: block2b2},
+		0x2ba: {Insts: []chip8.Instruction{chip8.LDVxVy}, Run: block2ba},
+		0x2be: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block2be},
+		0x2c0: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2c0},
+		0x2c8: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.LDVxVy}, Run: block2c8},
+		0x2d2: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.RND}, Run: block2d2},
+		0x2d8: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.LDVxByte, chip8.AND, chip8.SKP}, Run: block2d8},
+		0x2e0: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2e0},
+		0x2e2: {Insts: []chip8.Instruction{chip8.SKNP}, Run: block2e2},
+		0x2e4: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2e4},
+	},
+}
+
+func block200(c *chip8.Context) {
+	// 0x200: 0x00e0 CLS
+	c.Exec(0x200)
+	// 0x202: 0x6c00 LD Vc, 0x00
+	c.V[0xc] = 0x00
+	// 0x204: 0x4c00 SNE Vc, 0x00
+	if c.V[0xc] != 0x00 {
+		*c.PC = 0x208
+		return
+	}
+	*c.PC = 0x206
+}
+
+func block206(c *chip8.Context) {
+	// 0x206: 0x6e0f LD Ve, 0x0f
+	c.V[0xe] = 0x0f
+	*c.PC = 0x208
+}
+
+func block208(c *chip8.Context) {
+	// 0x208: 0xa203 LD I, 0x203
+	*c.I = 0x203
+	// 0x20a: 0x6020 LD V0, 0x20
+	c.V[0x0] = 0x20
+	// 0x20c: 0xf055 LD [I], V0
+	c.Exec(0x20c)
+	*c.PC = 0x20e
+}
+
+func block20e(c *chip8.Context) {
+	// 0x20e: 0x00e0 CLS
+	c.Exec(0x20e)
+	*c.PC = 0x210
+}
+
+func block21a(c *chip8.Context) {
+	// 0x21a: 0x1210 JP 0x210
+	*c.PC = 0x210
+}
+
+func block21c(c *chip8.Context) {
+	// 0x21c: 0x6100 LD V1, 0x00
+	c.V[0x1] = 0x00
+	// 0x21e: 0x6217 LD V2, 0x17
+	c.V[0x2] = 0x17
+	// 0x220: 0x6304 LD V3, 0x04
+	c.V[0x3] = 0x04
+	*c.PC = 0x222
+}
+
+func block222(c *chip8.Context) {
+	// 0x222: 0x4110 SNE V1, 0x10
+	if c.V[0x1] != 0x10 {
+		*c.PC = 0x226
+		return
+	}
+	*c.PC = 0x224
+}
+
+func block226(c *chip8.Context) {
+	// 0x226: 0xa2e8 LD I, 0x2e8
+	*c.I = 0x2e8
+	// 0x228: 0xf11e ADD I, V1
+	*c.I += uint16(c.V[0x1])
+	// 0x22a: 0xf065 LD V0, [I]
+	c.Exec(0x22a)
+	// 0x22c: 0x4000 SNE V0, 0x00
+	if c.V[0x0] != 0x00 {
+		*c.PC = 0x230
+		return
+	}
+	*c.PC = 0x22e
+}
+
+func block22e(c *chip8.Context) {
+	// 0x22e: 0x1234 JP 0x234
+	*c.PC = 0x234
+}
+
+func block230(c *chip8.Context) {
+	// 0x230: 0xf029 LD F, V0
+	*c.I = uint16(c.V[0x0] * 5)
+	// 0x232: 0xd235 DRW V2, V3, 0x5
+	c.Exec(0x232)
+	*c.PC = 0x234
+}
+
+func block234(c *chip8.Context) {
+	// 0x234: 0x7101 ADD V1, 0x01
+	c.V[0x1] += 0x01
+	// 0x236: 0x7205 ADD V2, 0x05
+	c.V[0x2] += 0x05
+	// 0x238: 0x6403 LD V4, 0x03
+	c.V[0x4] = 0x03
+	// 0x23a: 0x8412 AND V4, V1
+	c.V[0x4] &= c.V[0x1]
+	// 0x23c: 0x3400 SE V4, 0x00
+	if c.V[0x4] == 0x00 {
+		*c.PC = 0x240
+		return
+	}
+	*c.PC = 0x23e
+}
+
+func block23e(c *chip8.Context) {
+	// 0x23e: 0x1222 JP 0x222
+	*c.PC = 0x222
+}
+
+func block240(c *chip8.Context) {
+	// 0x240: 0x6217 LD V2, 0x17
+	c.V[0x2] = 0x17
+	// 0x242: 0x7306 ADD V3, 0x06
+	c.V[0x3] += 0x06
+	// 0x244: 0x1222 JP 0x222
+	*c.PC = 0x222
+}
+
+func block246(c *chip8.Context) {
+	// 0x246: 0x6403 LD V4, 0x03
+	c.V[0x4] = 0x03
+	// 0x248: 0x84e2 AND V4, Ve
+	c.V[0x4] &= c.V[0xe]
+	// 0x24a: 0x6503 LD V5, 0x03
+	c.V[0x5] = 0x03
+	// 0x24c: 0x85d2 AND V5, Vd
+	c.V[0x5] &= c.V[0xd]
+	// 0x24e: 0x9450 SNE V4, V5
+	if c.V[0x4] != c.V[0x5] {
+		*c.PC = 0x252
+		return
+	}
+	*c.PC = 0x250
+}
+
+func block252(c *chip8.Context) {
+	// 0x252: 0x4403 SNE V4, 0x03
+	if c.V[0x4] != 0x03 {
+		*c.PC = 0x256
+		return
+	}
+	*c.PC = 0x254
+}
+
+func block256(c *chip8.Context) {
+	// 0x256: 0x6401 LD V4, 0x01
+	c.V[0x4] = 0x01
+	// 0x258: 0x84e4 ADD V4, Ve
+	c.Exec(0x258)
+	*c.PC = 0x25a
+}
+
+func block25c(c *chip8.Context) {
+	// 0x25c: 0x1246 JP 0x246
+	*c.PC = 0x246
+}
+
+func block25e(c *chip8.Context) {
+	// 0x25e: 0x6403 LD V4, 0x03
+	c.V[0x4] = 0x03
+	// 0x260: 0x84e2 AND V4, Ve
+	c.V[0x4] &= c.V[0xe]
+	// 0x262: 0x6503 LD V5, 0x03
+	c.V[0x5] = 0x03
+	// 0x264: 0x85d2 AND V5, Vd
+	c.V[0x5] &= c.V[0xd]
+	// 0x266: 0x9450 SNE V4, V5
+	if c.V[0x4] != c.V[0x5] {
+		*c.PC = 0x26a
+		return
+	}
+	*c.PC = 0x268
+}
+
+func block26a(c *chip8.Context) {
+	// 0x26a: 0x4400 SNE V4, 0x00
+	if c.V[0x4] != 0x00 {
+		*c.PC = 0x26e
+		return
+	}
+	*c.PC = 0x26c
+}
+
+func block26e(c *chip8.Context) {
+	// 0x26e: 0x64ff LD V4, 0xff
+	c.V[0x4] = 0xff
+	// 0x270: 0x84e4 ADD V4, Ve
+	c.Exec(0x270)
+	*c.PC = 0x272
+}
+
+func block274(c *chip8.Context) {
+	// 0x274: 0x125e JP 0x25e
+	*c.PC = 0x25e
+}
+
+func block276(c *chip8.Context) {
+	// 0x276: 0x640c LD V4, 0x0c
+	c.V[0x4] = 0x0c
+	// 0x278: 0x84e2 AND V4, Ve
+	c.V[0x4] &= c.V[0xe]
+	// 0x27a: 0x650c LD V5, 0x0c
+	c.V[0x5] = 0x0c
+	// 0x27c: 0x85d2 AND V5, Vd
+	c.V[0x5] &= c.V[0xd]
+	// 0x27e: 0x9450 SNE V4, V5
+	if c.V[0x4] != c.V[0x5] {
+		*c.PC = 0x282
+		return
+	}
+	*c.PC = 0x280
+}
+
+func block282(c *chip8.Context) {
+	// 0x282: 0x4400 SNE V4, 0x00
+	if c.V[0x4] != 0x00 {
+		*c.PC = 0x286
+		return
+	}
+	*c.PC = 0x284
+}
+
+func block286(c *chip8.Context) {
+	// 0x286: 0x64fc LD V4, 0xfc
+	c.V[0x4] = 0xfc
+	// 0x288: 0x84e4 ADD V4, Ve
+	c.Exec(0x288)
+	*c.PC = 0x28a
+}
+
+func block28c(c *chip8.Context) {
+	// 0x28c: 0x1276 JP 0x276
+	*c.PC = 0x276
+}
+
+func block28e(c *chip8.Context) {
+	// 0x28e: 0x640c LD V4, 0x0c
+	c.V[0x4] = 0x0c
+	// 0x290: 0x84e2 AND V4, Ve
+	c.V[0x4] &= c.V[0xe]
+	// 0x292: 0x650c LD V5, 0x0c
+	c.V[0x5] = 0x0c
+	// 0x294: 0x85d2 AND V5, Vd
+	c.V[0x5] &= c.V[0xd]
+	// 0x296: 0x9450 SNE V4, V5
+	if c.V[0x4] != c.V[0x5] {
+		*c.PC = 0x29a
+		return
+	}
+	*c.PC = 0x298
+}
+
+func block29a(c *chip8.Context) {
+	// 0x29a: 0x440c SNE V4, 0x0c
+	if c.V[0x4] != 0x0c {
+		*c.PC = 0x29e
+		return
+	}
+	*c.PC = 0x29c
+}
+
+func block29e(c *chip8.Context) {
+	// 0x29e: 0x6404 LD V4, 0x04
+	c.V[0x4] = 0x04
+	// 0x2a0: 0x84e4 ADD V4, Ve
+	c.Exec(0x2a0)
+	*c.PC = 0x2a2
+}
+
+func block2a4(c *chip8.Context) {
+	// 0x2a4: 0x128e JP 0x28e
+	*c.PC = 0x28e
+}
+
+func block2a6(c *chip8.Context) {
+	// 0x2a6: 0xa2e8 LD I, 0x2e8
+	*c.I = 0x2e8
+	// 0x2a8: 0xf41e ADD I, V4
+	*c.I += uint16(c.V[0x4])
+	// 0x2aa: 0xf065 LD V0, [I]
+	c.Exec(0x2aa)
+	// 0x2ac: 0xa2e8 LD I, 0x2e8
+	*c.I = 0x2e8
+	// 0x2ae: 0xfe1e ADD I, Ve
+	*c.I += uint16(c.V[0xe])
+	// 0x2b0: 0xf055 LD [I], V0
+	c.Exec(0x2b0)
+	*c.PC = 0x2b2
+}
+
+func block2b2(c *chip8.Context) {
+	// 0x2b2: 0x6000 LD V0, 0x00
+	c.V[0x0] = 0x00
+	// 0x2b4: 0xa2e8 LD I, 0x2e8
+	*c.I = 0x2e8
+	// 0x2b6: 0xf41e ADD I, V4
+	*c.I += uint16(c.V[0x4])
+	// 0x2b8: 0xf055 LD [I], V0
+	c.Exec(0x2b8)
+	*c.PC = 0x2ba
+}
+
+func block2ba(c *chip8.Context) {
+	// 0x2ba: 0x8e40 LD Ve, V4
+	c.V[0xe] = c.V[0x4]
+	*c.PC = 0x2bc
+}
+
+func block2be(c *chip8.Context) {
+	// 0x2be: 0x3c00 SE Vc, 0x00
+	if c.V[0xc] == 0x00 {
+		*c.PC = 0x2c2
+		return
+	}
+	*c.PC = 0x2c0
+}
+
+func block2c0(c *chip8.Context) {
+	// 0x2c0: 0x12d2 JP 0x2d2
+	*c.PC = 0x2d2
+}
+
+func block2c8(c *chip8.Context) {
+	// 0x2c8: 0xa2f8 LD I, 0x2f8
+	*c.I = 0x2f8
+	// 0x2ca: 0xfd1e ADD I, Vd
+	*c.I += uint16(c.V[0xd])
+	// 0x2cc: 0xf065 LD V0, [I]
+	c.Exec(0x2cc)
+	// 0x2ce: 0x8d00 LD Vd, V0
+	c.V[0xd] = c.V[0x0]
+	*c.PC = 0x2d0
+}
+
+func block2d2(c *chip8.Context) {
+	// 0x2d2: 0x7cff ADD Vc, 0xff
+	c.V[0xc] += 0xff
+	// 0x2d4: 0xcd0f RND Vd, byte
+	c.Exec(0x2d4)
+	*c.PC = 0x2d6
+}
+
+func block2d8(c *chip8.Context) {
+	// 0x2d8: 0x7d01 ADD Vd, 0x01
+	c.V[0xd] += 0x01
+	// 0x2da: 0x600f LD V0, 0x0f
+	c.V[0x0] = 0x0f
+	// 0x2dc: 0x8d02 AND Vd, V0
+	c.V[0xd] &= c.V[0x0]
+	// 0x2de: 0xed9e SKP Vd
+	if c.Pressed(c.V[0xd]) {
+		*c.PC = 0x2e2
+		return
+	}
+	*c.PC = 0x2e0
+}
+
+func block2e0(c *chip8.Context) {
+	// 0x2e0: 0x12d8 JP 0x2d8
+	*c.PC = 0x2d8
+}
+
+func block2e2(c *chip8.Context) {
+	// 0x2e2: 0xeda1 SKNP Vd
+	if !c.Pressed(c.V[0xd]) {
+		*c.PC = 0x2e6
+		return
+	}
+	*c.PC = 0x2e4
+}
+
+func block2e4(c *chip8.Context) {
+	// 0x2e4: 0x12e2 JP 0x2e2
+	*c.PC = 0x2e2
+}
@@ -0,0 +1,37 @@
+#!/usr/bin/env python
+
+import os
+import subprocess
+
+template = '''// Package aot holds the bundled games compiled ahead of time by go-chip8
+// recompile. Run gen.py to regenerate it.
+package aot
+
+import (
+	"github.com/morinokami/go-chip8/chip8"
+{}
+)
+
+// Programs are the compiled games by name.
+var Programs = map[string]*chip8.Program{{
+{}
+}}
+'''
+
+imports = ''
+programs = ''
+filter_func = lambda x: os.path.isfile(os.path.join('..', x)) and not x.endswith('.py') and not x.endswith('.go')
+for game in filter(filter_func, sorted(os.listdir('..'))):
+    # package names cannot start with a digit
+    pkg = game.lower()
+    if pkg[0].isdigit():
+        pkg = 'g' + pkg
+    os.makedirs(pkg, exist_ok=True)
+    subprocess.check_call(['go', 'run', '../..', 'recompile', '-g', game, '-p', pkg,
+                           os.path.join(pkg, 'program.go')])
+    imports += '\t"github.com/morinokami/go-chip8/games/aot/{}"\n'.format(pkg)
+    programs += '\t"{}": {}.Program,\n'.format(game, pkg)
+
+with open('aot.go', 'w') as f:
+    f.write(template.format(imports.rstrip('\n'), programs.rstrip('\n')))
+subprocess.check_call(['gofmt', '-w', 'aot.go'])
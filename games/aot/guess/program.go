@@ -0,0 +1,218 @@
+// Code generated by go-chip8 recompile from GUESS; DO NOT EDIT.
+
+package guess
+
+import "github.com/morinokami/go-chip8/chip8"
+
+var Program = &chip8.Program{
+	ROM: "9f5175a62e9ffb77f150e494e77f525a73800f54d569cf3455bf7c2264ffc922",
+	Blocks: map[uint16]*chip8.Block{
+		0x200: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block200},
+		0x202: {Insts: []chip8.Instruction{chip8.CLS, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block202},
+		0x20a: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.AND, chip8.SNEVxByte}, Run: block20a},
+		0x210: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block210},
+		0x212: {Insts: []chip8.Instruction{chip8.LDVxVy}, Run: block212},
+		0x216: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block216},
+		0x218: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block218},
+		0x21a: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte, chip8.SEVxByte}, Run: block21a},
+		0x220: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block220},
+		0x222: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block222},
+		0x224: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block224},
+		0x228: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block228},
+		0x22a: {Insts: []chip8.Instruction{chip8.ADDVxVy}, Run: block22a},
+		0x22c: {Insts: []chip8.Instruction{chip8.ADDVxVy, chip8.SEVxByte}, Run: block22c},
+		0x230: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block230},
+		0x232: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxVy, chip8.CLS}, Run: block232},
+		0x23c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block23c},
+		0x23e: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDBVx}, Run: block23e},
+		0x242: {Insts: []chip8.Instruction{chip8.LDVxI}, Run: block242},
+		0x246: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.LDVxVy}, Run: block246},
+		0x24e: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte}, Run: block24e},
+		0x254: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.ADDVxVy, chip8.ADDVxVy, chip8.ADDVxVy, chip8.LDIAddr, chip8.ADDIVx}, Run: block254},
+	},
+}
+
+func block200(c *chip8.Context) {
+	// 0x200: 0x6e01 LD Ve, 0x01
+	c.V[0xe] = 0x01
+	*c.PC = 0x202
+}
+
+func block202(c *chip8.Context) {
+	// 0x202: 0x00e0 CLS
+	c.Exec(0x202)
+	// 0x204: 0x6d01 LD Vd, 0x01
+	c.V[0xd] = 0x01
+	// 0x206: 0x6a01 LD Va, 0x01
+	c.V[0xa] = 0x01
+	// 0x208: 0x6b01 LD Vb, 0x01
+	c.V[0xb] = 0x01
+	*c.PC = 0x20a
+}
+
+func block20a(c *chip8.Context) {
+	// 0x20a: 0x8cd0 LD Vc, Vd
+	c.V[0xc] = c.V[0xd]
+	// 0x20c: 0x8ce2 AND Vc, Ve
+	c.V[0xc] &= c.V[0xe]
+	// 0x20e: 0x4c00 SNE Vc, 0x00
+	if c.V[0xc] != 0x00 {
+		*c.PC = 0x212
+		return
+	}
+	*c.PC = 0x210
+}
+
+func block210(c *chip8.Context) {
+	// 0x210: 0x1220 JP 0x220
+	*c.PC = 0x220
+}
+
+func block212(c *chip8.Context) {
+	// 0x212: 0x88d0 LD V8, Vd
+	c.V[0x8] = c.V[0xd]
+	*c.PC = 0x214
+}
+
+func block216(c *chip8.Context) {
+	// 0x216: 0x3a40 SE Va, 0x40
+	if c.V[0xa] == 0x40 {
+		*c.PC = 0x21a
+		return
+	}
+	*c.PC = 0x218
+}
+
+func block218(c *chip8.Context) {
+	// 0x218: 0x1220 JP 0x220
+	*c.PC = 0x220
+}
+
+func block21a(c *chip8.Context) {
+	// 0x21a: 0x6a01 LD Va, 0x01
+	c.V[0xa] = 0x01
+	// 0x21c: 0x7b06 ADD Vb, 0x06
+	c.V[0xb] += 0x06
+	// 0x21e: 0x3c3f SE Vc, 0x3f
+	if c.V[0xc] == 0x3f {
+		*c.PC = 0x222
+		return
+	}
+	*c.PC = 0x220
+}
+
+func block220(c *chip8.Context) {
+	// 0x220: 0x7d01 ADD Vd, 0x01
+	c.V[0xd] += 0x01
+	*c.PC = 0x222
+}
+
+func block222(c *chip8.Context) {
+	// 0x222: 0x3d3f SE Vd, 0x3f
+	if c.V[0xd] == 0x3f {
+		*c.PC = 0x226
+		return
+	}
+	*c.PC = 0x224
+}
+
+func block224(c *chip8.Context) {
+	// 0x224: 0x120a JP 0x20a
+	*c.PC = 0x20a
+}
+
+func block228(c *chip8.Context) {
+	// 0x228: 0x4005 SNE V0, 0x05
+	if c.V[0x0] != 0x05 {
+		*c.PC = 0x22c
+		return
+	}
+	*c.PC = 0x22a
+}
+
+func block22a(c *chip8.Context) {
+	// 0x22a: 0x89e4 ADD V9, Ve
+	c.Exec(0x22a)
+	*c.PC = 0x22c
+}
+
+func block22c(c *chip8.Context) {
+	// 0x22c: 0x8ee4 ADD Ve, Ve
+	c.Exec(0x22c)
+	// 0x22e: 0x3e40 SE Ve, 0x40
+	if c.V[0xe] == 0x40 {
+		*c.PC = 0x232
+		return
+	}
+	*c.PC = 0x230
+}
+
+func block230(c *chip8.Context) {
+	// 0x230: 0x1202 JP 0x202
+	*c.PC = 0x202
+}
+
+func block232(c *chip8.Context) {
+	// 0x232: 0x6a1c LD Va, 0x1c
+	c.V[0xa] = 0x1c
+	// 0x234: 0x6b0d LD Vb, 0x0d
+	c.V[0xb] = 0x0d
+	// 0x236: 0x8890 LD V8, V9
+	c.V[0x8] = c.V[0x9]
+	// 0x238: 0x00e0 CLS
+	c.Exec(0x238)
+	*c.PC = 0x23a
+}
+
+func block23c(c *chip8.Context) {
+	// 0x23c: 0x123c JP 0x23c
+	*c.PC = 0x23c
+}
+
+func block23e(c *chip8.Context) {
+	// 0x23e: 0xa294 LD I, 0x294
+	*c.I = 0x294
+	// 0x240: 0xf833 LD B, V8
+	c.Exec(0x240)
+	*c.PC = 0x242
+}
+
+func block242(c *chip8.Context) {
+	// 0x242: 0xf265 LD V2, [I]
+	c.Exec(0x242)
+	*c.PC = 0x244
+}
+
+func block246(c *chip8.Context) {
+	// 0x246: 0xdab5 DRW Va, Vb, 0x5
+	c.Exec(0x246)
+	// 0x248: 0x7a04 ADD Va, 0x04
+	c.V[0xa] += 0x04
+	// 0x24a: 0x8120 LD V1, V2
+	c.V[0x1] = c.V[0x2]
+	*c.PC = 0x24c
+}
+
+func block24e(c *chip8.Context) {
+	// 0x24e: 0xdab5 DRW Va, Vb, 0x5
+	c.Exec(0x24e)
+	// 0x250: 0x7a05 ADD Va, 0x05
+	c.V[0xa] += 0x05
+	*c.PC = 0x252
+}
+
+func block254(c *chip8.Context) {
+	// 0x254: 0x8310 LD V3, V1
+	c.V[0x3] = c.V[0x1]
+	// 0x256: 0x8334 ADD V3, V3
+	c.Exec(0x256)
+	// 0x258: 0x8334 ADD V3, V3
+	c.Exec(0x258)
+	// 0x25a: 0x8314 ADD V3, V1
+	c.Exec(0x25a)
+	// 0x25c: 0xa262 LD I, 0x262
+	*c.I = 0x262
+	// 0x25e: 0xf31e ADD I, V3
+	*c.I += uint16(c.V[0x3])
+	*c.PC = 0x260
+}
@@ -0,0 +1,951 @@
+// Code generated by go-chip8 recompile from HIDDEN; DO NOT EDIT.
+
+package hidden
+
+import "github.com/morinokami/go-chip8/chip8"
+
+var Program = &chip8.Program{
+	ROM: "4f0b0ea0ca8cb819574dd1bef22943dd04282e005647f9dcfd9246d4e2458a89",
+	Blocks: map[uint16]*chip8.Block{
+		0x200: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block200},
+		0x21d: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxByte, chip8.LDVxByte, chip8.LDIVx}, Run: block21d},
+		0x225: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxByte, chip8.LDIVx}, Run: block225},
+		0x22b: {Insts: []chip8.Instruction{chip8.CLS, chip8.LDIAddr, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block22b},
+		0x235: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.ADDIVx, chip8.SEVxByte}, Run: block235},
+		0x23d: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block23d},
+		0x241: {Insts: []chip8.Instruction{chip8.CLS, chip8.LDIAddr, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block241},
+		0x24b: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.ADDIVx, chip8.SEVxByte}, Run: block24b},
+		0x253: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block253},
+		0x255: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxI, chip8.LDIAddr, chip8.LDIVx}, Run: block255},
+		0x25d: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte}, Run: block25d},
+		0x261: {Insts: []chip8.Instruction{chip8.RND, chip8.RND, chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.LDVxVy, chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.LDVxVy}, Run: block261},
+		0x279: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxVy, chip8.LDIVx}, Run: block279},
+		0x281: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SEVxByte}, Run: block281},
+		0x285: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block285},
+		0x287: {Insts: []chip8.Instruction{chip8.CLS, chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr}, Run: block287},
+		0x28f: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.SEVxByte}, Run: block28f},
+		0x295: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block295},
+		0x297: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte, chip8.SEVxByte}, Run: block297},
+		0x29d: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block29d},
+		0x29f: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block29f},
+		0x2a5: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxI, chip8.ADDVxByte, chip8.LDIVx}, Run: block2a5},
+		0x2af: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2af},
+		0x2b5: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxVy, chip8.LDVxVy}, Run: block2b5},
+		0x2c1: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.LDVxVy, chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.SEVxVy}, Run: block2c1},
+		0x2d1: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2d1},
+		0x2d5: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2d5},
+		0x2db: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDIAddr, chip8.ADDIVx, chip8.LDIVx}, Run: block2db},
+		0x2e3: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.LDIVx}, Run: block2e3},
+		0x2e9: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SEVxByte}, Run: block2e9},
+		0x2ed: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2ed},
+		0x2ef: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxI, chip8.LDVxVy, chip8.SUB, chip8.SEVxByte}, Run: block2ef},
+		0x2f9: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2f9},
+		0x2fb: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxVy, chip8.LDIVx}, Run: block2fb},
+		0x301: {Insts: []chip8.Instruction{chip8.CLS, chip8.LDIAddr, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block301},
+		0x30b: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.ADDIVx, chip8.SEVxByte}, Run: block30b},
+		0x313: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block313},
+		0x315: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxI, chip8.LDVxVy, chip8.LDVxVy, chip8.LDVxByte}, Run: block315},
+		0x321: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxVy}, Run: block321},
+		0x329: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block329},
+		0x32d: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block32d},
+		0x333: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.ADDVxByte}, Run: block333},
+		0x33d: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.DRW, chip8.LDIAddr, chip8.DRW, chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.ADDVxByte}, Run: block33d},
+		0x351: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.DRW, chip8.LDIAddr, chip8.DRW, chip8.JPAddr}, Run: block351},
+		0x35d: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW}, Run: block35d},
+		0x363: {Insts: []chip8.Instruction{chip8.DRW, chip8.SEVxByte}, Run: block363},
+		0x367: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block367},
+		0x369: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block369},
+		0x36b: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block36b},
+		0x36d: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.ADDVxByte}, Run: block36d},
+		0x371: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block371},
+		0x373: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block373},
+		0x375: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block375},
+		0x377: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block377},
+		0x379: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.ADDVxByte}, Run: block379},
+		0x37d: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block37d},
+		0x37f: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block37f},
+		0x381: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block381},
+		0x383: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block383},
+		0x385: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.ADDVxByte}, Run: block385},
+		0x389: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block389},
+		0x38b: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block38b},
+		0x38d: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block38d},
+		0x38f: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block38f},
+		0x391: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.ADDVxByte}, Run: block391},
+		0x395: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block395},
+		0x397: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block397},
+		0x399: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.SNEVxByte}, Run: block399},
+		0x3a1: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block3a1},
+		0x3a3: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.SNEVxVy}, Run: block3a3},
+		0x3a7: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block3a7},
+		0x3a9: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.LDIAddr, chip8.DRW, chip8.LDIAddr}, Run: block3a9},
+		0x3b3: {Insts: []chip8.Instruction{chip8.ADDIVx, chip8.DRW}, Run: block3b3},
+		0x3b9: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block3b9},
+		0x3c1: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.ADDIVx, chip8.SEVxByte}, Run: block3c1},
+		0x3c9: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block3c9},
+		0x3cd: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr, chip8.DRW, chip8.LDIAddr, chip8.DRW}, Run: block3cd},
+		0x3db: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.JPAddr}, Run: block3db},
+		0x3df: {Insts: []chip8.Instruction{chip8.LDIAddr}, Run: block3df},
+		0x3e1: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block3e1},
+		0x3e7: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.ADDIVx, chip8.SEVxByte}, Run: block3e7},
+		0x3ef: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block3ef},
+		0x3f3: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.ADDVxVy, chip8.ADDVxVy, chip8.ADDVxVy, chip8.ADDVxVy, chip8.SUB}, Run: block3f3},
+		0x401: {Insts: []chip8.Instruction{chip8.LDDTVx}, Run: block401},
+		0x403: {Insts: []chip8.Instruction{chip8.LDVxDT, chip8.SEVxByte}, Run: block403},
+		0x407: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block407},
+		0x40b: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDBVx}, Run: block40b},
+		0x40f: {Insts: []chip8.Instruction{chip8.LDVxI, chip8.LDVxByte, chip8.LDFVx, chip8.DRW, chip8.LDVxByte, chip8.LDFVx, chip8.DRW}, Run: block40f},
+	},
+}
+
+func block200(c *chip8.Context) {
+	// 0x200: 0x121d JP 0x21d
+	*c.PC = 0x21d
+}
+
+func block21d(c *chip8.Context) {
+	// 0x21d: 0xa43f LD I, 0x43f
+	*c.I = 0x43f
+	// 0x21f: 0x6000 LD V0, 0x00
+	c.V[0x0] = 0x00
+	// 0x221: 0x6140 LD V1, 0x40
+	c.V[0x1] = 0x40
+	// 0x223: 0xf155 LD [I], V1
+	c.Exec(0x223)
+	*c.PC = 0x225
+}
+
+func block225(c *chip8.Context) {
+	// 0x225: 0xa43f LD I, 0x43f
+	*c.I = 0x43f
+	// 0x227: 0x6000 LD V0, 0x00
+	c.V[0x0] = 0x00
+	// 0x229: 0xf055 LD [I], V0
+	c.Exec(0x229)
+	*c.PC = 0x22b
+}
+
+func block22b(c *chip8.Context) {
+	// 0x22b: 0x00e0 CLS
+	c.Exec(0x22b)
+	// 0x22d: 0xa47e LD I, 0x47e
+	*c.I = 0x47e
+	// 0x22f: 0x600c LD V0, 0x0c
+	c.V[0x0] = 0x0c
+	// 0x231: 0x6108 LD V1, 0x08
+	c.V[0x1] = 0x08
+	// 0x233: 0x620f LD V2, 0x0f
+	c.V[0x2] = 0x0f
+	*c.PC = 0x235
+}
+
+func block235(c *chip8.Context) {
+	// 0x235: 0xd01f DRW V0, V1, 0xf
+	c.Exec(0x235)
+	// 0x237: 0x7008 ADD V0, 0x08
+	c.V[0x0] += 0x08
+	// 0x239: 0xf21e ADD I, V2
+	*c.I += uint16(c.V[0x2])
+	// 0x23b: 0x3034 SE V0, 0x34
+	if c.V[0x0] == 0x34 {
+		*c.PC = 0x23f
+		return
+	}
+	*c.PC = 0x23d
+}
+
+func block23d(c *chip8.Context) {
+	// 0x23d: 0x1235 JP 0x235
+	*c.PC = 0x235
+}
+
+func block241(c *chip8.Context) {
+	// 0x241: 0x00e0 CLS
+	c.Exec(0x241)
+	// 0x243: 0xa4c9 LD I, 0x4c9
+	*c.I = 0x4c9
+	// 0x245: 0x6013 LD V0, 0x13
+	c.V[0x0] = 0x13
+	// 0x247: 0x610d LD V1, 0x0d
+	c.V[0x1] = 0x0d
+	// 0x249: 0x6204 LD V2, 0x04
+	c.V[0x2] = 0x04
+	*c.PC = 0x24b
+}
+
+func block24b(c *chip8.Context) {
+	// 0x24b: 0xd014 DRW V0, V1, 0x4
+	c.Exec(0x24b)
+	// 0x24d: 0x7008 ADD V0, 0x08
+	c.V[0x0] += 0x08
+	// 0x24f: 0xf21e ADD I, V2
+	*c.I += uint16(c.V[0x2])
+	// 0x251: 0x302b SE V0, 0x2b
+	if c.V[0x0] == 0x2b {
+		*c.PC = 0x255
+		return
+	}
+	*c.PC = 0x253
+}
+
+func block253(c *chip8.Context) {
+	// 0x253: 0x124b JP 0x24b
+	*c.PC = 0x24b
+}
+
+func block255(c *chip8.Context) {
+	// 0x255: 0xa41f LD I, 0x41f
+	*c.I = 0x41f
+	// 0x257: 0xff65 LD Vf, [I]
+	c.Exec(0x257)
+	// 0x259: 0xa42f LD I, 0x42f
+	*c.I = 0x42f
+	// 0x25b: 0xff55 LD [I], Vf
+	c.Exec(0x25b)
+	*c.PC = 0x25d
+}
+
+func block25d(c *chip8.Context) {
+	// 0x25d: 0x6340 LD V3, 0x40
+	c.V[0x3] = 0x40
+	// 0x25f: 0x6608 LD V6, 0x08
+	c.V[0x6] = 0x08
+	*c.PC = 0x261
+}
+
+func block261(c *chip8.Context) {
+	// 0x261: 0xc10f RND V1, byte
+	c.Exec(0x261)
+	// 0x263: 0xc20f RND V2, byte
+	c.Exec(0x263)
+	// 0x265: 0xa42f LD I, 0x42f
+	*c.I = 0x42f
+	// 0x267: 0xf11e ADD I, V1
+	*c.I += uint16(c.V[0x1])
+	// 0x269: 0xf065 LD V0, [I]
+	c.Exec(0x269)
+	// 0x26b: 0x8400 LD V4, V0
+	c.V[0x4] = c.V[0x0]
+	// 0x26d: 0xa42f LD I, 0x42f
+	*c.I = 0x42f
+	// 0x26f: 0xf21e ADD I, V2
+	*c.I += uint16(c.V[0x2])
+	// 0x271: 0xf065 LD V0, [I]
+	c.Exec(0x271)
+	// 0x273: 0x8500 LD V5, V0
+	c.V[0x5] = c.V[0x0]
+	*c.PC = 0x275
+}
+
+func block279(c *chip8.Context) {
+	// 0x279: 0xa42f LD I, 0x42f
+	*c.I = 0x42f
+	// 0x27b: 0xf11e ADD I, V1
+	*c.I += uint16(c.V[0x1])
+	// 0x27d: 0x8050 LD V0, V5
+	c.V[0x0] = c.V[0x5]
+	// 0x27f: 0xf055 LD [I], V0
+	c.Exec(0x27f)
+	*c.PC = 0x281
+}
+
+func block281(c *chip8.Context) {
+	// 0x281: 0x73ff ADD V3, 0xff
+	c.V[0x3] += 0xff
+	// 0x283: 0x3300 SE V3, 0x00
+	if c.V[0x3] == 0x00 {
+		*c.PC = 0x287
+		return
+	}
+	*c.PC = 0x285
+}
+
+func block285(c *chip8.Context) {
+	// 0x285: 0x1261 JP 0x261
+	*c.PC = 0x261
+}
+
+func block287(c *chip8.Context) {
+	// 0x287: 0x00e0 CLS
+	c.Exec(0x287)
+	// 0x289: 0x6000 LD V0, 0x00
+	c.V[0x0] = 0x00
+	// 0x28b: 0x6100 LD V1, 0x00
+	c.V[0x1] = 0x00
+	// 0x28d: 0xa477 LD I, 0x477
+	*c.I = 0x477
+	*c.PC = 0x28f
+}
+
+func block28f(c *chip8.Context) {
+	// 0x28f: 0xd017 DRW V0, V1, 0x7
+	c.Exec(0x28f)
+	// 0x291: 0x7008 ADD V0, 0x08
+	c.V[0x0] += 0x08
+	// 0x293: 0x3020 SE V0, 0x20
+	if c.V[0x0] == 0x20 {
+		*c.PC = 0x297
+		return
+	}
+	*c.PC = 0x295
+}
+
+func block295(c *chip8.Context) {
+	// 0x295: 0x128f JP 0x28f
+	*c.PC = 0x28f
+}
+
+func block297(c *chip8.Context) {
+	// 0x297: 0x6000 LD V0, 0x00
+	c.V[0x0] = 0x00
+	// 0x299: 0x7108 ADD V1, 0x08
+	c.V[0x1] += 0x08
+	// 0x29b: 0x3120 SE V1, 0x20
+	if c.V[0x1] == 0x20 {
+		*c.PC = 0x29f
+		return
+	}
+	*c.PC = 0x29d
+}
+
+func block29d(c *chip8.Context) {
+	// 0x29d: 0x128f JP 0x28f
+	*c.PC = 0x28f
+}
+
+func block29f(c *chip8.Context) {
+	// 0x29f: 0x6c00 LD Vc, 0x00
+	c.V[0xc] = 0x00
+	// 0x2a1: 0x6d00 LD Vd, 0x00
+	c.V[0xd] = 0x00
+	// 0x2a3: 0x6e00 LD Ve, 0x00
+	c.V[0xe] = 0x00
+	*c.PC = 0x2a5
+}
+
+func block2a5(c *chip8.Context) {
+	// 0x2a5: 0xa43f LD I, 0x43f
+	*c.I = 0x43f
+	// 0x2a7: 0xf065 LD V0, [I]
+	c.Exec(0x2a7)
+	// 0x2a9: 0x7001 ADD V0, 0x01
+	c.V[0x0] += 0x01
+	// 0x2ab: 0xf055 LD [I], V0
+	c.Exec(0x2ab)
+	*c.PC = 0x2ad
+}
+
+func block2af(c *chip8.Context) {
+	// 0x2af: 0x6a10 LD Va, 0x10
+	c.V[0xa] = 0x10
+	*c.PC = 0x2b1
+}
+
+func block2b5(c *chip8.Context) {
+	// 0x2b5: 0x8a90 LD Va, V9
+	c.V[0xa] = c.V[0x9]
+	// 0x2b7: 0x87d0 LD V7, Vd
+	c.V[0x7] = c.V[0xd]
+	// 0x2b9: 0x88e0 LD V8, Ve
+	c.V[0x8] = c.V[0xe]
+	*c.PC = 0x2bb
+}
+
+func block2c1(c *chip8.Context) {
+	// 0x2c1: 0xa42f LD I, 0x42f
+	*c.I = 0x42f
+	// 0x2c3: 0xf91e ADD I, V9
+	*c.I += uint16(c.V[0x9])
+	// 0x2c5: 0xf065 LD V0, [I]
+	c.Exec(0x2c5)
+	// 0x2c7: 0x8100 LD V1, V0
+	c.V[0x1] = c.V[0x0]
+	// 0x2c9: 0xa42f LD I, 0x42f
+	*c.I = 0x42f
+	// 0x2cb: 0xfa1e ADD I, Va
+	*c.I += uint16(c.V[0xa])
+	// 0x2cd: 0xf065 LD V0, [I]
+	c.Exec(0x2cd)
+	// 0x2cf: 0x5010 SE V0, V1
+	if c.V[0x0] == c.V[0x1] {
+		*c.PC = 0x2d3
+		return
+	}
+	*c.PC = 0x2d1
+}
+
+func block2d1(c *chip8.Context) {
+	// 0x2d1: 0x132b JP 0x32b
+	*c.PC = 0x32b
+}
+
+func block2d5(c *chip8.Context) {
+	// 0x2d5: 0x6020 LD V0, 0x20
+	c.V[0x0] = 0x20
+	*c.PC = 0x2d7
+}
+
+func block2db(c *chip8.Context) {
+	// 0x2db: 0x6000 LD V0, 0x00
+	c.V[0x0] = 0x00
+	// 0x2dd: 0xa42f LD I, 0x42f
+	*c.I = 0x42f
+	// 0x2df: 0xf91e ADD I, V9
+	*c.I += uint16(c.V[0x9])
+	// 0x2e1: 0xf055 LD [I], V0
+	c.Exec(0x2e1)
+	*c.PC = 0x2e3
+}
+
+func block2e3(c *chip8.Context) {
+	// 0x2e3: 0xa42f LD I, 0x42f
+	*c.I = 0x42f
+	// 0x2e5: 0xfa1e ADD I, Va
+	*c.I += uint16(c.V[0xa])
+	// 0x2e7: 0xf055 LD [I], V0
+	c.Exec(0x2e7)
+	*c.PC = 0x2e9
+}
+
+func block2e9(c *chip8.Context) {
+	// 0x2e9: 0x76ff ADD V6, 0xff
+	c.V[0x6] += 0xff
+	// 0x2eb: 0x3600 SE V6, 0x00
+	if c.V[0x6] == 0x00 {
+		*c.PC = 0x2ef
+		return
+	}
+	*c.PC = 0x2ed
+}
+
+func block2ed(c *chip8.Context) {
+	// 0x2ed: 0x12a5 JP 0x2a5
+	*c.PC = 0x2a5
+}
+
+func block2ef(c *chip8.Context) {
+	// 0x2ef: 0xa43f LD I, 0x43f
+	*c.I = 0x43f
+	// 0x2f1: 0xf165 LD V1, [I]
+	c.Exec(0x2f1)
+	// 0x2f3: 0x8200 LD V2, V0
+	c.V[0x2] = c.V[0x0]
+	// 0x2f5: 0x8015 SUB V0, V1
+	c.Exec(0x2f5)
+	// 0x2f7: 0x3f00 SE Vf, 0x00
+	if c.V[0xf] == 0x00 {
+		*c.PC = 0x2fb
+		return
+	}
+	*c.PC = 0x2f9
+}
+
+func block2f9(c *chip8.Context) {
+	// 0x2f9: 0x1301 JP 0x301
+	*c.PC = 0x301
+}
+
+func block2fb(c *chip8.Context) {
+	// 0x2fb: 0x8020 LD V0, V2
+	c.V[0x0] = c.V[0x2]
+	// 0x2fd: 0x8120 LD V1, V2
+	c.V[0x1] = c.V[0x2]
+	// 0x2ff: 0xf155 LD [I], V1
+	c.Exec(0x2ff)
+	*c.PC = 0x301
+}
+
+func block301(c *chip8.Context) {
+	// 0x301: 0x00e0 CLS
+	c.Exec(0x301)
+	// 0x303: 0xa519 LD I, 0x519
+	*c.I = 0x519
+	// 0x305: 0x6010 LD V0, 0x10
+	c.V[0x0] = 0x10
+	// 0x307: 0x6107 LD V1, 0x07
+	c.V[0x1] = 0x07
+	// 0x309: 0x620e LD V2, 0x0e
+	c.V[0x2] = 0x0e
+	*c.PC = 0x30b
+}
+
+func block30b(c *chip8.Context) {
+	// 0x30b: 0xd01f DRW V0, V1, 0xf
+	c.Exec(0x30b)
+	// 0x30d: 0x7008 ADD V0, 0x08
+	c.V[0x0] += 0x08
+	// 0x30f: 0xf21e ADD I, V2
+	*c.I += uint16(c.V[0x2])
+	// 0x311: 0x3030 SE V0, 0x30
+	if c.V[0x0] == 0x30 {
+		*c.PC = 0x315
+		return
+	}
+	*c.PC = 0x313
+}
+
+func block313(c *chip8.Context) {
+	// 0x313: 0x130b JP 0x30b
+	*c.PC = 0x30b
+}
+
+func block315(c *chip8.Context) {
+	// 0x315: 0xa43f LD I, 0x43f
+	*c.I = 0x43f
+	// 0x317: 0xf165 LD V1, [I]
+	c.Exec(0x317)
+	// 0x319: 0x8410 LD V4, V1
+	c.V[0x4] = c.V[0x1]
+	// 0x31b: 0x8300 LD V3, V0
+	c.V[0x3] = c.V[0x0]
+	// 0x31d: 0x6609 LD V6, 0x09
+	c.V[0x6] = 0x09
+	*c.PC = 0x31f
+}
+
+func block321(c *chip8.Context) {
+	// 0x321: 0x660f LD V6, 0x0f
+	c.V[0x6] = 0x0f
+	// 0x323: 0x8340 LD V3, V4
+	c.V[0x3] = c.V[0x4]
+	*c.PC = 0x325
+}
+
+func block329(c *chip8.Context) {
+	// 0x329: 0x1225 JP 0x225
+	*c.PC = 0x225
+}
+
+func block32d(c *chip8.Context) {
+	// 0x32d: 0x6080 LD V0, 0x80
+	c.V[0x0] = 0x80
+	*c.PC = 0x32f
+}
+
+func block333(c *chip8.Context) {
+	// 0x333: 0xa42f LD I, 0x42f
+	*c.I = 0x42f
+	// 0x335: 0xfa1e ADD I, Va
+	*c.I += uint16(c.V[0xa])
+	// 0x337: 0xf065 LD V0, [I]
+	c.Exec(0x337)
+	// 0x339: 0x70ff ADD V0, 0xff
+	c.V[0x0] += 0xff
+	*c.PC = 0x33b
+}
+
+func block33d(c *chip8.Context) {
+	// 0x33d: 0xa441 LD I, 0x441
+	*c.I = 0x441
+	// 0x33f: 0xf01e ADD I, V0
+	*c.I += uint16(c.V[0x0])
+	// 0x341: 0xd787 DRW V7, V8, 0x7
+	c.Exec(0x341)
+	// 0x343: 0xa477 LD I, 0x477
+	*c.I = 0x477
+	// 0x345: 0xd787 DRW V7, V8, 0x7
+	c.Exec(0x345)
+	// 0x347: 0xa42f LD I, 0x42f
+	*c.I = 0x42f
+	// 0x349: 0xf91e ADD I, V9
+	*c.I += uint16(c.V[0x9])
+	// 0x34b: 0xf065 LD V0, [I]
+	c.Exec(0x34b)
+	// 0x34d: 0x70ff ADD V0, 0xff
+	c.V[0x0] += 0xff
+	*c.PC = 0x34f
+}
+
+func block351(c *chip8.Context) {
+	// 0x351: 0xa441 LD I, 0x441
+	*c.I = 0x441
+	// 0x353: 0xf01e ADD I, V0
+	*c.I += uint16(c.V[0x0])
+	// 0x355: 0xdde7 DRW Vd, Ve, 0x7
+	c.Exec(0x355)
+	// 0x357: 0xa477 LD I, 0x477
+	*c.I = 0x477
+	// 0x359: 0xdde7 DRW Vd, Ve, 0x7
+	c.Exec(0x359)
+	// 0x35b: 0x12a5 JP 0x2a5
+	*c.PC = 0x2a5
+}
+
+func block35d(c *chip8.Context) {
+	// 0x35d: 0xa471 LD I, 0x471
+	*c.I = 0x471
+	// 0x35f: 0xdde7 DRW Vd, Ve, 0x7
+	c.Exec(0x35f)
+	*c.PC = 0x361
+}
+
+func block363(c *chip8.Context) {
+	// 0x363: 0xdde7 DRW Vd, Ve, 0x7
+	c.Exec(0x363)
+	// 0x365: 0x3b04 SE Vb, 0x04
+	if c.V[0xb] == 0x04 {
+		*c.PC = 0x369
+		return
+	}
+	*c.PC = 0x367
+}
+
+func block367(c *chip8.Context) {
+	// 0x367: 0x1371 JP 0x371
+	*c.PC = 0x371
+}
+
+func block369(c *chip8.Context) {
+	// 0x369: 0x4d00 SNE Vd, 0x00
+	if c.V[0xd] != 0x00 {
+		*c.PC = 0x36d
+		return
+	}
+	*c.PC = 0x36b
+}
+
+func block36b(c *chip8.Context) {
+	// 0x36b: 0x135d JP 0x35d
+	*c.PC = 0x35d
+}
+
+func block36d(c *chip8.Context) {
+	// 0x36d: 0x7df8 ADD Vd, 0xf8
+	c.V[0xd] += 0xf8
+	// 0x36f: 0x7cff ADD Vc, 0xff
+	c.V[0xc] += 0xff
+	*c.PC = 0x371
+}
+
+func block371(c *chip8.Context) {
+	// 0x371: 0x3b06 SE Vb, 0x06
+	if c.V[0xb] == 0x06 {
+		*c.PC = 0x375
+		return
+	}
+	*c.PC = 0x373
+}
+
+func block373(c *chip8.Context) {
+	// 0x373: 0x137d JP 0x37d
+	*c.PC = 0x37d
+}
+
+func block375(c *chip8.Context) {
+	// 0x375: 0x4d18 SNE Vd, 0x18
+	if c.V[0xd] != 0x18 {
+		*c.PC = 0x379
+		return
+	}
+	*c.PC = 0x377
+}
+
+func block377(c *chip8.Context) {
+	// 0x377: 0x135d JP 0x35d
+	*c.PC = 0x35d
+}
+
+func block379(c *chip8.Context) {
+	// 0x379: 0x7d08 ADD Vd, 0x08
+	c.V[0xd] += 0x08
+	// 0x37b: 0x7c01 ADD Vc, 0x01
+	c.V[0xc] += 0x01
+	*c.PC = 0x37d
+}
+
+func block37d(c *chip8.Context) {
+	// 0x37d: 0x3b02 SE Vb, 0x02
+	if c.V[0xb] == 0x02 {
+		*c.PC = 0x381
+		return
+	}
+	*c.PC = 0x37f
+}
+
+func block37f(c *chip8.Context) {
+	// 0x37f: 0x1389 JP 0x389
+	*c.PC = 0x389
+}
+
+func block381(c *chip8.Context) {
+	// 0x381: 0x4e00 SNE Ve, 0x00
+	if c.V[0xe] != 0x00 {
+		*c.PC = 0x385
+		return
+	}
+	*c.PC = 0x383
+}
+
+func block383(c *chip8.Context) {
+	// 0x383: 0x135d JP 0x35d
+	*c.PC = 0x35d
+}
+
+func block385(c *chip8.Context) {
+	// 0x385: 0x7ef8 ADD Ve, 0xf8
+	c.V[0xe] += 0xf8
+	// 0x387: 0x7cfc ADD Vc, 0xfc
+	c.V[0xc] += 0xfc
+	*c.PC = 0x389
+}
+
+func block389(c *chip8.Context) {
+	// 0x389: 0x3b08 SE Vb, 0x08
+	if c.V[0xb] == 0x08 {
+		*c.PC = 0x38d
+		return
+	}
+	*c.PC = 0x38b
+}
+
+func block38b(c *chip8.Context) {
+	// 0x38b: 0x1395 JP 0x395
+	*c.PC = 0x395
+}
+
+func block38d(c *chip8.Context) {
+	// 0x38d: 0x4e18 SNE Ve, 0x18
+	if c.V[0xe] != 0x18 {
+		*c.PC = 0x391
+		return
+	}
+	*c.PC = 0x38f
+}
+
+func block38f(c *chip8.Context) {
+	// 0x38f: 0x135d JP 0x35d
+	*c.PC = 0x35d
+}
+
+func block391(c *chip8.Context) {
+	// 0x391: 0x7e08 ADD Ve, 0x08
+	c.V[0xe] += 0x08
+	// 0x393: 0x7c04 ADD Vc, 0x04
+	c.V[0xc] += 0x04
+	*c.PC = 0x395
+}
+
+func block395(c *chip8.Context) {
+	// 0x395: 0x3b05 SE Vb, 0x05
+	if c.V[0xb] == 0x05 {
+		*c.PC = 0x399
+		return
+	}
+	*c.PC = 0x397
+}
+
+func block397(c *chip8.Context) {
+	// 0x397: 0x135d JP 0x35d
+	*c.PC = 0x35d
+}
+
+func block399(c *chip8.Context) {
+	// 0x399: 0xa42f LD I, 0x42f
+	*c.I = 0x42f
+	// 0x39b: 0xfc1e ADD I, Vc
+	*c.I += uint16(c.V[0xc])
+	// 0x39d: 0xf065 LD V0, [I]
+	c.Exec(0x39d)
+	// 0x39f: 0x4000 SNE V0, 0x00
+	if c.V[0x0] != 0x00 {
+		*c.PC = 0x3a3
+		return
+	}
+	*c.PC = 0x3a1
+}
+
+func block3a1(c *chip8.Context) {
+	// 0x3a1: 0x135d JP 0x35d
+	*c.PC = 0x35d
+}
+
+func block3a3(c *chip8.Context) {
+	// 0x3a3: 0x89c0 LD V9, Vc
+	c.V[0x9] = c.V[0xc]
+	// 0x3a5: 0x99a0 SNE V9, Va
+	if c.V[0x9] != c.V[0xa] {
+		*c.PC = 0x3a9
+		return
+	}
+	*c.PC = 0x3a7
+}
+
+func block3a7(c *chip8.Context) {
+	// 0x3a7: 0x135d JP 0x35d
+	*c.PC = 0x35d
+}
+
+func block3a9(c *chip8.Context) {
+	// 0x3a9: 0x70ff ADD V0, 0xff
+	c.V[0x0] += 0xff
+	// 0x3ab: 0xa477 LD I, 0x477
+	*c.I = 0x477
+	// 0x3ad: 0xdde7 DRW Vd, Ve, 0x7
+	c.Exec(0x3ad)
+	// 0x3af: 0xa441 LD I, 0x441
+	*c.I = 0x441
+	*c.PC = 0x3b1
+}
+
+func block3b3(c *chip8.Context) {
+	// 0x3b3: 0xf01e ADD I, V0
+	*c.I += uint16(c.V[0x0])
+	// 0x3b5: 0xdde7 DRW Vd, Ve, 0x7
+	c.Exec(0x3b5)
+	*c.PC = 0x3b7
+}
+
+func block3b9(c *chip8.Context) {
+	// 0x3b9: 0xa4d5 LD I, 0x4d5
+	*c.I = 0x4d5
+	// 0x3bb: 0x6024 LD V0, 0x24
+	c.V[0x0] = 0x24
+	// 0x3bd: 0x610a LD V1, 0x0a
+	c.V[0x1] = 0x0a
+	// 0x3bf: 0x620b LD V2, 0x0b
+	c.V[0x2] = 0x0b
+	*c.PC = 0x3c1
+}
+
+func block3c1(c *chip8.Context) {
+	// 0x3c1: 0xd01b DRW V0, V1, 0xb
+	c.Exec(0x3c1)
+	// 0x3c3: 0x7008 ADD V0, 0x08
+	c.V[0x0] += 0x08
+	// 0x3c5: 0xf21e ADD I, V2
+	*c.I += uint16(c.V[0x2])
+	// 0x3c7: 0x303c SE V0, 0x3c
+	if c.V[0x0] == 0x3c {
+		*c.PC = 0x3cb
+		return
+	}
+	*c.PC = 0x3c9
+}
+
+func block3c9(c *chip8.Context) {
+	// 0x3c9: 0x13c1 JP 0x3c1
+	*c.PC = 0x3c1
+}
+
+func block3cd(c *chip8.Context) {
+	// 0x3cd: 0x6034 LD V0, 0x34
+	c.V[0x0] = 0x34
+	// 0x3cf: 0x6110 LD V1, 0x10
+	c.V[0x1] = 0x10
+	// 0x3d1: 0xa4f1 LD I, 0x4f1
+	*c.I = 0x4f1
+	// 0x3d3: 0xd015 DRW V0, V1, 0x5
+	c.Exec(0x3d3)
+	// 0x3d5: 0xa4f6 LD I, 0x4f6
+	*c.I = 0x4f6
+	// 0x3d7: 0xd015 DRW V0, V1, 0x5
+	c.Exec(0x3d7)
+	*c.PC = 0x3d9
+}
+
+func block3db(c *chip8.Context) {
+	// 0x3db: 0xa4fb LD I, 0x4fb
+	*c.I = 0x4fb
+	// 0x3dd: 0x13e1 JP 0x3e1
+	*c.PC = 0x3e1
+}
+
+func block3df(c *chip8.Context) {
+	// 0x3df: 0xa50a LD I, 0x50a
+	*c.I = 0x50a
+	*c.PC = 0x3e1
+}
+
+func block3e1(c *chip8.Context) {
+	// 0x3e1: 0x6024 LD V0, 0x24
+	c.V[0x0] = 0x24
+	// 0x3e3: 0x610d LD V1, 0x0d
+	c.V[0x1] = 0x0d
+	// 0x3e5: 0x6205 LD V2, 0x05
+	c.V[0x2] = 0x05
+	*c.PC = 0x3e7
+}
+
+func block3e7(c *chip8.Context) {
+	// 0x3e7: 0xd015 DRW V0, V1, 0x5
+	c.Exec(0x3e7)
+	// 0x3e9: 0x7008 ADD V0, 0x08
+	c.V[0x0] += 0x08
+	// 0x3eb: 0xf21e ADD I, V2
+	*c.I += uint16(c.V[0x2])
+	// 0x3ed: 0x303c SE V0, 0x3c
+	if c.V[0x0] == 0x3c {
+		*c.PC = 0x3f1
+		return
+	}
+	*c.PC = 0x3ef
+}
+
+func block3ef(c *chip8.Context) {
+	// 0x3ef: 0x13e7 JP 0x3e7
+	*c.PC = 0x3e7
+}
+
+func block3f3(c *chip8.Context) {
+	// 0x3f3: 0x8100 LD V1, V0
+	c.V[0x1] = c.V[0x0]
+	// 0x3f5: 0x8114 ADD V1, V1
+	c.Exec(0x3f5)
+	// 0x3f7: 0x8004 ADD V0, V0
+	c.Exec(0x3f7)
+	// 0x3f9: 0x8004 ADD V0, V0
+	c.Exec(0x3f9)
+	// 0x3fb: 0x8004 ADD V0, V0
+	c.Exec(0x3fb)
+	// 0x3fd: 0x8015 SUB V0, V1
+	c.Exec(0x3fd)
+	*c.PC = 0x3ff
+}
+
+func block401(c *chip8.Context) {
+	// 0x401: 0xf015 LD DT, V0
+	*c.DT = c.V[0x0]
+	*c.PC = 0x403
+}
+
+func block403(c *chip8.Context) {
+	// 0x403: 0xf007 LD V0, DT
+	c.V[0x0] = *c.DT
+	// 0x405: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x409
+		return
+	}
+	*c.PC = 0x407
+}
+
+func block407(c *chip8.Context) {
+	// 0x407: 0x1403 JP 0x403
+	*c.PC = 0x403
+}
+
+func block40b(c *chip8.Context) {
+	// 0x40b: 0xa42f LD I, 0x42f
+	*c.I = 0x42f
+	// 0x40d: 0xf333 LD B, V3
+	c.Exec(0x40d)
+	*c.PC = 0x40f
+}
+
+func block40f(c *chip8.Context) {
+	// 0x40f: 0xf265 LD V2, [I]
+	c.Exec(0x40f)
+	// 0x411: 0x6523 LD V5, 0x23
+	c.V[0x5] = 0x23
+	// 0x413: 0xf129 LD F, V1
+	*c.I = uint16(c.V[0x1] * 5)
+	// 0x415: 0xd565 DRW V5, V6, 0x5
+	c.Exec(0x415)
+	// 0x417: 0x6528 LD V5, 0x28
+	c.V[0x5] = 0x28
+	// 0x419: 0xf229 LD F, V2
+	*c.I = uint16(c.V[0x2] * 5)
+	// 0x41b: 0xd565 DRW V5, V6, 0x5
+	c.Exec(0x41b)
+	*c.PC = 0x41d
+}
@@ -0,0 +1,42 @@
+// Code generated by go-chip8 recompile from IBM; DO NOT EDIT.
+
+package ibm
+
+import "github.com/morinokami/go-chip8/chip8"
+
+var Program = &chip8.Program{
+	ROM: "8bf3b46d8a64c2074e7538200f684a2eaced258404d3c7d3bd7a917c3d0143e5",
+	Blocks: map[uint16]*chip8.Block{
+		0x200: {Insts: []chip8.Instruction{chip8.CLS, chip8.LDIAddr, chip8.LDVxByte, chip8.LDVxByte, chip8.DRW, chip8.ADDVxByte, chip8.LDIAddr, chip8.DRW, chip8.LDIAddr, chip8.ADDVxByte}, Run: block200},
+		0x228: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block228},
+	},
+}
+
+func block200(c *chip8.Context) {
+	// 0x200: 0x00e0 CLS
+	c.Exec(0x200)
+	// 0x202: 0xa22a LD I, 0x22a
+	*c.I = 0x22a
+	// 0x204: 0x600c LD V0, 0x0c
+	c.V[0x0] = 0x0c
+	// 0x206: 0x6108 LD V1, 0x08
+	c.V[0x1] = 0x08
+	// 0x208: 0xd01f DRW V0, V1, 0xf
+	c.Exec(0x208)
+	// 0x20a: 0x7009 ADD V0, 0x09
+	c.V[0x0] += 0x09
+	// 0x20c: 0xa239 LD I, 0x239
+	*c.I = 0x239
+	// 0x20e: 0xd01f DRW V0, V1, 0xf
+	c.Exec(0x20e)
+	// 0x210: 0xa248 LD I, 0x248
+	*c.I = 0x248
+	// 0x212: 0x7008 ADD V0, 0x08
+	c.V[0x0] += 0x08
+	*c.PC = 0x214
+}
+
+func block228(c *chip8.Context) {
+	// 0x228: 0x1228 JP 0x228
+	*c.PC = 0x228
+}
@@ -0,0 +1,840 @@
+// Code generated by go-chip8 recompile from INVADERS; DO NOT EDIT.
+
+package invaders
+
+import "github.com/morinokami/go-chip8/chip8"
+
+var Program = &chip8.Program{
+	ROM: "2d0e1fa53216b297e74041d4fb766f42327a42893e83bb4ec931a9dff5c2dd10",
+	Blocks: map[uint16]*chip8.Block{
+		0x200: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block200},
+		0x225: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr}, Run: block225},
+		0x22d: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.ADDIVx, chip8.SEVxByte}, Run: block22d},
+		0x235: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block235},
+		0x237: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.LDVxByte, chip8.SEVxByte}, Run: block237},
+		0x23d: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block23d},
+		0x23f: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block23f},
+		0x247: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDDTVx}, Run: block247},
+		0x24b: {Insts: []chip8.Instruction{chip8.LDVxDT, chip8.SEVxByte}, Run: block24b},
+		0x24f: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block24f},
+		0x253: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.JPAddr}, Run: block253},
+		0x257: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.CLS}, Run: block257},
+		0x26d: {Insts: []chip8.Instruction{chip8.LDDTVx}, Run: block26d},
+		0x26f: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKP}, Run: block26f},
+		0x273: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block273},
+		0x277: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block277},
+		0x279: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block279},
+		0x27d: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKP}, Run: block27d},
+		0x281: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block281},
+		0x285: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block285},
+		0x287: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block287},
+		0x28b: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block28b},
+		0x28d: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block28d},
+		0x28f: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKP}, Run: block28f},
+		0x293: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block293},
+		0x295: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxVy, chip8.LDIAddr, chip8.DRW}, Run: block295},
+		0x29f: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.ADDVxByte, chip8.SEVxByte}, Run: block29f},
+		0x2a7: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2a7},
+		0x2a9: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.JPAddr}, Run: block2a9},
+		0x2ad: {Insts: []chip8.Instruction{chip8.DRW, chip8.SEVxByte}, Run: block2ad},
+		0x2b1: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2b1},
+		0x2b3: {Insts: []chip8.Instruction{chip8.DRW, chip8.LDVxByte, chip8.LDVxVy, chip8.ADDVxByte, chip8.SUB, chip8.LDVxByte, chip8.AND, chip8.LDVxByte, chip8.SEVxByte}, Run: block2b3},
+		0x2c5: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2c5},
+		0x2c9: {Insts: []chip8.Instruction{chip8.SHR, chip8.SNEVxByte}, Run: block2c9},
+		0x2cd: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2cd},
+		0x2cf: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block2cf},
+		0x2d1: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2d1},
+		0x2d5: {Insts: []chip8.Instruction{chip8.SHR, chip8.SEVxByte}, Run: block2d5},
+		0x2d9: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2d9},
+		0x2dd: {Insts: []chip8.Instruction{chip8.SHR, chip8.SNEVxByte}, Run: block2dd},
+		0x2e1: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2e1},
+		0x2e3: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block2e3},
+		0x2e5: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2e5},
+		0x2e9: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block2e9},
+		0x2eb: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2eb},
+		0x2ed: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SNEVxByte}, Run: block2ed},
+		0x2f1: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2f1},
+		0x2f3: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.ADDVxByte, chip8.LDVxByte, chip8.CLS}, Run: block2f3},
+		0x303: {Insts: []chip8.Instruction{chip8.LDDTVx, chip8.JPAddr}, Run: block303},
+		0x307: {Insts: []chip8.Instruction{chip8.LDVxDT, chip8.SEVxByte}, Run: block307},
+		0x30b: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block30b},
+		0x30d: {Insts: []chip8.Instruction{chip8.LDDTVx}, Run: block30d},
+		0x311: {Insts: []chip8.Instruction{chip8.ADDVxVy, chip8.SEVxByte}, Run: block311},
+		0x315: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block315},
+		0x317: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.LDVxByte}, Run: block317},
+		0x31b: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block31b},
+		0x31d: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block31d},
+		0x31f: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.LDVxByte}, Run: block31f},
+		0x325: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block325},
+		0x327: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block327},
+		0x329: {Insts: []chip8.Instruction{chip8.CLS, chip8.LDIAddr, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block329},
+		0x333: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.ADDIVx, chip8.SEVxByte}, Run: block333},
+		0x33b: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block33b},
+		0x33f: {Insts: []chip8.Instruction{chip8.CLS, chip8.LDIAddr, chip8.LDVxI, chip8.JPAddr}, Run: block33f},
+		0x347: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxByte}, Run: block347},
+		0x34f: {Insts: []chip8.Instruction{chip8.SHR}, Run: block34f},
+		0x353: {Insts: []chip8.Instruction{chip8.SHR}, Run: block353},
+		0x357: {Insts: []chip8.Instruction{chip8.SHR}, Run: block357},
+		0x35b: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block35b},
+		0x35f: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.AND, chip8.SEVxByte}, Run: block35f},
+		0x365: {Insts: []chip8.Instruction{chip8.DRW}, Run: block365},
+		0x367: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block367},
+		0x36b: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxByte, chip8.DRW}, Run: block36b},
+		0x375: {Insts: []chip8.Instruction{chip8.XOR}, Run: block375},
+		0x379: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDSTVx, chip8.LDDTVx}, Run: block379},
+		0x37f: {Insts: []chip8.Instruction{chip8.LDVxDT, chip8.SEVxByte}, Run: block37f},
+		0x383: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block383},
+		0x387: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxVy, chip8.LDVxByte}, Run: block387},
+		0x38d: {Insts: []chip8.Instruction{chip8.SKNP}, Run: block38d},
+		0x38f: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block38f},
+		0x391: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.SEVxByte}, Run: block391},
+		0x399: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block399},
+		0x39b: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.JPAddr}, Run: block39b},
+		0x3a5: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.DRW, chip8.ADDVxByte, chip8.ADDVxByte, chip8.ADDVxByte, chip8.SEVxByte}, Run: block3a5},
+		0x3b3: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block3b3},
+	},
+}
+
+func block200(c *chip8.Context) {
+	// 0x200: 0x1225 JP 0x225
+	*c.PC = 0x225
+}
+
+func block225(c *chip8.Context) {
+	// 0x225: 0x6000 LD V0, 0x00
+	c.V[0x0] = 0x00
+	// 0x227: 0x6100 LD V1, 0x00
+	c.V[0x1] = 0x00
+	// 0x229: 0x6208 LD V2, 0x08
+	c.V[0x2] = 0x08
+	// 0x22b: 0xa3d3 LD I, 0x3d3
+	*c.I = 0x3d3
+	*c.PC = 0x22d
+}
+
+func block22d(c *chip8.Context) {
+	// 0x22d: 0xd018 DRW V0, V1, 0x8
+	c.Exec(0x22d)
+	// 0x22f: 0x7108 ADD V1, 0x08
+	c.V[0x1] += 0x08
+	// 0x231: 0xf21e ADD I, V2
+	*c.I += uint16(c.V[0x2])
+	// 0x233: 0x3120 SE V1, 0x20
+	if c.V[0x1] == 0x20 {
+		*c.PC = 0x237
+		return
+	}
+	*c.PC = 0x235
+}
+
+func block235(c *chip8.Context) {
+	// 0x235: 0x122d JP 0x22d
+	*c.PC = 0x22d
+}
+
+func block237(c *chip8.Context) {
+	// 0x237: 0x7008 ADD V0, 0x08
+	c.V[0x0] += 0x08
+	// 0x239: 0x6100 LD V1, 0x00
+	c.V[0x1] = 0x00
+	// 0x23b: 0x3040 SE V0, 0x40
+	if c.V[0x0] == 0x40 {
+		*c.PC = 0x23f
+		return
+	}
+	*c.PC = 0x23d
+}
+
+func block23d(c *chip8.Context) {
+	// 0x23d: 0x122d JP 0x22d
+	*c.PC = 0x22d
+}
+
+func block23f(c *chip8.Context) {
+	// 0x23f: 0x6905 LD V9, 0x05
+	c.V[0x9] = 0x05
+	// 0x241: 0x6c15 LD Vc, 0x15
+	c.V[0xc] = 0x15
+	// 0x243: 0x6e00 LD Ve, 0x00
+	c.V[0xe] = 0x00
+	*c.PC = 0x245
+}
+
+func block247(c *chip8.Context) {
+	// 0x247: 0x600a LD V0, 0x0a
+	c.V[0x0] = 0x0a
+	// 0x249: 0xf015 LD DT, V0
+	*c.DT = c.V[0x0]
+	*c.PC = 0x24b
+}
+
+func block24b(c *chip8.Context) {
+	// 0x24b: 0xf007 LD V0, DT
+	c.V[0x0] = *c.DT
+	// 0x24d: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x251
+		return
+	}
+	*c.PC = 0x24f
+}
+
+func block24f(c *chip8.Context) {
+	// 0x24f: 0x124b JP 0x24b
+	*c.PC = 0x24b
+}
+
+func block253(c *chip8.Context) {
+	// 0x253: 0x7e01 ADD Ve, 0x01
+	c.V[0xe] += 0x01
+	// 0x255: 0x1245 JP 0x245
+	*c.PC = 0x245
+}
+
+func block257(c *chip8.Context) {
+	// 0x257: 0x6600 LD V6, 0x00
+	c.V[0x6] = 0x00
+	// 0x259: 0x681c LD V8, 0x1c
+	c.V[0x8] = 0x1c
+	// 0x25b: 0x6900 LD V9, 0x00
+	c.V[0x9] = 0x00
+	// 0x25d: 0x6a04 LD Va, 0x04
+	c.V[0xa] = 0x04
+	// 0x25f: 0x6b0a LD Vb, 0x0a
+	c.V[0xb] = 0x0a
+	// 0x261: 0x6c04 LD Vc, 0x04
+	c.V[0xc] = 0x04
+	// 0x263: 0x6d3c LD Vd, 0x3c
+	c.V[0xd] = 0x3c
+	// 0x265: 0x6e0f LD Ve, 0x0f
+	c.V[0xe] = 0x0f
+	// 0x267: 0x00e0 CLS
+	c.Exec(0x267)
+	*c.PC = 0x269
+}
+
+func block26d(c *chip8.Context) {
+	// 0x26d: 0xfd15 LD DT, Vd
+	*c.DT = c.V[0xd]
+	*c.PC = 0x26f
+}
+
+func block26f(c *chip8.Context) {
+	// 0x26f: 0x6004 LD V0, 0x04
+	c.V[0x0] = 0x04
+	// 0x271: 0xe09e SKP V0
+	if c.Pressed(c.V[0x0]) {
+		*c.PC = 0x275
+		return
+	}
+	*c.PC = 0x273
+}
+
+func block273(c *chip8.Context) {
+	// 0x273: 0x127d JP 0x27d
+	*c.PC = 0x27d
+}
+
+func block277(c *chip8.Context) {
+	// 0x277: 0x3800 SE V8, 0x00
+	if c.V[0x8] == 0x00 {
+		*c.PC = 0x27b
+		return
+	}
+	*c.PC = 0x279
+}
+
+func block279(c *chip8.Context) {
+	// 0x279: 0x78ff ADD V8, 0xff
+	c.V[0x8] += 0xff
+	*c.PC = 0x27b
+}
+
+func block27d(c *chip8.Context) {
+	// 0x27d: 0x6006 LD V0, 0x06
+	c.V[0x0] = 0x06
+	// 0x27f: 0xe09e SKP V0
+	if c.Pressed(c.V[0x0]) {
+		*c.PC = 0x283
+		return
+	}
+	*c.PC = 0x281
+}
+
+func block281(c *chip8.Context) {
+	// 0x281: 0x128b JP 0x28b
+	*c.PC = 0x28b
+}
+
+func block285(c *chip8.Context) {
+	// 0x285: 0x3839 SE V8, 0x39
+	if c.V[0x8] == 0x39 {
+		*c.PC = 0x289
+		return
+	}
+	*c.PC = 0x287
+}
+
+func block287(c *chip8.Context) {
+	// 0x287: 0x7801 ADD V8, 0x01
+	c.V[0x8] += 0x01
+	*c.PC = 0x289
+}
+
+func block28b(c *chip8.Context) {
+	// 0x28b: 0x3600 SE V6, 0x00
+	if c.V[0x6] == 0x00 {
+		*c.PC = 0x28f
+		return
+	}
+	*c.PC = 0x28d
+}
+
+func block28d(c *chip8.Context) {
+	// 0x28d: 0x129f JP 0x29f
+	*c.PC = 0x29f
+}
+
+func block28f(c *chip8.Context) {
+	// 0x28f: 0x6005 LD V0, 0x05
+	c.V[0x0] = 0x05
+	// 0x291: 0xe09e SKP V0
+	if c.Pressed(c.V[0x0]) {
+		*c.PC = 0x295
+		return
+	}
+	*c.PC = 0x293
+}
+
+func block293(c *chip8.Context) {
+	// 0x293: 0x12e9 JP 0x2e9
+	*c.PC = 0x2e9
+}
+
+func block295(c *chip8.Context) {
+	// 0x295: 0x6601 LD V6, 0x01
+	c.V[0x6] = 0x01
+	// 0x297: 0x651b LD V5, 0x1b
+	c.V[0x5] = 0x1b
+	// 0x299: 0x8480 LD V4, V8
+	c.V[0x4] = c.V[0x8]
+	// 0x29b: 0xa3cf LD I, 0x3cf
+	*c.I = 0x3cf
+	// 0x29d: 0xd451 DRW V4, V5, 0x1
+	c.Exec(0x29d)
+	*c.PC = 0x29f
+}
+
+func block29f(c *chip8.Context) {
+	// 0x29f: 0xa3cf LD I, 0x3cf
+	*c.I = 0x3cf
+	// 0x2a1: 0xd451 DRW V4, V5, 0x1
+	c.Exec(0x2a1)
+	// 0x2a3: 0x75ff ADD V5, 0xff
+	c.V[0x5] += 0xff
+	// 0x2a5: 0x35ff SE V5, 0xff
+	if c.V[0x5] == 0xff {
+		*c.PC = 0x2a9
+		return
+	}
+	*c.PC = 0x2a7
+}
+
+func block2a7(c *chip8.Context) {
+	// 0x2a7: 0x12ad JP 0x2ad
+	*c.PC = 0x2ad
+}
+
+func block2a9(c *chip8.Context) {
+	// 0x2a9: 0x6600 LD V6, 0x00
+	c.V[0x6] = 0x00
+	// 0x2ab: 0x12e9 JP 0x2e9
+	*c.PC = 0x2e9
+}
+
+func block2ad(c *chip8.Context) {
+	// 0x2ad: 0xd451 DRW V4, V5, 0x1
+	c.Exec(0x2ad)
+	// 0x2af: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x2b3
+		return
+	}
+	*c.PC = 0x2b1
+}
+
+func block2b1(c *chip8.Context) {
+	// 0x2b1: 0x12e9 JP 0x2e9
+	*c.PC = 0x2e9
+}
+
+func block2b3(c *chip8.Context) {
+	// 0x2b3: 0xd451 DRW V4, V5, 0x1
+	c.Exec(0x2b3)
+	// 0x2b5: 0x6600 LD V6, 0x00
+	c.V[0x6] = 0x00
+	// 0x2b7: 0x8340 LD V3, V4
+	c.V[0x3] = c.V[0x4]
+	// 0x2b9: 0x7303 ADD V3, 0x03
+	c.V[0x3] += 0x03
+	// 0x2bb: 0x83b5 SUB V3, Vb
+	c.Exec(0x2bb)
+	// 0x2bd: 0x62f8 LD V2, 0xf8
+	c.V[0x2] = 0xf8
+	// 0x2bf: 0x8322 AND V3, V2
+	c.V[0x3] &= c.V[0x2]
+	// 0x2c1: 0x6208 LD V2, 0x08
+	c.V[0x2] = 0x08
+	// 0x2c3: 0x3300 SE V3, 0x00
+	if c.V[0x3] == 0x00 {
+		*c.PC = 0x2c7
+		return
+	}
+	*c.PC = 0x2c5
+}
+
+func block2c5(c *chip8.Context) {
+	// 0x2c5: 0x12c9 JP 0x2c9
+	*c.PC = 0x2c9
+}
+
+func block2c9(c *chip8.Context) {
+	// 0x2c9: 0x8206 SHR V2, V0
+	c.Exec(0x2c9)
+	// 0x2cb: 0x4308 SNE V3, 0x08
+	if c.V[0x3] != 0x08 {
+		*c.PC = 0x2cf
+		return
+	}
+	*c.PC = 0x2cd
+}
+
+func block2cd(c *chip8.Context) {
+	// 0x2cd: 0x12d3 JP 0x2d3
+	*c.PC = 0x2d3
+}
+
+func block2cf(c *chip8.Context) {
+	// 0x2cf: 0x3310 SE V3, 0x10
+	if c.V[0x3] == 0x10 {
+		*c.PC = 0x2d3
+		return
+	}
+	*c.PC = 0x2d1
+}
+
+func block2d1(c *chip8.Context) {
+	// 0x2d1: 0x12d5 JP 0x2d5
+	*c.PC = 0x2d5
+}
+
+func block2d5(c *chip8.Context) {
+	// 0x2d5: 0x8206 SHR V2, V0
+	c.Exec(0x2d5)
+	// 0x2d7: 0x3318 SE V3, 0x18
+	if c.V[0x3] == 0x18 {
+		*c.PC = 0x2db
+		return
+	}
+	*c.PC = 0x2d9
+}
+
+func block2d9(c *chip8.Context) {
+	// 0x2d9: 0x12dd JP 0x2dd
+	*c.PC = 0x2dd
+}
+
+func block2dd(c *chip8.Context) {
+	// 0x2dd: 0x8206 SHR V2, V0
+	c.Exec(0x2dd)
+	// 0x2df: 0x4320 SNE V3, 0x20
+	if c.V[0x3] != 0x20 {
+		*c.PC = 0x2e3
+		return
+	}
+	*c.PC = 0x2e1
+}
+
+func block2e1(c *chip8.Context) {
+	// 0x2e1: 0x12e7 JP 0x2e7
+	*c.PC = 0x2e7
+}
+
+func block2e3(c *chip8.Context) {
+	// 0x2e3: 0x3328 SE V3, 0x28
+	if c.V[0x3] == 0x28 {
+		*c.PC = 0x2e7
+		return
+	}
+	*c.PC = 0x2e5
+}
+
+func block2e5(c *chip8.Context) {
+	// 0x2e5: 0x12e9 JP 0x2e9
+	*c.PC = 0x2e9
+}
+
+func block2e9(c *chip8.Context) {
+	// 0x2e9: 0x3e00 SE Ve, 0x00
+	if c.V[0xe] == 0x00 {
+		*c.PC = 0x2ed
+		return
+	}
+	*c.PC = 0x2eb
+}
+
+func block2eb(c *chip8.Context) {
+	// 0x2eb: 0x1307 JP 0x307
+	*c.PC = 0x307
+}
+
+func block2ed(c *chip8.Context) {
+	// 0x2ed: 0x7906 ADD V9, 0x06
+	c.V[0x9] += 0x06
+	// 0x2ef: 0x4918 SNE V9, 0x18
+	if c.V[0x9] != 0x18 {
+		*c.PC = 0x2f3
+		return
+	}
+	*c.PC = 0x2f1
+}
+
+func block2f1(c *chip8.Context) {
+	// 0x2f1: 0x6900 LD V9, 0x00
+	c.V[0x9] = 0x00
+	*c.PC = 0x2f3
+}
+
+func block2f3(c *chip8.Context) {
+	// 0x2f3: 0x6a04 LD Va, 0x04
+	c.V[0xa] = 0x04
+	// 0x2f5: 0x6b0a LD Vb, 0x0a
+	c.V[0xb] = 0x0a
+	// 0x2f7: 0x6c04 LD Vc, 0x04
+	c.V[0xc] = 0x04
+	// 0x2f9: 0x7df4 ADD Vd, 0xf4
+	c.V[0xd] += 0xf4
+	// 0x2fb: 0x6e0f LD Ve, 0x0f
+	c.V[0xe] = 0x0f
+	// 0x2fd: 0x00e0 CLS
+	c.Exec(0x2fd)
+	*c.PC = 0x2ff
+}
+
+func block303(c *chip8.Context) {
+	// 0x303: 0xfd15 LD DT, Vd
+	*c.DT = c.V[0xd]
+	// 0x305: 0x126f JP 0x26f
+	*c.PC = 0x26f
+}
+
+func block307(c *chip8.Context) {
+	// 0x307: 0xf707 LD V7, DT
+	c.V[0x7] = *c.DT
+	// 0x309: 0x3700 SE V7, 0x00
+	if c.V[0x7] == 0x00 {
+		*c.PC = 0x30d
+		return
+	}
+	*c.PC = 0x30b
+}
+
+func block30b(c *chip8.Context) {
+	// 0x30b: 0x126f JP 0x26f
+	*c.PC = 0x26f
+}
+
+func block30d(c *chip8.Context) {
+	// 0x30d: 0xfd15 LD DT, Vd
+	*c.DT = c.V[0xd]
+	*c.PC = 0x30f
+}
+
+func block311(c *chip8.Context) {
+	// 0x311: 0x8ba4 ADD Vb, Va
+	c.Exec(0x311)
+	// 0x313: 0x3b12 SE Vb, 0x12
+	if c.V[0xb] == 0x12 {
+		*c.PC = 0x317
+		return
+	}
+	*c.PC = 0x315
+}
+
+func block315(c *chip8.Context) {
+	// 0x315: 0x131b JP 0x31b
+	*c.PC = 0x31b
+}
+
+func block317(c *chip8.Context) {
+	// 0x317: 0x7c02 ADD Vc, 0x02
+	c.V[0xc] += 0x02
+	// 0x319: 0x6afc LD Va, 0xfc
+	c.V[0xa] = 0xfc
+	*c.PC = 0x31b
+}
+
+func block31b(c *chip8.Context) {
+	// 0x31b: 0x3b02 SE Vb, 0x02
+	if c.V[0xb] == 0x02 {
+		*c.PC = 0x31f
+		return
+	}
+	*c.PC = 0x31d
+}
+
+func block31d(c *chip8.Context) {
+	// 0x31d: 0x1323 JP 0x323
+	*c.PC = 0x323
+}
+
+func block31f(c *chip8.Context) {
+	// 0x31f: 0x7c02 ADD Vc, 0x02
+	c.V[0xc] += 0x02
+	// 0x321: 0x6a04 LD Va, 0x04
+	c.V[0xa] = 0x04
+	*c.PC = 0x323
+}
+
+func block325(c *chip8.Context) {
+	// 0x325: 0x3c18 SE Vc, 0x18
+	if c.V[0xc] == 0x18 {
+		*c.PC = 0x329
+		return
+	}
+	*c.PC = 0x327
+}
+
+func block327(c *chip8.Context) {
+	// 0x327: 0x126f JP 0x26f
+	*c.PC = 0x26f
+}
+
+func block329(c *chip8.Context) {
+	// 0x329: 0x00e0 CLS
+	c.Exec(0x329)
+	// 0x32b: 0xa4d3 LD I, 0x4d3
+	*c.I = 0x4d3
+	// 0x32d: 0x6014 LD V0, 0x14
+	c.V[0x0] = 0x14
+	// 0x32f: 0x6108 LD V1, 0x08
+	c.V[0x1] = 0x08
+	// 0x331: 0x620f LD V2, 0x0f
+	c.V[0x2] = 0x0f
+	*c.PC = 0x333
+}
+
+func block333(c *chip8.Context) {
+	// 0x333: 0xd01f DRW V0, V1, 0xf
+	c.Exec(0x333)
+	// 0x335: 0x7008 ADD V0, 0x08
+	c.V[0x0] += 0x08
+	// 0x337: 0xf21e ADD I, V2
+	*c.I += uint16(c.V[0x2])
+	// 0x339: 0x302c SE V0, 0x2c
+	if c.V[0x0] == 0x2c {
+		*c.PC = 0x33d
+		return
+	}
+	*c.PC = 0x33b
+}
+
+func block33b(c *chip8.Context) {
+	// 0x33b: 0x1333 JP 0x333
+	*c.PC = 0x333
+}
+
+func block33f(c *chip8.Context) {
+	// 0x33f: 0x00e0 CLS
+	c.Exec(0x33f)
+	// 0x341: 0xa6f4 LD I, 0x6f4
+	*c.I = 0x6f4
+	// 0x343: 0xfe65 LD Ve, [I]
+	c.Exec(0x343)
+	// 0x345: 0x1225 JP 0x225
+	*c.PC = 0x225
+}
+
+func block347(c *chip8.Context) {
+	// 0x347: 0xa3b7 LD I, 0x3b7
+	*c.I = 0x3b7
+	// 0x349: 0xf91e ADD I, V9
+	*c.I += uint16(c.V[0x9])
+	// 0x34b: 0x6108 LD V1, 0x08
+	c.V[0x1] = 0x08
+	*c.PC = 0x34d
+}
+
+func block34f(c *chip8.Context) {
+	// 0x34f: 0x8106 SHR V1, V0
+	c.Exec(0x34f)
+	*c.PC = 0x351
+}
+
+func block353(c *chip8.Context) {
+	// 0x353: 0x8106 SHR V1, V0
+	c.Exec(0x353)
+	*c.PC = 0x355
+}
+
+func block357(c *chip8.Context) {
+	// 0x357: 0x8106 SHR V1, V0
+	c.Exec(0x357)
+	*c.PC = 0x359
+}
+
+func block35b(c *chip8.Context) {
+	// 0x35b: 0x7bd0 ADD Vb, 0xd0
+	c.V[0xb] += 0xd0
+	*c.PC = 0x35d
+}
+
+func block35f(c *chip8.Context) {
+	// 0x35f: 0x80e0 LD V0, Ve
+	c.V[0x0] = c.V[0xe]
+	// 0x361: 0x8012 AND V0, V1
+	c.V[0x0] &= c.V[0x1]
+	// 0x363: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x367
+		return
+	}
+	*c.PC = 0x365
+}
+
+func block365(c *chip8.Context) {
+	// 0x365: 0xdbc6 DRW Vb, Vc, 0x6
+	c.Exec(0x365)
+	*c.PC = 0x367
+}
+
+func block367(c *chip8.Context) {
+	// 0x367: 0x7b0c ADD Vb, 0x0c
+	c.V[0xb] += 0x0c
+	*c.PC = 0x369
+}
+
+func block36b(c *chip8.Context) {
+	// 0x36b: 0xa3cf LD I, 0x3cf
+	*c.I = 0x3cf
+	// 0x36d: 0x601c LD V0, 0x1c
+	c.V[0x0] = 0x1c
+	// 0x36f: 0xd804 DRW V8, V0, 0x4
+	c.Exec(0x36f)
+	*c.PC = 0x371
+}
+
+func block375(c *chip8.Context) {
+	// 0x375: 0x8e23 XOR Ve, V2
+	c.V[0xe] ^= c.V[0x2]
+	*c.PC = 0x377
+}
+
+func block379(c *chip8.Context) {
+	// 0x379: 0x6005 LD V0, 0x05
+	c.V[0x0] = 0x05
+	// 0x37b: 0xf018 LD ST, V0
+	*c.ST = c.V[0x0]
+	// 0x37d: 0xf015 LD DT, V0
+	*c.DT = c.V[0x0]
+	*c.PC = 0x37f
+}
+
+func block37f(c *chip8.Context) {
+	// 0x37f: 0xf007 LD V0, DT
+	c.V[0x0] = *c.DT
+	// 0x381: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x385
+		return
+	}
+	*c.PC = 0x383
+}
+
+func block383(c *chip8.Context) {
+	// 0x383: 0x137f JP 0x37f
+	*c.PC = 0x37f
+}
+
+func block387(c *chip8.Context) {
+	// 0x387: 0x6a00 LD Va, 0x00
+	c.V[0xa] = 0x00
+	// 0x389: 0x8de0 LD Vd, Ve
+	c.V[0xd] = c.V[0xe]
+	// 0x38b: 0x6b04 LD Vb, 0x04
+	c.V[0xb] = 0x04
+	*c.PC = 0x38d
+}
+
+func block38d(c *chip8.Context) {
+	// 0x38d: 0xe9a1 SKNP V9
+	if !c.Pressed(c.V[0x9]) {
+		*c.PC = 0x391
+		return
+	}
+	*c.PC = 0x38f
+}
+
+func block38f(c *chip8.Context) {
+	// 0x38f: 0x1257 JP 0x257
+	*c.PC = 0x257
+}
+
+func block391(c *chip8.Context) {
+	// 0x391: 0xa602 LD I, 0x602
+	*c.I = 0x602
+	// 0x393: 0xfd1e ADD I, Vd
+	*c.I += uint16(c.V[0xd])
+	// 0x395: 0xf065 LD V0, [I]
+	c.Exec(0x395)
+	// 0x397: 0x30ff SE V0, 0xff
+	if c.V[0x0] == 0xff {
+		*c.PC = 0x39b
+		return
+	}
+	*c.PC = 0x399
+}
+
+func block399(c *chip8.Context) {
+	// 0x399: 0x13a5 JP 0x3a5
+	*c.PC = 0x3a5
+}
+
+func block39b(c *chip8.Context) {
+	// 0x39b: 0x6a00 LD Va, 0x00
+	c.V[0xa] = 0x00
+	// 0x39d: 0x6b04 LD Vb, 0x04
+	c.V[0xb] = 0x04
+	// 0x39f: 0x6d01 LD Vd, 0x01
+	c.V[0xd] = 0x01
+	// 0x3a1: 0x6e01 LD Ve, 0x01
+	c.V[0xe] = 0x01
+	// 0x3a3: 0x138d JP 0x38d
+	*c.PC = 0x38d
+}
+
+func block3a5(c *chip8.Context) {
+	// 0x3a5: 0xa500 LD I, 0x500
+	*c.I = 0x500
+	// 0x3a7: 0xf01e ADD I, V0
+	*c.I += uint16(c.V[0x0])
+	// 0x3a9: 0xdbc6 DRW Vb, Vc, 0x6
+	c.Exec(0x3a9)
+	// 0x3ab: 0x7b08 ADD Vb, 0x08
+	c.V[0xb] += 0x08
+	// 0x3ad: 0x7d01 ADD Vd, 0x01
+	c.V[0xd] += 0x01
+	// 0x3af: 0x7a01 ADD Va, 0x01
+	c.V[0xa] += 0x01
+	// 0x3b1: 0x3a07 SE Va, 0x07
+	if c.V[0xa] == 0x07 {
+		*c.PC = 0x3b5
+		return
+	}
+	*c.PC = 0x3b3
+}
+
+func block3b3(c *chip8.Context) {
+	// 0x3b3: 0x138d JP 0x38d
+	*c.PC = 0x38d
+}
@@ -0,0 +1,272 @@
+// Code generated by go-chip8 recompile from KALEID; DO NOT EDIT.
+
+package kaleid
+
+import "github.com/morinokami/go-chip8/chip8"
+
+var Program = &chip8.Program{
+	ROM: "ff3139e8ce77c2bdad54d386fa17825466778885abd1fb2fd5f9af4c6aa639f5",
+	Blocks: map[uint16]*chip8.Block{
+		0x200: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block200},
+		0x20a: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx}, Run: block20a},
+		0x210: {Insts: []chip8.Instruction{chip8.LDIVx}, Run: block210},
+		0x212: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block212},
+		0x214: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block214},
+		0x216: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SEVxByte}, Run: block216},
+		0x21a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block21a},
+		0x21c: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block21c},
+		0x21e: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.SNEVxByte}, Run: block21e},
+		0x226: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block226},
+		0x228: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SNEVxByte}, Run: block228},
+		0x22c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block22c},
+		0x230: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block230},
+		0x232: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block232},
+		0x234: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block234},
+		0x236: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block236},
+		0x238: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block238},
+		0x23a: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block23a},
+		0x23c: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block23c},
+		0x23e: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block23e},
+		0x240: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block240},
+		0x242: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxByte, chip8.AND, chip8.LDVxByte, chip8.AND, chip8.SEVxByte}, Run: block242},
+		0x24e: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block24e},
+		0x250: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.LDVxByte, chip8.AND, chip8.SEVxByte}, Run: block250},
+		0x25a: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block25a},
+		0x25c: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.DRW, chip8.LDVxVy, chip8.LDVxByte, chip8.SUB, chip8.DRW, chip8.LDVxByte, chip8.SUB, chip8.DRW}, Run: block25c},
+	},
+}
+
+func block200(c *chip8.Context) {
+	// 0x200: 0x6000 LD V0, 0x00
+	c.V[0x0] = 0x00
+	// 0x202: 0x6380 LD V3, 0x80
+	c.V[0x3] = 0x80
+	// 0x204: 0x611f LD V1, 0x1f
+	c.V[0x1] = 0x1f
+	// 0x206: 0x620f LD V2, 0x0f
+	c.V[0x2] = 0x0f
+	*c.PC = 0x208
+}
+
+func block20a(c *chip8.Context) {
+	// 0x20a: 0xa200 LD I, 0x200
+	*c.I = 0x200
+	// 0x20c: 0xf31e ADD I, V3
+	*c.I += uint16(c.V[0x3])
+	*c.PC = 0x20e
+}
+
+func block210(c *chip8.Context) {
+	// 0x210: 0xf055 LD [I], V0
+	c.Exec(0x210)
+	*c.PC = 0x212
+}
+
+func block212(c *chip8.Context) {
+	// 0x212: 0x4000 SNE V0, 0x00
+	if c.V[0x0] != 0x00 {
+		*c.PC = 0x216
+		return
+	}
+	*c.PC = 0x214
+}
+
+func block214(c *chip8.Context) {
+	// 0x214: 0x121c JP 0x21c
+	*c.PC = 0x21c
+}
+
+func block216(c *chip8.Context) {
+	// 0x216: 0x7301 ADD V3, 0x01
+	c.V[0x3] += 0x01
+	// 0x218: 0x3300 SE V3, 0x00
+	if c.V[0x3] == 0x00 {
+		*c.PC = 0x21c
+		return
+	}
+	*c.PC = 0x21a
+}
+
+func block21a(c *chip8.Context) {
+	// 0x21a: 0x1208 JP 0x208
+	*c.PC = 0x208
+}
+
+func block21c(c *chip8.Context) {
+	// 0x21c: 0x6380 LD V3, 0x80
+	c.V[0x3] = 0x80
+	*c.PC = 0x21e
+}
+
+func block21e(c *chip8.Context) {
+	// 0x21e: 0xa200 LD I, 0x200
+	*c.I = 0x200
+	// 0x220: 0xf31e ADD I, V3
+	*c.I += uint16(c.V[0x3])
+	// 0x222: 0xf065 LD V0, [I]
+	c.Exec(0x222)
+	// 0x224: 0x4000 SNE V0, 0x00
+	if c.V[0x0] != 0x00 {
+		*c.PC = 0x228
+		return
+	}
+	*c.PC = 0x226
+}
+
+func block226(c *chip8.Context) {
+	// 0x226: 0x121c JP 0x21c
+	*c.PC = 0x21c
+}
+
+func block228(c *chip8.Context) {
+	// 0x228: 0x7301 ADD V3, 0x01
+	c.V[0x3] += 0x01
+	// 0x22a: 0x4300 SNE V3, 0x00
+	if c.V[0x3] != 0x00 {
+		*c.PC = 0x22e
+		return
+	}
+	*c.PC = 0x22c
+}
+
+func block22c(c *chip8.Context) {
+	// 0x22c: 0x121c JP 0x21c
+	*c.PC = 0x21c
+}
+
+func block230(c *chip8.Context) {
+	// 0x230: 0x121e JP 0x21e
+	*c.PC = 0x21e
+}
+
+func block232(c *chip8.Context) {
+	// 0x232: 0x4002 SNE V0, 0x02
+	if c.V[0x0] != 0x02 {
+		*c.PC = 0x236
+		return
+	}
+	*c.PC = 0x234
+}
+
+func block234(c *chip8.Context) {
+	// 0x234: 0x72ff ADD V2, 0xff
+	c.V[0x2] += 0xff
+	*c.PC = 0x236
+}
+
+func block236(c *chip8.Context) {
+	// 0x236: 0x4004 SNE V0, 0x04
+	if c.V[0x0] != 0x04 {
+		*c.PC = 0x23a
+		return
+	}
+	*c.PC = 0x238
+}
+
+func block238(c *chip8.Context) {
+	// 0x238: 0x71ff ADD V1, 0xff
+	c.V[0x1] += 0xff
+	*c.PC = 0x23a
+}
+
+func block23a(c *chip8.Context) {
+	// 0x23a: 0x4006 SNE V0, 0x06
+	if c.V[0x0] != 0x06 {
+		*c.PC = 0x23e
+		return
+	}
+	*c.PC = 0x23c
+}
+
+func block23c(c *chip8.Context) {
+	// 0x23c: 0x7101 ADD V1, 0x01
+	c.V[0x1] += 0x01
+	*c.PC = 0x23e
+}
+
+func block23e(c *chip8.Context) {
+	// 0x23e: 0x4008 SNE V0, 0x08
+	if c.V[0x0] != 0x08 {
+		*c.PC = 0x242
+		return
+	}
+	*c.PC = 0x240
+}
+
+func block240(c *chip8.Context) {
+	// 0x240: 0x7201 ADD V2, 0x01
+	c.V[0x2] += 0x01
+	*c.PC = 0x242
+}
+
+func block242(c *chip8.Context) {
+	// 0x242: 0xa277 LD I, 0x277
+	*c.I = 0x277
+	// 0x244: 0x6ae0 LD Va, 0xe0
+	c.V[0xa] = 0xe0
+	// 0x246: 0x8a12 AND Va, V1
+	c.V[0xa] &= c.V[0x1]
+	// 0x248: 0x6b1f LD Vb, 0x1f
+	c.V[0xb] = 0x1f
+	// 0x24a: 0x81b2 AND V1, Vb
+	c.V[0x1] &= c.V[0xb]
+	// 0x24c: 0x3a00 SE Va, 0x00
+	if c.V[0xa] == 0x00 {
+		*c.PC = 0x250
+		return
+	}
+	*c.PC = 0x24e
+}
+
+func block24e(c *chip8.Context) {
+	// 0x24e: 0x7201 ADD V2, 0x01
+	c.V[0x2] += 0x01
+	*c.PC = 0x250
+}
+
+func block250(c *chip8.Context) {
+	// 0x250: 0x6af0 LD Va, 0xf0
+	c.V[0xa] = 0xf0
+	// 0x252: 0x8a22 AND Va, V2
+	c.V[0xa] &= c.V[0x2]
+	// 0x254: 0x6b0f LD Vb, 0x0f
+	c.V[0xb] = 0x0f
+	// 0x256: 0x82b2 AND V2, Vb
+	c.V[0x2] &= c.V[0xb]
+	// 0x258: 0x3a00 SE Va, 0x00
+	if c.V[0xa] == 0x00 {
+		*c.PC = 0x25c
+		return
+	}
+	*c.PC = 0x25a
+}
+
+func block25a(c *chip8.Context) {
+	// 0x25a: 0x7101 ADD V1, 0x01
+	c.V[0x1] += 0x01
+	*c.PC = 0x25c
+}
+
+func block25c(c *chip8.Context) {
+	// 0x25c: 0x6b1f LD Vb, 0x1f
+	c.V[0xb] = 0x1f
+	// 0x25e: 0x81b2 AND V1, Vb
+	c.V[0x1] &= c.V[0xb]
+	// 0x260: 0xd121 DRW V1, V2, 0x1
+	c.Exec(0x260)
+	// 0x262: 0x8a10 LD Va, V1
+	c.V[0xa] = c.V[0x1]
+	// 0x264: 0x6b1f LD Vb, 0x1f
+	c.V[0xb] = 0x1f
+	// 0x266: 0x8b25 SUB Vb, V2
+	c.Exec(0x266)
+	// 0x268: 0xdab1 DRW Va, Vb, 0x1
+	c.Exec(0x268)
+	// 0x26a: 0x6a3f LD Va, 0x3f
+	c.V[0xa] = 0x3f
+	// 0x26c: 0x8a15 SUB Va, V1
+	c.Exec(0x26c)
+	// 0x26e: 0xdab1 DRW Va, Vb, 0x1
+	c.Exec(0x26e)
+	*c.PC = 0x270
+}
@@ -0,0 +1,78 @@
+// Code generated by go-chip8 recompile from MAZE; DO NOT EDIT.
+
+package maze
+
+import "github.com/morinokami/go-chip8/chip8"
+
+var Program = &chip8.Program{
+	ROM: "86437986e84b5c944f8883547b4380cbdaacb08503bf1cb65f7167782f786060",
+	Blocks: map[uint16]*chip8.Block{
+		0x200: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.RND, chip8.SEVxByte}, Run: block200},
+		0x206: {Insts: []chip8.Instruction{chip8.LDIAddr}, Run: block206},
+		0x208: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.SEVxByte}, Run: block208},
+		0x20e: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block20e},
+		0x210: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte, chip8.SEVxByte}, Run: block210},
+		0x216: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block216},
+		0x218: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block218},
+	},
+}
+
+func block200(c *chip8.Context) {
+	// 0x200: 0xa21e LD I, 0x21e
+	*c.I = 0x21e
+	// 0x202: 0xc201 RND V2, byte
+	c.Exec(0x202)
+	// 0x204: 0x3201 SE V2, 0x01
+	if c.V[0x2] == 0x01 {
+		*c.PC = 0x208
+		return
+	}
+	*c.PC = 0x206
+}
+
+func block206(c *chip8.Context) {
+	// 0x206: 0xa21a LD I, 0x21a
+	*c.I = 0x21a
+	*c.PC = 0x208
+}
+
+func block208(c *chip8.Context) {
+	// 0x208: 0xd014 DRW V0, V1, 0x4
+	c.Exec(0x208)
+	// 0x20a: 0x7004 ADD V0, 0x04
+	c.V[0x0] += 0x04
+	// 0x20c: 0x3040 SE V0, 0x40
+	if c.V[0x0] == 0x40 {
+		*c.PC = 0x210
+		return
+	}
+	*c.PC = 0x20e
+}
+
+func block20e(c *chip8.Context) {
+	// 0x20e: 0x1200 JP 0x200
+	*c.PC = 0x200
+}
+
+func block210(c *chip8.Context) {
+	// 0x210: 0x6000 LD V0, 0x00
+	c.V[0x0] = 0x00
+	// 0x212: 0x7104 ADD V1, 0x04
+	c.V[0x1] += 0x04
+	// 0x214: 0x3120 SE V1, 0x20
+	if c.V[0x1] == 0x20 {
+		*c.PC = 0x218
+		return
+	}
+	*c.PC = 0x216
+}
+
+func block216(c *chip8.Context) {
+	// 0x216: 0x1200 JP 0x200
+	*c.PC = 0x200
+}
+
+func block218(c *chip8.Context) {
+	// 0x218: 0x1218 JP 0x218
+	*c.PC = 0x218
+}
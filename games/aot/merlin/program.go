@@ -0,0 +1,470 @@
+// Code generated by go-chip8 recompile from MERLIN; DO NOT EDIT.
+
+package merlin
+
+import "github.com/morinokami/go-chip8/chip8"
+
+var Program = &chip8.Program{
+	ROM: "1a684bdb74e4c34cdc74aa92eb6bf61e719b2885e8e08e7bdd7644f9e4c07460",
+	Blocks: map[uint16]*chip8.Block{
+		0x200: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block200},
+		0x21b: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxByte, chip8.LDVxByte}, Run: block21b},
+		0x223: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxByte, chip8.LDVxByte}, Run: block223},
+		0x22b: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block22b},
+		0x22f: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte}, Run: block22f},
+		0x235: {Insts: []chip8.Instruction{chip8.RND, chip8.LDVxVy, chip8.LDIAddr, chip8.ADDIVx, chip8.LDIVx}, Run: block235},
+		0x23f: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.AND, chip8.SEVxByte}, Run: block23f},
+		0x249: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block249},
+		0x24b: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.SEVxByte}, Run: block24b},
+		0x251: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block251},
+		0x253: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.LDVxByte}, Run: block253},
+		0x25b: {Insts: []chip8.Instruction{chip8.DRW, chip8.LDVxByte}, Run: block25b},
+		0x261: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SEVxVy}, Run: block261},
+		0x265: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block265},
+		0x267: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block267},
+		0x269: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr}, Run: block269},
+		0x271: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block271},
+		0x273: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block273},
+		0x275: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.JPAddr}, Run: block275},
+		0x279: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block279},
+		0x27b: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block27b},
+		0x27d: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.LDVxByte, chip8.JPAddr}, Run: block27d},
+		0x283: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block283},
+		0x285: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block285},
+		0x287: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.LDVxByte, chip8.JPAddr}, Run: block287},
+		0x28d: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block28d},
+		0x28f: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block28f},
+		0x291: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.ADDVxByte, chip8.LDVxByte}, Run: block291},
+		0x297: {Insts: []chip8.Instruction{chip8.DRW, chip8.LDVxByte}, Run: block297},
+		0x29d: {Insts: []chip8.Instruction{chip8.DRW, chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.ADDVxByte, chip8.SEVxVy}, Run: block29d},
+		0x2a9: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2a9},
+		0x2ab: {Insts: []chip8.Instruction{chip8.SEVxVy}, Run: block2ab},
+		0x2ad: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2ad},
+		0x2b1: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.JPAddr}, Run: block2b1},
+		0x2b7: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxByte, chip8.LDVxByte}, Run: block2b7},
+		0x2bf: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2bf},
+		0x2c1: {Insts: []chip8.Instruction{chip8.LDDTVx}, Run: block2c1},
+		0x2c3: {Insts: []chip8.Instruction{chip8.LDVxDT, chip8.SEVxByte}, Run: block2c3},
+		0x2c7: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2c7},
+		0x2cb: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxByte}, Run: block2cb},
+		0x2cf: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDIVx, chip8.ADDVxByte, chip8.LDVxVy, chip8.ADDVxByte, chip8.SEVxVy}, Run: block2cf},
+		0x2db: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2db},
+		0x2df: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxVy, chip8.ADDVxByte, chip8.LDBVx}, Run: block2df},
+		0x2e7: {Insts: []chip8.Instruction{chip8.LDVxI, chip8.LDFVx, chip8.LDVxByte, chip8.LDVxByte, chip8.DRW, chip8.ADDVxByte, chip8.LDFVx, chip8.DRW}, Run: block2e7},
+		0x2f9: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxByte, chip8.LDVxByte, chip8.DRW, chip8.ADDVxByte, chip8.DRW, chip8.ADDVxByte, chip8.DRW, chip8.ADDVxByte, chip8.DRW}, Run: block2f9},
+	},
+}
+
+func block200(c *chip8.Context) {
+	// 0x200: 0x1219 JP 0x219
+	*c.PC = 0x219
+}
+
+func block21b(c *chip8.Context) {
+	// 0x21b: 0xa31d LD I, 0x31d
+	*c.I = 0x31d
+	// 0x21d: 0x6010 LD V0, 0x10
+	c.V[0x0] = 0x10
+	// 0x21f: 0x6100 LD V1, 0x00
+	c.V[0x1] = 0x00
+	*c.PC = 0x221
+}
+
+func block223(c *chip8.Context) {
+	// 0x223: 0xa331 LD I, 0x331
+	*c.I = 0x331
+	// 0x225: 0x600b LD V0, 0x0b
+	c.V[0x0] = 0x0b
+	// 0x227: 0x611b LD V1, 0x1b
+	c.V[0x1] = 0x1b
+	*c.PC = 0x229
+}
+
+func block22b(c *chip8.Context) {
+	// 0x22b: 0x6404 LD V4, 0x04
+	c.V[0x4] = 0x04
+	*c.PC = 0x22d
+}
+
+func block22f(c *chip8.Context) {
+	// 0x22f: 0x6500 LD V5, 0x00
+	c.V[0x5] = 0x00
+	// 0x231: 0x6228 LD V2, 0x28
+	c.V[0x2] = 0x28
+	*c.PC = 0x233
+}
+
+func block235(c *chip8.Context) {
+	// 0x235: 0xc203 RND V2, byte
+	c.Exec(0x235)
+	// 0x237: 0x8020 LD V0, V2
+	c.V[0x0] = c.V[0x2]
+	// 0x239: 0xa359 LD I, 0x359
+	*c.I = 0x359
+	// 0x23b: 0xf51e ADD I, V5
+	*c.I += uint16(c.V[0x5])
+	// 0x23d: 0xf055 LD [I], V0
+	c.Exec(0x23d)
+	*c.PC = 0x23f
+}
+
+func block23f(c *chip8.Context) {
+	// 0x23f: 0x6017 LD V0, 0x17
+	c.V[0x0] = 0x17
+	// 0x241: 0x6108 LD V1, 0x08
+	c.V[0x1] = 0x08
+	// 0x243: 0x6301 LD V3, 0x01
+	c.V[0x3] = 0x01
+	// 0x245: 0x8322 AND V3, V2
+	c.V[0x3] &= c.V[0x2]
+	// 0x247: 0x3300 SE V3, 0x00
+	if c.V[0x3] == 0x00 {
+		*c.PC = 0x24b
+		return
+	}
+	*c.PC = 0x249
+}
+
+func block249(c *chip8.Context) {
+	// 0x249: 0x700a ADD V0, 0x0a
+	c.V[0x0] += 0x0a
+	*c.PC = 0x24b
+}
+
+func block24b(c *chip8.Context) {
+	// 0x24b: 0x6302 LD V3, 0x02
+	c.V[0x3] = 0x02
+	// 0x24d: 0x8322 AND V3, V2
+	c.V[0x3] &= c.V[0x2]
+	// 0x24f: 0x3300 SE V3, 0x00
+	if c.V[0x3] == 0x00 {
+		*c.PC = 0x253
+		return
+	}
+	*c.PC = 0x251
+}
+
+func block251(c *chip8.Context) {
+	// 0x251: 0x710a ADD V1, 0x0a
+	c.V[0x1] += 0x0a
+	*c.PC = 0x253
+}
+
+func block253(c *chip8.Context) {
+	// 0x253: 0xa317 LD I, 0x317
+	*c.I = 0x317
+	// 0x255: 0xd016 DRW V0, V1, 0x6
+	c.Exec(0x255)
+	// 0x257: 0x6214 LD V2, 0x14
+	c.V[0x2] = 0x14
+	*c.PC = 0x259
+}
+
+func block25b(c *chip8.Context) {
+	// 0x25b: 0xd016 DRW V0, V1, 0x6
+	c.Exec(0x25b)
+	// 0x25d: 0x6205 LD V2, 0x05
+	c.V[0x2] = 0x05
+	*c.PC = 0x25f
+}
+
+func block261(c *chip8.Context) {
+	// 0x261: 0x7501 ADD V5, 0x01
+	c.V[0x5] += 0x01
+	// 0x263: 0x5450 SE V4, V5
+	if c.V[0x4] == c.V[0x5] {
+		*c.PC = 0x267
+		return
+	}
+	*c.PC = 0x265
+}
+
+func block265(c *chip8.Context) {
+	// 0x265: 0x1235 JP 0x235
+	*c.PC = 0x235
+}
+
+func block267(c *chip8.Context) {
+	// 0x267: 0x6500 LD V5, 0x00
+	c.V[0x5] = 0x00
+	*c.PC = 0x269
+}
+
+func block269(c *chip8.Context) {
+	// 0x269: 0x6017 LD V0, 0x17
+	c.V[0x0] = 0x17
+	// 0x26b: 0x6108 LD V1, 0x08
+	c.V[0x1] = 0x08
+	// 0x26d: 0xa317 LD I, 0x317
+	*c.I = 0x317
+	*c.PC = 0x26f
+}
+
+func block271(c *chip8.Context) {
+	// 0x271: 0x3304 SE V3, 0x04
+	if c.V[0x3] == 0x04 {
+		*c.PC = 0x275
+		return
+	}
+	*c.PC = 0x273
+}
+
+func block273(c *chip8.Context) {
+	// 0x273: 0x1279 JP 0x279
+	*c.PC = 0x279
+}
+
+func block275(c *chip8.Context) {
+	// 0x275: 0x6300 LD V3, 0x00
+	c.V[0x3] = 0x00
+	// 0x277: 0x1297 JP 0x297
+	*c.PC = 0x297
+}
+
+func block279(c *chip8.Context) {
+	// 0x279: 0x3305 SE V3, 0x05
+	if c.V[0x3] == 0x05 {
+		*c.PC = 0x27d
+		return
+	}
+	*c.PC = 0x27b
+}
+
+func block27b(c *chip8.Context) {
+	// 0x27b: 0x1283 JP 0x283
+	*c.PC = 0x283
+}
+
+func block27d(c *chip8.Context) {
+	// 0x27d: 0x700a ADD V0, 0x0a
+	c.V[0x0] += 0x0a
+	// 0x27f: 0x6301 LD V3, 0x01
+	c.V[0x3] = 0x01
+	// 0x281: 0x1297 JP 0x297
+	*c.PC = 0x297
+}
+
+func block283(c *chip8.Context) {
+	// 0x283: 0x3307 SE V3, 0x07
+	if c.V[0x3] == 0x07 {
+		*c.PC = 0x287
+		return
+	}
+	*c.PC = 0x285
+}
+
+func block285(c *chip8.Context) {
+	// 0x285: 0x128d JP 0x28d
+	*c.PC = 0x28d
+}
+
+func block287(c *chip8.Context) {
+	// 0x287: 0x710a ADD V1, 0x0a
+	c.V[0x1] += 0x0a
+	// 0x289: 0x6302 LD V3, 0x02
+	c.V[0x3] = 0x02
+	// 0x28b: 0x1297 JP 0x297
+	*c.PC = 0x297
+}
+
+func block28d(c *chip8.Context) {
+	// 0x28d: 0x3308 SE V3, 0x08
+	if c.V[0x3] == 0x08 {
+		*c.PC = 0x291
+		return
+	}
+	*c.PC = 0x28f
+}
+
+func block28f(c *chip8.Context) {
+	// 0x28f: 0x1269 JP 0x269
+	*c.PC = 0x269
+}
+
+func block291(c *chip8.Context) {
+	// 0x291: 0x700a ADD V0, 0x0a
+	c.V[0x0] += 0x0a
+	// 0x293: 0x710a ADD V1, 0x0a
+	c.V[0x1] += 0x0a
+	// 0x295: 0x6303 LD V3, 0x03
+	c.V[0x3] = 0x03
+	*c.PC = 0x297
+}
+
+func block297(c *chip8.Context) {
+	// 0x297: 0xd016 DRW V0, V1, 0x6
+	c.Exec(0x297)
+	// 0x299: 0x6214 LD V2, 0x14
+	c.V[0x2] = 0x14
+	*c.PC = 0x29b
+}
+
+func block29d(c *chip8.Context) {
+	// 0x29d: 0xd016 DRW V0, V1, 0x6
+	c.Exec(0x29d)
+	// 0x29f: 0xa359 LD I, 0x359
+	*c.I = 0x359
+	// 0x2a1: 0xf51e ADD I, V5
+	*c.I += uint16(c.V[0x5])
+	// 0x2a3: 0xf065 LD V0, [I]
+	c.Exec(0x2a3)
+	// 0x2a5: 0x7501 ADD V5, 0x01
+	c.V[0x5] += 0x01
+	// 0x2a7: 0x5030 SE V0, V3
+	if c.V[0x0] == c.V[0x3] {
+		*c.PC = 0x2ab
+		return
+	}
+	*c.PC = 0x2a9
+}
+
+func block2a9(c *chip8.Context) {
+	// 0x2a9: 0x12b5 JP 0x2b5
+	*c.PC = 0x2b5
+}
+
+func block2ab(c *chip8.Context) {
+	// 0x2ab: 0x5540 SE V5, V4
+	if c.V[0x5] == c.V[0x4] {
+		*c.PC = 0x2af
+		return
+	}
+	*c.PC = 0x2ad
+}
+
+func block2ad(c *chip8.Context) {
+	// 0x2ad: 0x1269 JP 0x269
+	*c.PC = 0x269
+}
+
+func block2b1(c *chip8.Context) {
+	// 0x2b1: 0x7401 ADD V4, 0x01
+	c.V[0x4] += 0x01
+	// 0x2b3: 0x122d JP 0x22d
+	*c.PC = 0x22d
+}
+
+func block2b7(c *chip8.Context) {
+	// 0x2b7: 0xa345 LD I, 0x345
+	*c.I = 0x345
+	// 0x2b9: 0x6010 LD V0, 0x10
+	c.V[0x0] = 0x10
+	// 0x2bb: 0x610e LD V1, 0x0e
+	c.V[0x1] = 0x0e
+	*c.PC = 0x2bd
+}
+
+func block2bf(c *chip8.Context) {
+	// 0x2bf: 0x12bf JP 0x2bf
+	*c.PC = 0x2bf
+}
+
+func block2c1(c *chip8.Context) {
+	// 0x2c1: 0xf215 LD DT, V2
+	*c.DT = c.V[0x2]
+	*c.PC = 0x2c3
+}
+
+func block2c3(c *chip8.Context) {
+	// 0x2c3: 0xf207 LD V2, DT
+	c.V[0x2] = *c.DT
+	// 0x2c5: 0x3200 SE V2, 0x00
+	if c.V[0x2] == 0x00 {
+		*c.PC = 0x2c9
+		return
+	}
+	*c.PC = 0x2c7
+}
+
+func block2c7(c *chip8.Context) {
+	// 0x2c7: 0x12c3 JP 0x2c3
+	*c.PC = 0x2c3
+}
+
+func block2cb(c *chip8.Context) {
+	// 0x2cb: 0x8300 LD V3, V0
+	c.V[0x3] = c.V[0x0]
+	// 0x2cd: 0x6205 LD V2, 0x05
+	c.V[0x2] = 0x05
+	*c.PC = 0x2cf
+}
+
+func block2cf(c *chip8.Context) {
+	// 0x2cf: 0xd015 DRW V0, V1, 0x5
+	c.Exec(0x2cf)
+	// 0x2d1: 0xf21e ADD I, V2
+	*c.I += uint16(c.V[0x2])
+	// 0x2d3: 0x7008 ADD V0, 0x08
+	c.V[0x0] += 0x08
+	// 0x2d5: 0x8530 LD V5, V3
+	c.V[0x5] = c.V[0x3]
+	// 0x2d7: 0x7520 ADD V5, 0x20
+	c.V[0x5] += 0x20
+	// 0x2d9: 0x5050 SE V0, V5
+	if c.V[0x0] == c.V[0x5] {
+		*c.PC = 0x2dd
+		return
+	}
+	*c.PC = 0x2db
+}
+
+func block2db(c *chip8.Context) {
+	// 0x2db: 0x12cf JP 0x2cf
+	*c.PC = 0x2cf
+}
+
+func block2df(c *chip8.Context) {
+	// 0x2df: 0xa359 LD I, 0x359
+	*c.I = 0x359
+	// 0x2e1: 0x8340 LD V3, V4
+	c.V[0x3] = c.V[0x4]
+	// 0x2e3: 0x73fd ADD V3, 0xfd
+	c.V[0x3] += 0xfd
+	// 0x2e5: 0xf333 LD B, V3
+	c.Exec(0x2e5)
+	*c.PC = 0x2e7
+}
+
+func block2e7(c *chip8.Context) {
+	// 0x2e7: 0xf265 LD V2, [I]
+	c.Exec(0x2e7)
+	// 0x2e9: 0xf129 LD F, V1
+	*c.I = uint16(c.V[0x1] * 5)
+	// 0x2eb: 0x602b LD V0, 0x2b
+	c.V[0x0] = 0x2b
+	// 0x2ed: 0x631b LD V3, 0x1b
+	c.V[0x3] = 0x1b
+	// 0x2ef: 0xd035 DRW V0, V3, 0x5
+	c.Exec(0x2ef)
+	// 0x2f1: 0x7005 ADD V0, 0x05
+	c.V[0x0] += 0x05
+	// 0x2f3: 0xf229 LD F, V2
+	*c.I = uint16(c.V[0x2] * 5)
+	// 0x2f5: 0xd035 DRW V0, V3, 0x5
+	c.Exec(0x2f5)
+	*c.PC = 0x2f7
+}
+
+func block2f9(c *chip8.Context) {
+	// 0x2f9: 0xa30f LD I, 0x30f
+	*c.I = 0x30f
+	// 0x2fb: 0x6017 LD V0, 0x17
+	c.V[0x0] = 0x17
+	// 0x2fd: 0x6107 LD V1, 0x07
+	c.V[0x1] = 0x07
+	// 0x2ff: 0xd018 DRW V0, V1, 0x8
+	c.Exec(0x2ff)
+	// 0x301: 0x700a ADD V0, 0x0a
+	c.V[0x0] += 0x0a
+	// 0x303: 0xd018 DRW V0, V1, 0x8
+	c.Exec(0x303)
+	// 0x305: 0x710a ADD V1, 0x0a
+	c.V[0x1] += 0x0a
+	// 0x307: 0xd018 DRW V0, V1, 0x8
+	c.Exec(0x307)
+	// 0x309: 0x70f6 ADD V0, 0xf6
+	c.V[0x0] += 0xf6
+	// 0x30b: 0xd018 DRW V0, V1, 0x8
+	c.Exec(0x30b)
+	*c.PC = 0x30d
+}
@@ -0,0 +1,351 @@
+// Code generated by go-chip8 recompile from MISSILE; DO NOT EDIT.
+
+package missile
+
+import "github.com/morinokami/go-chip8/chip8"
+
+var Program = &chip8.Program{
+	ROM: "70fde31eb67c3b405b7484be49c4685a4de2de4a85194784dcb39c3aed4013fb",
+	Blocks: map[uint16]*chip8.Block{
+		0x200: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block200},
+		0x219: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr}, Run: block219},
+		0x229: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.SEVxByte}, Run: block229},
+		0x22f: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block22f},
+		0x231: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr, chip8.DRW}, Run: block231},
+		0x239: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.SEVxByte}, Run: block239},
+		0x23f: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block23f},
+		0x241: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SNEVxByte}, Run: block241},
+		0x245: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block245},
+		0x247: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block247},
+		0x249: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SNEVxByte}, Run: block249},
+		0x24d: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block24d},
+		0x24f: {Insts: []chip8.Instruction{chip8.DRW, chip8.LDDTVx}, Run: block24f},
+		0x253: {Insts: []chip8.Instruction{chip8.LDVxDT, chip8.SEVxByte}, Run: block253},
+		0x257: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block257},
+		0x259: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKP}, Run: block259},
+		0x25d: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block25d},
+		0x25f: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block25f},
+		0x261: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block261},
+		0x263: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxVy, chip8.LDIAddr, chip8.DRW, chip8.LDVxByte}, Run: block263},
+		0x26d: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.DRW, chip8.SEVxByte}, Run: block26d},
+		0x275: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block275},
+		0x277: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block277},
+		0x279: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block279},
+		0x27b: {Insts: []chip8.Instruction{chip8.DRW, chip8.SEVxByte}, Run: block27b},
+		0x27f: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block27f},
+		0x281: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.ADDVxByte, chip8.LDVxVy, chip8.LDVxByte, chip8.LDIAddr, chip8.DRW, chip8.SNEVxByte}, Run: block281},
+		0x28f: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block28f},
+		0x291: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SEVxByte}, Run: block291},
+		0x295: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block295},
+		0x297: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDBVx}, Run: block297},
+		0x29b: {Insts: []chip8.Instruction{chip8.LDVxI, chip8.LDVxByte, chip8.LDVxByte, chip8.LDFVx, chip8.DRW, chip8.ADDVxByte, chip8.LDFVx, chip8.DRW}, Run: block29b},
+		0x2ab: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2ab},
+	},
+}
+
+func block200(c *chip8.Context) {
+	// 0x200: 0x1219 JP 0x219
+	*c.PC = 0x219
+}
+
+func block219(c *chip8.Context) {
+	// 0x219: 0x6c0c LD Vc, 0x0c
+	c.V[0xc] = 0x0c
+	// 0x21b: 0x6000 LD V0, 0x00
+	c.V[0x0] = 0x00
+	// 0x21d: 0x6100 LD V1, 0x00
+	c.V[0x1] = 0x00
+	// 0x21f: 0x6508 LD V5, 0x08
+	c.V[0x5] = 0x08
+	// 0x221: 0x660a LD V6, 0x0a
+	c.V[0x6] = 0x0a
+	// 0x223: 0x6700 LD V7, 0x00
+	c.V[0x7] = 0x00
+	// 0x225: 0x6e01 LD Ve, 0x01
+	c.V[0xe] = 0x01
+	// 0x227: 0xa2ad LD I, 0x2ad
+	*c.I = 0x2ad
+	*c.PC = 0x229
+}
+
+func block229(c *chip8.Context) {
+	// 0x229: 0xd014 DRW V0, V1, 0x4
+	c.Exec(0x229)
+	// 0x22b: 0x7008 ADD V0, 0x08
+	c.V[0x0] += 0x08
+	// 0x22d: 0x3040 SE V0, 0x40
+	if c.V[0x0] == 0x40 {
+		*c.PC = 0x231
+		return
+	}
+	*c.PC = 0x22f
+}
+
+func block22f(c *chip8.Context) {
+	// 0x22f: 0x1229 JP 0x229
+	*c.PC = 0x229
+}
+
+func block231(c *chip8.Context) {
+	// 0x231: 0x6000 LD V0, 0x00
+	c.V[0x0] = 0x00
+	// 0x233: 0x611c LD V1, 0x1c
+	c.V[0x1] = 0x1c
+	// 0x235: 0xa2b0 LD I, 0x2b0
+	*c.I = 0x2b0
+	// 0x237: 0xd014 DRW V0, V1, 0x4
+	c.Exec(0x237)
+	*c.PC = 0x239
+}
+
+func block239(c *chip8.Context) {
+	// 0x239: 0xa2b0 LD I, 0x2b0
+	*c.I = 0x2b0
+	// 0x23b: 0xd014 DRW V0, V1, 0x4
+	c.Exec(0x23b)
+	// 0x23d: 0x3e01 SE Ve, 0x01
+	if c.V[0xe] == 0x01 {
+		*c.PC = 0x241
+		return
+	}
+	*c.PC = 0x23f
+}
+
+func block23f(c *chip8.Context) {
+	// 0x23f: 0x1249 JP 0x249
+	*c.PC = 0x249
+}
+
+func block241(c *chip8.Context) {
+	// 0x241: 0x7004 ADD V0, 0x04
+	c.V[0x0] += 0x04
+	// 0x243: 0x4038 SNE V0, 0x38
+	if c.V[0x0] != 0x38 {
+		*c.PC = 0x247
+		return
+	}
+	*c.PC = 0x245
+}
+
+func block245(c *chip8.Context) {
+	// 0x245: 0x6e00 LD Ve, 0x00
+	c.V[0xe] = 0x00
+	*c.PC = 0x247
+}
+
+func block247(c *chip8.Context) {
+	// 0x247: 0x124f JP 0x24f
+	*c.PC = 0x24f
+}
+
+func block249(c *chip8.Context) {
+	// 0x249: 0x70fc ADD V0, 0xfc
+	c.V[0x0] += 0xfc
+	// 0x24b: 0x4000 SNE V0, 0x00
+	if c.V[0x0] != 0x00 {
+		*c.PC = 0x24f
+		return
+	}
+	*c.PC = 0x24d
+}
+
+func block24d(c *chip8.Context) {
+	// 0x24d: 0x6e01 LD Ve, 0x01
+	c.V[0xe] = 0x01
+	*c.PC = 0x24f
+}
+
+func block24f(c *chip8.Context) {
+	// 0x24f: 0xd014 DRW V0, V1, 0x4
+	c.Exec(0x24f)
+	// 0x251: 0xfc15 LD DT, Vc
+	*c.DT = c.V[0xc]
+	*c.PC = 0x253
+}
+
+func block253(c *chip8.Context) {
+	// 0x253: 0xfb07 LD Vb, DT
+	c.V[0xb] = *c.DT
+	// 0x255: 0x3b00 SE Vb, 0x00
+	if c.V[0xb] == 0x00 {
+		*c.PC = 0x259
+		return
+	}
+	*c.PC = 0x257
+}
+
+func block257(c *chip8.Context) {
+	// 0x257: 0x1253 JP 0x253
+	*c.PC = 0x253
+}
+
+func block259(c *chip8.Context) {
+	// 0x259: 0x6208 LD V2, 0x08
+	c.V[0x2] = 0x08
+	// 0x25b: 0xe29e SKP V2
+	if c.Pressed(c.V[0x2]) {
+		*c.PC = 0x25f
+		return
+	}
+	*c.PC = 0x25d
+}
+
+func block25d(c *chip8.Context) {
+	// 0x25d: 0x1295 JP 0x295
+	*c.PC = 0x295
+}
+
+func block25f(c *chip8.Context) {
+	// 0x25f: 0x3c00 SE Vc, 0x00
+	if c.V[0xc] == 0x00 {
+		*c.PC = 0x263
+		return
+	}
+	*c.PC = 0x261
+}
+
+func block261(c *chip8.Context) {
+	// 0x261: 0x7cfe ADD Vc, 0xfe
+	c.V[0xc] += 0xfe
+	*c.PC = 0x263
+}
+
+func block263(c *chip8.Context) {
+	// 0x263: 0x631b LD V3, 0x1b
+	c.V[0x3] = 0x1b
+	// 0x265: 0x8200 LD V2, V0
+	c.V[0x2] = c.V[0x0]
+	// 0x267: 0xa2b0 LD I, 0x2b0
+	*c.I = 0x2b0
+	// 0x269: 0xd231 DRW V2, V3, 0x1
+	c.Exec(0x269)
+	// 0x26b: 0x6400 LD V4, 0x00
+	c.V[0x4] = 0x00
+	*c.PC = 0x26d
+}
+
+func block26d(c *chip8.Context) {
+	// 0x26d: 0xd231 DRW V2, V3, 0x1
+	c.Exec(0x26d)
+	// 0x26f: 0x73ff ADD V3, 0xff
+	c.V[0x3] += 0xff
+	// 0x271: 0xd231 DRW V2, V3, 0x1
+	c.Exec(0x271)
+	// 0x273: 0x3f00 SE Vf, 0x00
+	if c.V[0xf] == 0x00 {
+		*c.PC = 0x277
+		return
+	}
+	*c.PC = 0x275
+}
+
+func block275(c *chip8.Context) {
+	// 0x275: 0x6401 LD V4, 0x01
+	c.V[0x4] = 0x01
+	*c.PC = 0x277
+}
+
+func block277(c *chip8.Context) {
+	// 0x277: 0x3303 SE V3, 0x03
+	if c.V[0x3] == 0x03 {
+		*c.PC = 0x27b
+		return
+	}
+	*c.PC = 0x279
+}
+
+func block279(c *chip8.Context) {
+	// 0x279: 0x126d JP 0x26d
+	*c.PC = 0x26d
+}
+
+func block27b(c *chip8.Context) {
+	// 0x27b: 0xd231 DRW V2, V3, 0x1
+	c.Exec(0x27b)
+	// 0x27d: 0x3401 SE V4, 0x01
+	if c.V[0x4] == 0x01 {
+		*c.PC = 0x281
+		return
+	}
+	*c.PC = 0x27f
+}
+
+func block27f(c *chip8.Context) {
+	// 0x27f: 0x1291 JP 0x291
+	*c.PC = 0x291
+}
+
+func block281(c *chip8.Context) {
+	// 0x281: 0x7705 ADD V7, 0x05
+	c.V[0x7] += 0x05
+	// 0x283: 0x75ff ADD V5, 0xff
+	c.V[0x5] += 0xff
+	// 0x285: 0x8200 LD V2, V0
+	c.V[0x2] = c.V[0x0]
+	// 0x287: 0x6300 LD V3, 0x00
+	c.V[0x3] = 0x00
+	// 0x289: 0xa2ad LD I, 0x2ad
+	*c.I = 0x2ad
+	// 0x28b: 0xd234 DRW V2, V3, 0x4
+	c.Exec(0x28b)
+	// 0x28d: 0x4500 SNE V5, 0x00
+	if c.V[0x5] != 0x00 {
+		*c.PC = 0x291
+		return
+	}
+	*c.PC = 0x28f
+}
+
+func block28f(c *chip8.Context) {
+	// 0x28f: 0x1297 JP 0x297
+	*c.PC = 0x297
+}
+
+func block291(c *chip8.Context) {
+	// 0x291: 0x76ff ADD V6, 0xff
+	c.V[0x6] += 0xff
+	// 0x293: 0x3600 SE V6, 0x00
+	if c.V[0x6] == 0x00 {
+		*c.PC = 0x297
+		return
+	}
+	*c.PC = 0x295
+}
+
+func block295(c *chip8.Context) {
+	// 0x295: 0x1239 JP 0x239
+	*c.PC = 0x239
+}
+
+func block297(c *chip8.Context) {
+	// 0x297: 0xa2b4 LD I, 0x2b4
+	*c.I = 0x2b4
+	// 0x299: 0xf733 LD B, V7
+	c.Exec(0x299)
+	*c.PC = 0x29b
+}
+
+func block29b(c *chip8.Context) {
+	// 0x29b: 0xf265 LD V2, [I]
+	c.Exec(0x29b)
+	// 0x29d: 0x631b LD V3, 0x1b
+	c.V[0x3] = 0x1b
+	// 0x29f: 0x640d LD V4, 0x0d
+	c.V[0x4] = 0x0d
+	// 0x2a1: 0xf129 LD F, V1
+	*c.I = uint16(c.V[0x1] * 5)
+	// 0x2a3: 0xd345 DRW V3, V4, 0x5
+	c.Exec(0x2a3)
+	// 0x2a5: 0x7305 ADD V3, 0x05
+	c.V[0x3] += 0x05
+	// 0x2a7: 0xf229 LD F, V2
+	*c.I = uint16(c.V[0x2] * 5)
+	// 0x2a9: 0xd345 DRW V3, V4, 0x5
+	c.Exec(0x2a9)
+	*c.PC = 0x2ab
+}
+
+func block2ab(c *chip8.Context) {
+	// 0x2ab: 0x12ab JP 0x2ab
+	*c.PC = 0x2ab
+}
@@ -0,0 +1,522 @@
+// Code generated by go-chip8 recompile from PONG; DO NOT EDIT.
+
+package pong
+
+import "github.com/morinokami/go-chip8/chip8"
+
+var Program = &chip8.Program{
+	ROM: "1db31d734b9352f96aa5e11d9a3085b043a04f21cc793ac9bfde62f857f983e9",
+	Blocks: map[uint16]*chip8.Block{
+		0x200: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr, chip8.DRW, chip8.DRW, chip8.LDVxByte}, Run: block200},
+		0x212: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte}, Run: block212},
+		0x216: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDDTVx}, Run: block216},
+		0x21a: {Insts: []chip8.Instruction{chip8.LDVxDT, chip8.SEVxByte}, Run: block21a},
+		0x21e: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block21e},
+		0x220: {Insts: []chip8.Instruction{chip8.RND, chip8.ADDVxByte, chip8.LDVxByte, chip8.LDIAddr, chip8.DRW}, Run: block220},
+		0x22a: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.DRW, chip8.LDVxByte, chip8.SKNP}, Run: block22a},
+		0x234: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block234},
+		0x236: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block236},
+		0x23a: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block23a},
+		0x23c: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.DRW, chip8.LDVxByte, chip8.SKNP}, Run: block23c},
+		0x246: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block246},
+		0x248: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block248},
+		0x24c: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block24c},
+		0x24e: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.DRW, chip8.LDIAddr, chip8.DRW, chip8.ADDVxVy, chip8.ADDVxVy, chip8.LDVxByte, chip8.AND, chip8.LDVxByte}, Run: block24e},
+		0x266: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block266},
+		0x268: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block268},
+		0x26a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block26a},
+		0x26c: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block26c},
+		0x26e: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block26e},
+		0x270: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block270},
+		0x272: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block272},
+		0x274: {Insts: []chip8.Instruction{chip8.DRW, chip8.JPAddr}, Run: block274},
+		0x278: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxVy, chip8.SUB, chip8.JPAddr}, Run: block278},
+		0x282: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxVy, chip8.SUB}, Run: block282},
+		0x28a: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block28a},
+		0x28c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block28c},
+		0x28e: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SUB, chip8.SEVxByte}, Run: block28e},
+		0x294: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block294},
+		0x296: {Insts: []chip8.Instruction{chip8.SUB, chip8.SEVxByte}, Run: block296},
+		0x29a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block29a},
+		0x29c: {Insts: []chip8.Instruction{chip8.SUB, chip8.SEVxByte}, Run: block29c},
+		0x2a0: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2a0},
+		0x2a2: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDSTVx}, Run: block2a2},
+		0x2a8: {Insts: []chip8.Instruction{chip8.ADDVxVy}, Run: block2a8},
+		0x2ac: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SEVxByte}, Run: block2ac},
+		0x2b0: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2b0},
+		0x2b2: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SEVxByte}, Run: block2b2},
+		0x2b6: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2b6},
+		0x2b8: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2b8},
+		0x2ba: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SNEVxByte}, Run: block2ba},
+		0x2be: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2be},
+		0x2c0: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2c0},
+		0x2c2: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SNEVxByte}, Run: block2c2},
+		0x2c6: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2c6},
+		0x2c8: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDSTVx, chip8.ADDVxByte, chip8.SNEVxByte}, Run: block2c8},
+		0x2d0: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block2d0},
+		0x2d2: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2d2},
+		0x2d4: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDBVx}, Run: block2d4},
+		0x2d8: {Insts: []chip8.Instruction{chip8.LDVxI, chip8.LDFVx, chip8.LDVxByte, chip8.LDVxByte, chip8.DRW, chip8.ADDVxByte, chip8.LDFVx, chip8.DRW}, Run: block2d8},
+	},
+}
+
+func block200(c *chip8.Context) {
+	// 0x200: 0x6a02 LD Va, 0x02
+	c.V[0xa] = 0x02
+	// 0x202: 0x6b0c LD Vb, 0x0c
+	c.V[0xb] = 0x0c
+	// 0x204: 0x6c3f LD Vc, 0x3f
+	c.V[0xc] = 0x3f
+	// 0x206: 0x6d0c LD Vd, 0x0c
+	c.V[0xd] = 0x0c
+	// 0x208: 0xa2ea LD I, 0x2ea
+	*c.I = 0x2ea
+	// 0x20a: 0xdab6 DRW Va, Vb, 0x6
+	c.Exec(0x20a)
+	// 0x20c: 0xdcd6 DRW Vc, Vd, 0x6
+	c.Exec(0x20c)
+	// 0x20e: 0x6e00 LD Ve, 0x00
+	c.V[0xe] = 0x00
+	*c.PC = 0x210
+}
+
+func block212(c *chip8.Context) {
+	// 0x212: 0x6603 LD V6, 0x03
+	c.V[0x6] = 0x03
+	// 0x214: 0x6802 LD V8, 0x02
+	c.V[0x8] = 0x02
+	*c.PC = 0x216
+}
+
+func block216(c *chip8.Context) {
+	// 0x216: 0x6060 LD V0, 0x60
+	c.V[0x0] = 0x60
+	// 0x218: 0xf015 LD DT, V0
+	*c.DT = c.V[0x0]
+	*c.PC = 0x21a
+}
+
+func block21a(c *chip8.Context) {
+	// 0x21a: 0xf007 LD V0, DT
+	c.V[0x0] = *c.DT
+	// 0x21c: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x220
+		return
+	}
+	*c.PC = 0x21e
+}
+
+func block21e(c *chip8.Context) {
+	// 0x21e: 0x121a JP 0x21a
+	*c.PC = 0x21a
+}
+
+func block220(c *chip8.Context) {
+	// 0x220: 0xc717 RND V7, byte
+	c.Exec(0x220)
+	// 0x222: 0x7708 ADD V7, 0x08
+	c.V[0x7] += 0x08
+	// 0x224: 0x69ff LD V9, 0xff
+	c.V[0x9] = 0xff
+	// 0x226: 0xa2f0 LD I, 0x2f0
+	*c.I = 0x2f0
+	// 0x228: 0xd671 DRW V6, V7, 0x1
+	c.Exec(0x228)
+	*c.PC = 0x22a
+}
+
+func block22a(c *chip8.Context) {
+	// 0x22a: 0xa2ea LD I, 0x2ea
+	*c.I = 0x2ea
+	// 0x22c: 0xdab6 DRW Va, Vb, 0x6
+	c.Exec(0x22c)
+	// 0x22e: 0xdcd6 DRW Vc, Vd, 0x6
+	c.Exec(0x22e)
+	// 0x230: 0x6001 LD V0, 0x01
+	c.V[0x0] = 0x01
+	// 0x232: 0xe0a1 SKNP V0
+	if !c.Pressed(c.V[0x0]) {
+		*c.PC = 0x236
+		return
+	}
+	*c.PC = 0x234
+}
+
+func block234(c *chip8.Context) {
+	// 0x234: 0x7bfe ADD Vb, 0xfe
+	c.V[0xb] += 0xfe
+	*c.PC = 0x236
+}
+
+func block236(c *chip8.Context) {
+	// 0x236: 0x6004 LD V0, 0x04
+	c.V[0x0] = 0x04
+	// 0x238: 0xe0a1 SKNP V0
+	if !c.Pressed(c.V[0x0]) {
+		*c.PC = 0x23c
+		return
+	}
+	*c.PC = 0x23a
+}
+
+func block23a(c *chip8.Context) {
+	// 0x23a: 0x7b02 ADD Vb, 0x02
+	c.V[0xb] += 0x02
+	*c.PC = 0x23c
+}
+
+func block23c(c *chip8.Context) {
+	// 0x23c: 0x601f LD V0, 0x1f
+	c.V[0x0] = 0x1f
+	// 0x23e: 0x8b02 AND Vb, V0
+	c.V[0xb] &= c.V[0x0]
+	// 0x240: 0xdab6 DRW Va, Vb, 0x6
+	c.Exec(0x240)
+	// 0x242: 0x600c LD V0, 0x0c
+	c.V[0x0] = 0x0c
+	// 0x244: 0xe0a1 SKNP V0
+	if !c.Pressed(c.V[0x0]) {
+		*c.PC = 0x248
+		return
+	}
+	*c.PC = 0x246
+}
+
+func block246(c *chip8.Context) {
+	// 0x246: 0x7dfe ADD Vd, 0xfe
+	c.V[0xd] += 0xfe
+	*c.PC = 0x248
+}
+
+func block248(c *chip8.Context) {
+	// 0x248: 0x600d LD V0, 0x0d
+	c.V[0x0] = 0x0d
+	// 0x24a: 0xe0a1 SKNP V0
+	if !c.Pressed(c.V[0x0]) {
+		*c.PC = 0x24e
+		return
+	}
+	*c.PC = 0x24c
+}
+
+func block24c(c *chip8.Context) {
+	// 0x24c: 0x7d02 ADD Vd, 0x02
+	c.V[0xd] += 0x02
+	*c.PC = 0x24e
+}
+
+func block24e(c *chip8.Context) {
+	// 0x24e: 0x601f LD V0, 0x1f
+	c.V[0x0] = 0x1f
+	// 0x250: 0x8d02 AND Vd, V0
+	c.V[0xd] &= c.V[0x0]
+	// 0x252: 0xdcd6 DRW Vc, Vd, 0x6
+	c.Exec(0x252)
+	// 0x254: 0xa2f0 LD I, 0x2f0
+	*c.I = 0x2f0
+	// 0x256: 0xd671 DRW V6, V7, 0x1
+	c.Exec(0x256)
+	// 0x258: 0x8684 ADD V6, V8
+	c.Exec(0x258)
+	// 0x25a: 0x8794 ADD V7, V9
+	c.Exec(0x25a)
+	// 0x25c: 0x603f LD V0, 0x3f
+	c.V[0x0] = 0x3f
+	// 0x25e: 0x8602 AND V6, V0
+	c.V[0x6] &= c.V[0x0]
+	// 0x260: 0x611f LD V1, 0x1f
+	c.V[0x1] = 0x1f
+	*c.PC = 0x262
+}
+
+func block266(c *chip8.Context) {
+	// 0x266: 0x1278 JP 0x278
+	*c.PC = 0x278
+}
+
+func block268(c *chip8.Context) {
+	// 0x268: 0x463f SNE V6, 0x3f
+	if c.V[0x6] != 0x3f {
+		*c.PC = 0x26c
+		return
+	}
+	*c.PC = 0x26a
+}
+
+func block26a(c *chip8.Context) {
+	// 0x26a: 0x1282 JP 0x282
+	*c.PC = 0x282
+}
+
+func block26c(c *chip8.Context) {
+	// 0x26c: 0x471f SNE V7, 0x1f
+	if c.V[0x7] != 0x1f {
+		*c.PC = 0x270
+		return
+	}
+	*c.PC = 0x26e
+}
+
+func block26e(c *chip8.Context) {
+	// 0x26e: 0x69ff LD V9, 0xff
+	c.V[0x9] = 0xff
+	*c.PC = 0x270
+}
+
+func block270(c *chip8.Context) {
+	// 0x270: 0x4700 SNE V7, 0x00
+	if c.V[0x7] != 0x00 {
+		*c.PC = 0x274
+		return
+	}
+	*c.PC = 0x272
+}
+
+func block272(c *chip8.Context) {
+	// 0x272: 0x6901 LD V9, 0x01
+	c.V[0x9] = 0x01
+	*c.PC = 0x274
+}
+
+func block274(c *chip8.Context) {
+	// 0x274: 0xd671 DRW V6, V7, 0x1
+	c.Exec(0x274)
+	// 0x276: 0x122a JP 0x22a
+	*c.PC = 0x22a
+}
+
+func block278(c *chip8.Context) {
+	// 0x278: 0x6802 LD V8, 0x02
+	c.V[0x8] = 0x02
+	// 0x27a: 0x6301 LD V3, 0x01
+	c.V[0x3] = 0x01
+	// 0x27c: 0x8070 LD V0, V7
+	c.V[0x0] = c.V[0x7]
+	// 0x27e: 0x80b5 SUB V0, Vb
+	c.Exec(0x27e)
+	// 0x280: 0x128a JP 0x28a
+	*c.PC = 0x28a
+}
+
+func block282(c *chip8.Context) {
+	// 0x282: 0x68fe LD V8, 0xfe
+	c.V[0x8] = 0xfe
+	// 0x284: 0x630a LD V3, 0x0a
+	c.V[0x3] = 0x0a
+	// 0x286: 0x8070 LD V0, V7
+	c.V[0x0] = c.V[0x7]
+	// 0x288: 0x80d5 SUB V0, Vd
+	c.Exec(0x288)
+	*c.PC = 0x28a
+}
+
+func block28a(c *chip8.Context) {
+	// 0x28a: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x28e
+		return
+	}
+	*c.PC = 0x28c
+}
+
+func block28c(c *chip8.Context) {
+	// 0x28c: 0x12a2 JP 0x2a2
+	*c.PC = 0x2a2
+}
+
+func block28e(c *chip8.Context) {
+	// 0x28e: 0x6102 LD V1, 0x02
+	c.V[0x1] = 0x02
+	// 0x290: 0x8015 SUB V0, V1
+	c.Exec(0x290)
+	// 0x292: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x296
+		return
+	}
+	*c.PC = 0x294
+}
+
+func block294(c *chip8.Context) {
+	// 0x294: 0x12ba JP 0x2ba
+	*c.PC = 0x2ba
+}
+
+func block296(c *chip8.Context) {
+	// 0x296: 0x8015 SUB V0, V1
+	c.Exec(0x296)
+	// 0x298: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x29c
+		return
+	}
+	*c.PC = 0x29a
+}
+
+func block29a(c *chip8.Context) {
+	// 0x29a: 0x12c8 JP 0x2c8
+	*c.PC = 0x2c8
+}
+
+func block29c(c *chip8.Context) {
+	// 0x29c: 0x8015 SUB V0, V1
+	c.Exec(0x29c)
+	// 0x29e: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x2a2
+		return
+	}
+	*c.PC = 0x2a0
+}
+
+func block2a0(c *chip8.Context) {
+	// 0x2a0: 0x12c2 JP 0x2c2
+	*c.PC = 0x2c2
+}
+
+func block2a2(c *chip8.Context) {
+	// 0x2a2: 0x6020 LD V0, 0x20
+	c.V[0x0] = 0x20
+	// 0x2a4: 0xf018 LD ST, V0
+	*c.ST = c.V[0x0]
+	*c.PC = 0x2a6
+}
+
+func block2a8(c *chip8.Context) {
+	// 0x2a8: 0x8e34 ADD Ve, V3
+	c.Exec(0x2a8)
+	*c.PC = 0x2aa
+}
+
+func block2ac(c *chip8.Context) {
+	// 0x2ac: 0x663e LD V6, 0x3e
+	c.V[0x6] = 0x3e
+	// 0x2ae: 0x3301 SE V3, 0x01
+	if c.V[0x3] == 0x01 {
+		*c.PC = 0x2b2
+		return
+	}
+	*c.PC = 0x2b0
+}
+
+func block2b0(c *chip8.Context) {
+	// 0x2b0: 0x6603 LD V6, 0x03
+	c.V[0x6] = 0x03
+	*c.PC = 0x2b2
+}
+
+func block2b2(c *chip8.Context) {
+	// 0x2b2: 0x68fe LD V8, 0xfe
+	c.V[0x8] = 0xfe
+	// 0x2b4: 0x3301 SE V3, 0x01
+	if c.V[0x3] == 0x01 {
+		*c.PC = 0x2b8
+		return
+	}
+	*c.PC = 0x2b6
+}
+
+func block2b6(c *chip8.Context) {
+	// 0x2b6: 0x6802 LD V8, 0x02
+	c.V[0x8] = 0x02
+	*c.PC = 0x2b8
+}
+
+func block2b8(c *chip8.Context) {
+	// 0x2b8: 0x1216 JP 0x216
+	*c.PC = 0x216
+}
+
+func block2ba(c *chip8.Context) {
+	// 0x2ba: 0x79ff ADD V9, 0xff
+	c.V[0x9] += 0xff
+	// 0x2bc: 0x49fe SNE V9, 0xfe
+	if c.V[0x9] != 0xfe {
+		*c.PC = 0x2c0
+		return
+	}
+	*c.PC = 0x2be
+}
+
+func block2be(c *chip8.Context) {
+	// 0x2be: 0x69ff LD V9, 0xff
+	c.V[0x9] = 0xff
+	*c.PC = 0x2c0
+}
+
+func block2c0(c *chip8.Context) {
+	// 0x2c0: 0x12c8 JP 0x2c8
+	*c.PC = 0x2c8
+}
+
+func block2c2(c *chip8.Context) {
+	// 0x2c2: 0x7901 ADD V9, 0x01
+	c.V[0x9] += 0x01
+	// 0x2c4: 0x4902 SNE V9, 0x02
+	if c.V[0x9] != 0x02 {
+		*c.PC = 0x2c8
+		return
+	}
+	*c.PC = 0x2c6
+}
+
+func block2c6(c *chip8.Context) {
+	// 0x2c6: 0x6901 LD V9, 0x01
+	c.V[0x9] = 0x01
+	*c.PC = 0x2c8
+}
+
+func block2c8(c *chip8.Context) {
+	// 0x2c8: 0x6004 LD V0, 0x04
+	c.V[0x0] = 0x04
+	// 0x2ca: 0xf018 LD ST, V0
+	*c.ST = c.V[0x0]
+	// 0x2cc: 0x7601 ADD V6, 0x01
+	c.V[0x6] += 0x01
+	// 0x2ce: 0x4640 SNE V6, 0x40
+	if c.V[0x6] != 0x40 {
+		*c.PC = 0x2d2
+		return
+	}
+	*c.PC = 0x2d0
+}
+
+func block2d0(c *chip8.Context) {
+	// 0x2d0: 0x76fe ADD V6, 0xfe
+	c.V[0x6] += 0xfe
+	*c.PC = 0x2d2
+}
+
+func block2d2(c *chip8.Context) {
+	// 0x2d2: 0x126c JP 0x26c
+	*c.PC = 0x26c
+}
+
+func block2d4(c *chip8.Context) {
+	// 0x2d4: 0xa2f2 LD I, 0x2f2
+	*c.I = 0x2f2
+	// 0x2d6: 0xfe33 LD B, Ve
+	c.Exec(0x2d6)
+	*c.PC = 0x2d8
+}
+
+func block2d8(c *chip8.Context) {
+	// 0x2d8: 0xf265 LD V2, [I]
+	c.Exec(0x2d8)
+	// 0x2da: 0xf129 LD F, V1
+	*c.I = uint16(c.V[0x1] * 5)
+	// 0x2dc: 0x6414 LD V4, 0x14
+	c.V[0x4] = 0x14
+	// 0x2de: 0x6500 LD V5, 0x00
+	c.V[0x5] = 0x00
+	// 0x2e0: 0xd455 DRW V4, V5, 0x5
+	c.Exec(0x2e0)
+	// 0x2e2: 0x7415 ADD V4, 0x15
+	c.V[0x4] += 0x15
+	// 0x2e4: 0xf229 LD F, V2
+	*c.I = uint16(c.V[0x2] * 5)
+	// 0x2e6: 0xd455 DRW V4, V5, 0x5
+	c.Exec(0x2e6)
+	*c.PC = 0x2e8
+}
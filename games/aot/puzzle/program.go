@@ -0,0 +1,391 @@
+// Code generated by go-chip8 recompile from PUZZLE; DO NOT EDIT.
+
+package puzzle
+
+import "github.com/morinokami/go-chip8/chip8"
+
+var Program = &chip8.Program{
+	ROM: "e5582b76ad9d9b37a8b55e5456c7d9de1d04159e3eb05d4449f117abb8eba080",
+	Blocks: map[uint16]*chip8.Block{
+		0x200: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block200},
+		0x20a: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.LDFVx, chip8.SEVxByte}, Run: block20a},
+		0x212: {Insts: []chip8.Instruction{chip8.DRW}, Run: block212},
+		0x214: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.ADDVxByte, chip8.SEVxByte}, Run: block214},
+		0x21a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block21a},
+		0x21c: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte, chip8.LDVxByte, chip8.ADDVxByte}, Run: block21c},
+		0x224: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.LDIVx}, Run: block224},
+		0x22a: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SEVxByte}, Run: block22a},
+		0x22e: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block22e},
+		0x230: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block230},
+		0x238: {Insts: []chip8.Instruction{chip8.RND, chip8.ADDVxByte}, Run: block238},
+		0x23e: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SEVxByte}, Run: block23e},
+		0x242: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block242},
+		0x244: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte}, Run: block244},
+		0x24c: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.ADDVxByte, chip8.JPAddr}, Run: block24c},
+		0x252: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxVy, chip8.LDVxVy, chip8.SEVxByte}, Run: block252},
+		0x25a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block25a},
+		0x25c: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block25c},
+		0x25e: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block25e},
+		0x260: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.ADDVxByte}, Run: block260},
+		0x264: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block264},
+		0x266: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block266},
+		0x268: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block268},
+		0x26a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block26a},
+		0x26c: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.ADDVxByte}, Run: block26c},
+		0x270: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block270},
+		0x272: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block272},
+		0x274: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block274},
+		0x276: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block276},
+		0x278: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.ADDVxByte}, Run: block278},
+		0x27c: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block27c},
+		0x27e: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block27e},
+		0x280: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block280},
+		0x282: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block282},
+		0x284: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.ADDVxByte}, Run: block284},
+		0x288: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.LDVxVy, chip8.LDVxByte, chip8.LDIAddr, chip8.ADDIVx, chip8.LDIVx}, Run: block288},
+		0x298: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxVy, chip8.LDIVx}, Run: block298},
+		0x2a0: {Insts: []chip8.Instruction{chip8.LDFVx, chip8.DRW, chip8.DRW, chip8.LDVxVy, chip8.LDVxVy, chip8.LDVxVy}, Run: block2a0},
+	},
+}
+
+func block200(c *chip8.Context) {
+	// 0x200: 0x6a12 LD Va, 0x12
+	c.V[0xa] = 0x12
+	// 0x202: 0x6b01 LD Vb, 0x01
+	c.V[0xb] = 0x01
+	// 0x204: 0x6110 LD V1, 0x10
+	c.V[0x1] = 0x10
+	// 0x206: 0x6200 LD V2, 0x00
+	c.V[0x2] = 0x00
+	// 0x208: 0x6000 LD V0, 0x00
+	c.V[0x0] = 0x00
+	*c.PC = 0x20a
+}
+
+func block20a(c *chip8.Context) {
+	// 0x20a: 0xa2b0 LD I, 0x2b0
+	*c.I = 0x2b0
+	// 0x20c: 0xd127 DRW V1, V2, 0x7
+	c.Exec(0x20c)
+	// 0x20e: 0xf029 LD F, V0
+	*c.I = uint16(c.V[0x0] * 5)
+	// 0x210: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x214
+		return
+	}
+	*c.PC = 0x212
+}
+
+func block212(c *chip8.Context) {
+	// 0x212: 0xdab5 DRW Va, Vb, 0x5
+	c.Exec(0x212)
+	*c.PC = 0x214
+}
+
+func block214(c *chip8.Context) {
+	// 0x214: 0x7108 ADD V1, 0x08
+	c.V[0x1] += 0x08
+	// 0x216: 0x7a08 ADD Va, 0x08
+	c.V[0xa] += 0x08
+	// 0x218: 0x3130 SE V1, 0x30
+	if c.V[0x1] == 0x30 {
+		*c.PC = 0x21c
+		return
+	}
+	*c.PC = 0x21a
+}
+
+func block21a(c *chip8.Context) {
+	// 0x21a: 0x1224 JP 0x224
+	*c.PC = 0x224
+}
+
+func block21c(c *chip8.Context) {
+	// 0x21c: 0x6110 LD V1, 0x10
+	c.V[0x1] = 0x10
+	// 0x21e: 0x7208 ADD V2, 0x08
+	c.V[0x2] += 0x08
+	// 0x220: 0x6a12 LD Va, 0x12
+	c.V[0xa] = 0x12
+	// 0x222: 0x7b08 ADD Vb, 0x08
+	c.V[0xb] += 0x08
+	*c.PC = 0x224
+}
+
+func block224(c *chip8.Context) {
+	// 0x224: 0xa300 LD I, 0x300
+	*c.I = 0x300
+	// 0x226: 0xf01e ADD I, V0
+	*c.I += uint16(c.V[0x0])
+	// 0x228: 0xf055 LD [I], V0
+	c.Exec(0x228)
+	*c.PC = 0x22a
+}
+
+func block22a(c *chip8.Context) {
+	// 0x22a: 0x7001 ADD V0, 0x01
+	c.V[0x0] += 0x01
+	// 0x22c: 0x3010 SE V0, 0x10
+	if c.V[0x0] == 0x10 {
+		*c.PC = 0x230
+		return
+	}
+	*c.PC = 0x22e
+}
+
+func block22e(c *chip8.Context) {
+	// 0x22e: 0x120a JP 0x20a
+	*c.PC = 0x20a
+}
+
+func block230(c *chip8.Context) {
+	// 0x230: 0x6a12 LD Va, 0x12
+	c.V[0xa] = 0x12
+	// 0x232: 0x6b01 LD Vb, 0x01
+	c.V[0xb] = 0x01
+	// 0x234: 0x6c00 LD Vc, 0x00
+	c.V[0xc] = 0x00
+	// 0x236: 0x62ff LD V2, 0xff
+	c.V[0x2] = 0xff
+	*c.PC = 0x238
+}
+
+func block238(c *chip8.Context) {
+	// 0x238: 0xc006 RND V0, byte
+	c.Exec(0x238)
+	// 0x23a: 0x7002 ADD V0, 0x02
+	c.V[0x0] += 0x02
+	*c.PC = 0x23c
+}
+
+func block23e(c *chip8.Context) {
+	// 0x23e: 0x72ff ADD V2, 0xff
+	c.V[0x2] += 0xff
+	// 0x240: 0x3200 SE V2, 0x00
+	if c.V[0x2] == 0x00 {
+		*c.PC = 0x244
+		return
+	}
+	*c.PC = 0x242
+}
+
+func block242(c *chip8.Context) {
+	// 0x242: 0x1238 JP 0x238
+	*c.PC = 0x238
+}
+
+func block244(c *chip8.Context) {
+	// 0x244: 0x6e00 LD Ve, 0x00
+	c.V[0xe] = 0x00
+	// 0x246: 0x6e00 LD Ve, 0x00
+	c.V[0xe] = 0x00
+	*c.PC = 0x248
+}
+
+func block24c(c *chip8.Context) {
+	// 0x24c: 0x7e01 ADD Ve, 0x01
+	c.V[0xe] += 0x01
+	// 0x24e: 0x7e01 ADD Ve, 0x01
+	c.V[0xe] += 0x01
+	// 0x250: 0x1248 JP 0x248
+	*c.PC = 0x248
+}
+
+func block252(c *chip8.Context) {
+	// 0x252: 0x84a0 LD V4, Va
+	c.V[0x4] = c.V[0xa]
+	// 0x254: 0x85b0 LD V5, Vb
+	c.V[0x5] = c.V[0xb]
+	// 0x256: 0x86c0 LD V6, Vc
+	c.V[0x6] = c.V[0xc]
+	// 0x258: 0x3002 SE V0, 0x02
+	if c.V[0x0] == 0x02 {
+		*c.PC = 0x25c
+		return
+	}
+	*c.PC = 0x25a
+}
+
+func block25a(c *chip8.Context) {
+	// 0x25a: 0x1264 JP 0x264
+	*c.PC = 0x264
+}
+
+func block25c(c *chip8.Context) {
+	// 0x25c: 0x4501 SNE V5, 0x01
+	if c.V[0x5] != 0x01 {
+		*c.PC = 0x260
+		return
+	}
+	*c.PC = 0x25e
+}
+
+func block25e(c *chip8.Context) {
+	// 0x25e: 0x1264 JP 0x264
+	*c.PC = 0x264
+}
+
+func block260(c *chip8.Context) {
+	// 0x260: 0x75f8 ADD V5, 0xf8
+	c.V[0x5] += 0xf8
+	// 0x262: 0x76fc ADD V6, 0xfc
+	c.V[0x6] += 0xfc
+	*c.PC = 0x264
+}
+
+func block264(c *chip8.Context) {
+	// 0x264: 0x3008 SE V0, 0x08
+	if c.V[0x0] == 0x08 {
+		*c.PC = 0x268
+		return
+	}
+	*c.PC = 0x266
+}
+
+func block266(c *chip8.Context) {
+	// 0x266: 0x1270 JP 0x270
+	*c.PC = 0x270
+}
+
+func block268(c *chip8.Context) {
+	// 0x268: 0x4519 SNE V5, 0x19
+	if c.V[0x5] != 0x19 {
+		*c.PC = 0x26c
+		return
+	}
+	*c.PC = 0x26a
+}
+
+func block26a(c *chip8.Context) {
+	// 0x26a: 0x1270 JP 0x270
+	*c.PC = 0x270
+}
+
+func block26c(c *chip8.Context) {
+	// 0x26c: 0x7508 ADD V5, 0x08
+	c.V[0x5] += 0x08
+	// 0x26e: 0x7604 ADD V6, 0x04
+	c.V[0x6] += 0x04
+	*c.PC = 0x270
+}
+
+func block270(c *chip8.Context) {
+	// 0x270: 0x3006 SE V0, 0x06
+	if c.V[0x0] == 0x06 {
+		*c.PC = 0x274
+		return
+	}
+	*c.PC = 0x272
+}
+
+func block272(c *chip8.Context) {
+	// 0x272: 0x127c JP 0x27c
+	*c.PC = 0x27c
+}
+
+func block274(c *chip8.Context) {
+	// 0x274: 0x4412 SNE V4, 0x12
+	if c.V[0x4] != 0x12 {
+		*c.PC = 0x278
+		return
+	}
+	*c.PC = 0x276
+}
+
+func block276(c *chip8.Context) {
+	// 0x276: 0x127c JP 0x27c
+	*c.PC = 0x27c
+}
+
+func block278(c *chip8.Context) {
+	// 0x278: 0x74f8 ADD V4, 0xf8
+	c.V[0x4] += 0xf8
+	// 0x27a: 0x76ff ADD V6, 0xff
+	c.V[0x6] += 0xff
+	*c.PC = 0x27c
+}
+
+func block27c(c *chip8.Context) {
+	// 0x27c: 0x3004 SE V0, 0x04
+	if c.V[0x0] == 0x04 {
+		*c.PC = 0x280
+		return
+	}
+	*c.PC = 0x27e
+}
+
+func block27e(c *chip8.Context) {
+	// 0x27e: 0x1288 JP 0x288
+	*c.PC = 0x288
+}
+
+func block280(c *chip8.Context) {
+	// 0x280: 0x442a SNE V4, 0x2a
+	if c.V[0x4] != 0x2a {
+		*c.PC = 0x284
+		return
+	}
+	*c.PC = 0x282
+}
+
+func block282(c *chip8.Context) {
+	// 0x282: 0x1288 JP 0x288
+	*c.PC = 0x288
+}
+
+func block284(c *chip8.Context) {
+	// 0x284: 0x7408 ADD V4, 0x08
+	c.V[0x4] += 0x08
+	// 0x286: 0x7601 ADD V6, 0x01
+	c.V[0x6] += 0x01
+	*c.PC = 0x288
+}
+
+func block288(c *chip8.Context) {
+	// 0x288: 0xa300 LD I, 0x300
+	*c.I = 0x300
+	// 0x28a: 0xf61e ADD I, V6
+	*c.I += uint16(c.V[0x6])
+	// 0x28c: 0xf065 LD V0, [I]
+	c.Exec(0x28c)
+	// 0x28e: 0x8100 LD V1, V0
+	c.V[0x1] = c.V[0x0]
+	// 0x290: 0x6000 LD V0, 0x00
+	c.V[0x0] = 0x00
+	// 0x292: 0xa300 LD I, 0x300
+	*c.I = 0x300
+	// 0x294: 0xf61e ADD I, V6
+	*c.I += uint16(c.V[0x6])
+	// 0x296: 0xf055 LD [I], V0
+	c.Exec(0x296)
+	*c.PC = 0x298
+}
+
+func block298(c *chip8.Context) {
+	// 0x298: 0xa300 LD I, 0x300
+	*c.I = 0x300
+	// 0x29a: 0xfc1e ADD I, Vc
+	*c.I += uint16(c.V[0xc])
+	// 0x29c: 0x8010 LD V0, V1
+	c.V[0x0] = c.V[0x1]
+	// 0x29e: 0xf055 LD [I], V0
+	c.Exec(0x29e)
+	*c.PC = 0x2a0
+}
+
+func block2a0(c *chip8.Context) {
+	// 0x2a0: 0xf129 LD F, V1
+	*c.I = uint16(c.V[0x1] * 5)
+	// 0x2a2: 0xd455 DRW V4, V5, 0x5
+	c.Exec(0x2a2)
+	// 0x2a4: 0xdab5 DRW Va, Vb, 0x5
+	c.Exec(0x2a4)
+	// 0x2a6: 0x8a40 LD Va, V4
+	c.V[0xa] = c.V[0x4]
+	// 0x2a8: 0x8b50 LD Vb, V5
+	c.V[0xb] = c.V[0x5]
+	// 0x2aa: 0x8c60 LD Vc, V6
+	c.V[0xc] = c.V[0x6]
+	*c.PC = 0x2ac
+}
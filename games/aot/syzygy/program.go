@@ -0,0 +1,1602 @@
+// Code generated by go-chip8 recompile from SYZYGY; DO NOT EDIT.
+
+package syzygy
+
+import "github.com/morinokami/go-chip8/chip8"
+
+var Program = &chip8.Program{
+	ROM: "8e09b5a0181774546bb6b21b7bc02461cabf1f57670be30d4d7ec207a6d480f3",
+	Blocks: map[uint16]*chip8.Block{
+		0x200: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block200},
+		0x216: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block216},
+		0x21a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block21a},
+		0x21c: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block21c},
+		0x220: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block220},
+		0x222: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block222},
+		0x226: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block226},
+		0x228: {Insts: []chip8.Instruction{chip8.CLS, chip8.JPAddr}, Run: block228},
+		0x22c: {Insts: []chip8.Instruction{chip8.RND, chip8.ADDVxByte, chip8.RND, chip8.ADDVxByte, chip8.RND, chip8.LDVxVy, chip8.LDVxVy, chip8.LDVxVy, chip8.LDVxVy, chip8.SNEVxByte}, Run: block22c},
+		0x240: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block240},
+		0x242: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block242},
+		0x244: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block244},
+		0x246: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block246},
+		0x248: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block248},
+		0x24a: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block24a},
+		0x24c: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block24c},
+		0x24e: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.DRW, chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxVy, chip8.LDIVx}, Run: block24e},
+		0x260: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxByte, chip8.LDIVx}, Run: block260},
+		0x26c: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte}, Run: block26c},
+		0x270: {Insts: []chip8.Instruction{chip8.LDVxDT, chip8.SEVxByte}, Run: block270},
+		0x274: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block274},
+		0x276: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block276},
+		0x278: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block278},
+		0x27a: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDFVx, chip8.DRW, chip8.SEVxByte}, Run: block27a},
+		0x282: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block282},
+		0x284: {Insts: []chip8.Instruction{chip8.DRW}, Run: block284},
+		0x288: {Insts: []chip8.Instruction{chip8.LDDTVx, chip8.JPAddr}, Run: block288},
+		0x28c: {Insts: []chip8.Instruction{chip8.LDDTVx, chip8.LDVxByte, chip8.LDVxByte, chip8.JPAddr}, Run: block28c},
+		0x294: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDFVx, chip8.DRW}, Run: block294},
+		0x29c: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block29c},
+		0x2a0: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2a0},
+		0x2a2: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block2a2},
+		0x2a6: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2a6},
+		0x2a8: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block2a8},
+		0x2ac: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2ac},
+		0x2ae: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block2ae},
+		0x2b2: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2b2},
+		0x2b4: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2b4},
+		0x2b6: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block2b6},
+		0x2b8: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2b8},
+		0x2ba: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block2ba},
+		0x2bc: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2bc},
+		0x2be: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block2be},
+		0x2c0: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2c0},
+		0x2c2: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block2c2},
+		0x2c4: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.SEVxByte}, Run: block2c4},
+		0x2ca: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2ca},
+		0x2cc: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block2cc},
+		0x2ce: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2ce},
+		0x2d0: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.LDVxByte, chip8.AND, chip8.LDVxVy, chip8.SUBN, chip8.SEVxByte}, Run: block2d0},
+		0x2de: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2de},
+		0x2e0: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.ADDVxByte, chip8.SUB, chip8.SEVxByte}, Run: block2e0},
+		0x2e8: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2e8},
+		0x2ea: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.SUBN, chip8.SEVxByte}, Run: block2ea},
+		0x2f0: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2f0},
+		0x2f2: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.ADDVxByte, chip8.SUB, chip8.SEVxByte}, Run: block2f2},
+		0x2fa: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2fa},
+		0x2fc: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDSTVx, chip8.RND, chip8.ADDVxByte, chip8.ADDVxVy, chip8.LDIAddr, chip8.DRW, chip8.LDVxByte, chip8.LDFVx, chip8.DRW}, Run: block2fc},
+		0x31a: {Insts: []chip8.Instruction{chip8.LDVxDT, chip8.SEVxByte}, Run: block31a},
+		0x31e: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block31e},
+		0x320: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW}, Run: block320},
+		0x324: {Insts: []chip8.Instruction{chip8.SNEVxVy}, Run: block324},
+		0x326: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block326},
+		0x328: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxVy, chip8.LDIVx}, Run: block328},
+		0x332: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxByte, chip8.LDIVx}, Run: block332},
+		0x33c: {Insts: []chip8.Instruction{chip8.LDVxVy}, Run: block33c},
+		0x33e: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.ADDVxByte, chip8.LDIVx}, Run: block33e},
+		0x348: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block348},
+		0x34a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block34a},
+		0x34c: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block34c},
+		0x34e: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SEVxByte}, Run: block34e},
+		0x352: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block352},
+		0x354: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.JPAddr}, Run: block354},
+		0x358: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.SNEVxByte}, Run: block358},
+		0x35e: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block35e},
+		0x360: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block360},
+		0x362: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block362},
+		0x364: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block364},
+		0x366: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block366},
+		0x368: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block368},
+		0x36a: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block36a},
+		0x36c: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.ADDVxByte, chip8.LDIVx}, Run: block36c},
+		0x376: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block376},
+		0x378: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block378},
+		0x37a: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.LDVxVy, chip8.ADDVxByte, chip8.JPAddr}, Run: block37a},
+		0x388: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDSTVx, chip8.LDVxByte}, Run: block388},
+		0x38e: {Insts: []chip8.Instruction{chip8.SKP}, Run: block38e},
+		0x390: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block390},
+		0x392: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block392},
+		0x398: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SEVxByte}, Run: block398},
+		0x39c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block39c},
+		0x39e: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte, chip8.SEVxByte}, Run: block39e},
+		0x3a4: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block3a4},
+		0x3a6: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte}, Run: block3a6},
+		0x3aa: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.SNEVxByte}, Run: block3aa},
+		0x3b0: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block3b0},
+		0x3b2: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block3b2},
+		0x3b4: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block3b4},
+		0x3b6: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block3b6},
+		0x3b8: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block3b8},
+		0x3ba: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block3ba},
+		0x3bc: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block3bc},
+		0x3be: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.ADDVxByte, chip8.LDIVx}, Run: block3be},
+		0x3c8: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block3c8},
+		0x3ca: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block3ca},
+		0x3cc: {Insts: []chip8.Instruction{chip8.SNEVxVy}, Run: block3cc},
+		0x3ce: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block3ce},
+		0x3d0: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.LDVxVy, chip8.ADDVxByte, chip8.JPAddr}, Run: block3d0},
+		0x3de: {Insts: []chip8.Instruction{chip8.CLS, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr, chip8.DRW, chip8.DRW, chip8.ADDVxByte, chip8.LDIAddr}, Run: block3de},
+		0x412: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxI, chip8.SNEVxVy}, Run: block412},
+		0x418: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block418},
+		0x41a: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.SUB, chip8.SEVxByte}, Run: block41a},
+		0x420: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block420},
+		0x422: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block422},
+		0x424: {Insts: []chip8.Instruction{chip8.SNEVxVy}, Run: block424},
+		0x426: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block426},
+		0x428: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.SUB, chip8.SEVxByte}, Run: block428},
+		0x42e: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block42e},
+		0x430: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block430},
+		0x432: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.SUB, chip8.SEVxByte}, Run: block432},
+		0x438: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block438},
+		0x43a: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxVy, chip8.LDVxVy, chip8.LDVxVy, chip8.LDIVx}, Run: block43a},
+		0x444: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxI, chip8.LDVxByte, chip8.ADDVxByte, chip8.LDVxVy, chip8.LDVxVy, chip8.LDVxVy, chip8.LDIAddr}, Run: block444},
+		0x456: {Insts: []chip8.Instruction{chip8.RND, chip8.RND, chip8.LDVxByte, chip8.SUB, chip8.SEVxByte}, Run: block456},
+		0x460: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block460},
+		0x462: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SUBN, chip8.SEVxByte}, Run: block462},
+		0x468: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block468},
+		0x46a: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SUB, chip8.SEVxByte}, Run: block46a},
+		0x470: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block470},
+		0x472: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SUBN, chip8.SEVxByte}, Run: block472},
+		0x478: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block478},
+		0x47a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block47a},
+		0x47c: {Insts: []chip8.Instruction{chip8.RND, chip8.LDFVx, chip8.DRW}, Run: block47c},
+		0x482: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block482},
+		0x486: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block486},
+		0x488: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block488},
+		0x48c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block48c},
+		0x48e: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block48e},
+		0x490: {Insts: []chip8.Instruction{chip8.CLS}, Run: block490},
+		0x494: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block494},
+		0x496: {Insts: []chip8.Instruction{chip8.CLS, chip8.JPAddr}, Run: block496},
+		0x49a: {Insts: []chip8.Instruction{chip8.DRW, chip8.LDIAddr, chip8.ADDVxByte, chip8.DRW, chip8.LDFVx, chip8.ADDVxByte, chip8.DRW, chip8.LDFVx, chip8.ADDVxByte, chip8.DRW}, Run: block49a},
+		0x4b6: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block4b6},
+		0x4be: {Insts: []chip8.Instruction{chip8.DRW, chip8.DRW, chip8.ADDVxByte, chip8.SEVxByte}, Run: block4be},
+		0x4c6: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block4c6},
+		0x4c8: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxByte, chip8.LDVxByte, chip8.DRW, chip8.DRW, chip8.ADDVxByte, chip8.DRW, chip8.DRW}, Run: block4c8},
+		0x4da: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr, chip8.DRW, chip8.LDIAddr, chip8.ADDVxByte, chip8.DRW, chip8.LDIAddr, chip8.ADDVxByte, chip8.DRW}, Run: block4da},
+		0x522: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block522},
+		0x524: {Insts: []chip8.Instruction{chip8.RND, chip8.LDVxVy, chip8.ADDVxVy, chip8.SNEVxByte}, Run: block524},
+		0x52c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block52c},
+		0x52e: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.LDVxByte}, Run: block52e},
+		0x532: {Insts: []chip8.Instruction{chip8.RND, chip8.LDVxVy, chip8.ADDVxVy, chip8.SNEVxByte}, Run: block532},
+		0x53a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block53a},
+		0x53c: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.LDVxByte, chip8.RND, chip8.ADDVxByte, chip8.LDDTVx, chip8.RND, chip8.ADDVxByte}, Run: block53c},
+	},
+}
+
+func block200(c *chip8.Context) {
+	// 0x200: 0x1212 JP 0x212
+	*c.PC = 0x212
+}
+
+func block216(c *chip8.Context) {
+	// 0x216: 0x600f LD V0, 0x0f
+	c.V[0x0] = 0x0f
+	// 0x218: 0xe0a1 SKNP V0
+	if !c.Pressed(c.V[0x0]) {
+		*c.PC = 0x21c
+		return
+	}
+	*c.PC = 0x21a
+}
+
+func block21a(c *chip8.Context) {
+	// 0x21a: 0x1224 JP 0x224
+	*c.PC = 0x224
+}
+
+func block21c(c *chip8.Context) {
+	// 0x21c: 0x600e LD V0, 0x0e
+	c.V[0x0] = 0x0e
+	// 0x21e: 0xe0a1 SKNP V0
+	if !c.Pressed(c.V[0x0]) {
+		*c.PC = 0x222
+		return
+	}
+	*c.PC = 0x220
+}
+
+func block220(c *chip8.Context) {
+	// 0x220: 0x1228 JP 0x228
+	*c.PC = 0x228
+}
+
+func block222(c *chip8.Context) {
+	// 0x222: 0x1216 JP 0x216
+	*c.PC = 0x216
+}
+
+func block226(c *chip8.Context) {
+	// 0x226: 0x122c JP 0x22c
+	*c.PC = 0x22c
+}
+
+func block228(c *chip8.Context) {
+	// 0x228: 0x00e0 CLS
+	c.Exec(0x228)
+	// 0x22a: 0x122c JP 0x22c
+	*c.PC = 0x22c
+}
+
+func block22c(c *chip8.Context) {
+	// 0x22c: 0xc11f RND V1, byte
+	c.Exec(0x22c)
+	// 0x22e: 0x7110 ADD V1, 0x10
+	c.V[0x1] += 0x10
+	// 0x230: 0xc20f RND V2, byte
+	c.Exec(0x230)
+	// 0x232: 0x7208 ADD V2, 0x08
+	c.V[0x2] += 0x08
+	// 0x234: 0xc303 RND V3, byte
+	c.Exec(0x234)
+	// 0x236: 0x8530 LD V5, V3
+	c.V[0x5] = c.V[0x3]
+	// 0x238: 0x8610 LD V6, V1
+	c.V[0x6] = c.V[0x1]
+	// 0x23a: 0x8720 LD V7, V2
+	c.V[0x7] = c.V[0x2]
+	// 0x23c: 0x8830 LD V8, V3
+	c.V[0x8] = c.V[0x3]
+	// 0x23e: 0x4800 SNE V8, 0x00
+	if c.V[0x8] != 0x00 {
+		*c.PC = 0x242
+		return
+	}
+	*c.PC = 0x240
+}
+
+func block240(c *chip8.Context) {
+	// 0x240: 0x7701 ADD V7, 0x01
+	c.V[0x7] += 0x01
+	*c.PC = 0x242
+}
+
+func block242(c *chip8.Context) {
+	// 0x242: 0x4801 SNE V8, 0x01
+	if c.V[0x8] != 0x01 {
+		*c.PC = 0x246
+		return
+	}
+	*c.PC = 0x244
+}
+
+func block244(c *chip8.Context) {
+	// 0x244: 0x77ff ADD V7, 0xff
+	c.V[0x7] += 0xff
+	*c.PC = 0x246
+}
+
+func block246(c *chip8.Context) {
+	// 0x246: 0x4802 SNE V8, 0x02
+	if c.V[0x8] != 0x02 {
+		*c.PC = 0x24a
+		return
+	}
+	*c.PC = 0x248
+}
+
+func block248(c *chip8.Context) {
+	// 0x248: 0x7601 ADD V6, 0x01
+	c.V[0x6] += 0x01
+	*c.PC = 0x24a
+}
+
+func block24a(c *chip8.Context) {
+	// 0x24a: 0x4803 SNE V8, 0x03
+	if c.V[0x8] != 0x03 {
+		*c.PC = 0x24e
+		return
+	}
+	*c.PC = 0x24c
+}
+
+func block24c(c *chip8.Context) {
+	// 0x24c: 0x76ff ADD V6, 0xff
+	c.V[0x6] += 0xff
+	*c.PC = 0x24e
+}
+
+func block24e(c *chip8.Context) {
+	// 0x24e: 0xa54c LD I, 0x54c
+	*c.I = 0x54c
+	// 0x250: 0xd121 DRW V1, V2, 0x1
+	c.Exec(0x250)
+	// 0x252: 0xd671 DRW V6, V7, 0x1
+	c.Exec(0x252)
+	// 0x254: 0x64f0 LD V4, 0xf0
+	c.V[0x4] = 0xf0
+	// 0x256: 0x69f1 LD V9, 0xf1
+	c.V[0x9] = 0xf1
+	// 0x258: 0xa800 LD I, 0x800
+	*c.I = 0x800
+	// 0x25a: 0xf41e ADD I, V4
+	*c.I += uint16(c.V[0x4])
+	// 0x25c: 0x8030 LD V0, V3
+	c.V[0x0] = c.V[0x3]
+	// 0x25e: 0xf055 LD [I], V0
+	c.Exec(0x25e)
+	*c.PC = 0x260
+}
+
+func block260(c *chip8.Context) {
+	// 0x260: 0x7401 ADD V4, 0x01
+	c.V[0x4] += 0x01
+	// 0x262: 0xa800 LD I, 0x800
+	*c.I = 0x800
+	// 0x264: 0xf41e ADD I, V4
+	*c.I += uint16(c.V[0x4])
+	// 0x266: 0x6001 LD V0, 0x01
+	c.V[0x0] = 0x01
+	// 0x268: 0xf055 LD [I], V0
+	c.Exec(0x268)
+	*c.PC = 0x26a
+}
+
+func block26c(c *chip8.Context) {
+	// 0x26c: 0x6a00 LD Va, 0x00
+	c.V[0xa] = 0x00
+	// 0x26e: 0x7a00 ADD Va, 0x00
+	c.V[0xa] += 0x00
+	*c.PC = 0x270
+}
+
+func block270(c *chip8.Context) {
+	// 0x270: 0xf007 LD V0, DT
+	c.V[0x0] = *c.DT
+	// 0x272: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x276
+		return
+	}
+	*c.PC = 0x274
+}
+
+func block274(c *chip8.Context) {
+	// 0x274: 0x129c JP 0x29c
+	*c.PC = 0x29c
+}
+
+func block276(c *chip8.Context) {
+	// 0x276: 0x3d00 SE Vd, 0x00
+	if c.V[0xd] == 0x00 {
+		*c.PC = 0x27a
+		return
+	}
+	*c.PC = 0x278
+}
+
+func block278(c *chip8.Context) {
+	// 0x278: 0x1294 JP 0x294
+	*c.PC = 0x294
+}
+
+func block27a(c *chip8.Context) {
+	// 0x27a: 0x6000 LD V0, 0x00
+	c.V[0x0] = 0x00
+	// 0x27c: 0xf029 LD F, V0
+	*c.I = uint16(c.V[0x0] * 5)
+	// 0x27e: 0xdbc5 DRW Vb, Vc, 0x5
+	c.Exec(0x27e)
+	// 0x280: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x284
+		return
+	}
+	*c.PC = 0x282
+}
+
+func block282(c *chip8.Context) {
+	// 0x282: 0x128c JP 0x28c
+	*c.PC = 0x28c
+}
+
+func block284(c *chip8.Context) {
+	// 0x284: 0xdbc5 DRW Vb, Vc, 0x5
+	c.Exec(0x284)
+	*c.PC = 0x286
+}
+
+func block288(c *chip8.Context) {
+	// 0x288: 0xf015 LD DT, V0
+	*c.DT = c.V[0x0]
+	// 0x28a: 0x129c JP 0x29c
+	*c.PC = 0x29c
+}
+
+func block28c(c *chip8.Context) {
+	// 0x28c: 0xfe15 LD DT, Ve
+	*c.DT = c.V[0xe]
+	// 0x28e: 0x6d01 LD Vd, 0x01
+	c.V[0xd] = 0x01
+	// 0x290: 0x6e00 LD Ve, 0x00
+	c.V[0xe] = 0x00
+	// 0x292: 0x129c JP 0x29c
+	*c.PC = 0x29c
+}
+
+func block294(c *chip8.Context) {
+	// 0x294: 0x80e0 LD V0, Ve
+	c.V[0x0] = c.V[0xe]
+	// 0x296: 0xf029 LD F, V0
+	*c.I = uint16(c.V[0x0] * 5)
+	// 0x298: 0xdbc5 DRW Vb, Vc, 0x5
+	c.Exec(0x298)
+	*c.PC = 0x29a
+}
+
+func block29c(c *chip8.Context) {
+	// 0x29c: 0x6003 LD V0, 0x03
+	c.V[0x0] = 0x03
+	// 0x29e: 0xe0a1 SKNP V0
+	if !c.Pressed(c.V[0x0]) {
+		*c.PC = 0x2a2
+		return
+	}
+	*c.PC = 0x2a0
+}
+
+func block2a0(c *chip8.Context) {
+	// 0x2a0: 0x6300 LD V3, 0x00
+	c.V[0x3] = 0x00
+	*c.PC = 0x2a2
+}
+
+func block2a2(c *chip8.Context) {
+	// 0x2a2: 0x6006 LD V0, 0x06
+	c.V[0x0] = 0x06
+	// 0x2a4: 0xe0a1 SKNP V0
+	if !c.Pressed(c.V[0x0]) {
+		*c.PC = 0x2a8
+		return
+	}
+	*c.PC = 0x2a6
+}
+
+func block2a6(c *chip8.Context) {
+	// 0x2a6: 0x6301 LD V3, 0x01
+	c.V[0x3] = 0x01
+	*c.PC = 0x2a8
+}
+
+func block2a8(c *chip8.Context) {
+	// 0x2a8: 0x6007 LD V0, 0x07
+	c.V[0x0] = 0x07
+	// 0x2aa: 0xe0a1 SKNP V0
+	if !c.Pressed(c.V[0x0]) {
+		*c.PC = 0x2ae
+		return
+	}
+	*c.PC = 0x2ac
+}
+
+func block2ac(c *chip8.Context) {
+	// 0x2ac: 0x6302 LD V3, 0x02
+	c.V[0x3] = 0x02
+	*c.PC = 0x2ae
+}
+
+func block2ae(c *chip8.Context) {
+	// 0x2ae: 0x6008 LD V0, 0x08
+	c.V[0x0] = 0x08
+	// 0x2b0: 0xe0a1 SKNP V0
+	if !c.Pressed(c.V[0x0]) {
+		*c.PC = 0x2b4
+		return
+	}
+	*c.PC = 0x2b2
+}
+
+func block2b2(c *chip8.Context) {
+	// 0x2b2: 0x6303 LD V3, 0x03
+	c.V[0x3] = 0x03
+	*c.PC = 0x2b4
+}
+
+func block2b4(c *chip8.Context) {
+	// 0x2b4: 0x4300 SNE V3, 0x00
+	if c.V[0x3] != 0x00 {
+		*c.PC = 0x2b8
+		return
+	}
+	*c.PC = 0x2b6
+}
+
+func block2b6(c *chip8.Context) {
+	// 0x2b6: 0x72ff ADD V2, 0xff
+	c.V[0x2] += 0xff
+	*c.PC = 0x2b8
+}
+
+func block2b8(c *chip8.Context) {
+	// 0x2b8: 0x4301 SNE V3, 0x01
+	if c.V[0x3] != 0x01 {
+		*c.PC = 0x2bc
+		return
+	}
+	*c.PC = 0x2ba
+}
+
+func block2ba(c *chip8.Context) {
+	// 0x2ba: 0x7201 ADD V2, 0x01
+	c.V[0x2] += 0x01
+	*c.PC = 0x2bc
+}
+
+func block2bc(c *chip8.Context) {
+	// 0x2bc: 0x4302 SNE V3, 0x02
+	if c.V[0x3] != 0x02 {
+		*c.PC = 0x2c0
+		return
+	}
+	*c.PC = 0x2be
+}
+
+func block2be(c *chip8.Context) {
+	// 0x2be: 0x71ff ADD V1, 0xff
+	c.V[0x1] += 0xff
+	*c.PC = 0x2c0
+}
+
+func block2c0(c *chip8.Context) {
+	// 0x2c0: 0x4303 SNE V3, 0x03
+	if c.V[0x3] != 0x03 {
+		*c.PC = 0x2c4
+		return
+	}
+	*c.PC = 0x2c2
+}
+
+func block2c2(c *chip8.Context) {
+	// 0x2c2: 0x7101 ADD V1, 0x01
+	c.V[0x1] += 0x01
+	*c.PC = 0x2c4
+}
+
+func block2c4(c *chip8.Context) {
+	// 0x2c4: 0xa54c LD I, 0x54c
+	*c.I = 0x54c
+	// 0x2c6: 0xd121 DRW V1, V2, 0x1
+	c.Exec(0x2c6)
+	// 0x2c8: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x2cc
+		return
+	}
+	*c.PC = 0x2ca
+}
+
+func block2ca(c *chip8.Context) {
+	// 0x2ca: 0x1324 JP 0x324
+	*c.PC = 0x324
+}
+
+func block2cc(c *chip8.Context) {
+	// 0x2cc: 0x3d01 SE Vd, 0x01
+	if c.V[0xd] == 0x01 {
+		*c.PC = 0x2d0
+		return
+	}
+	*c.PC = 0x2ce
+}
+
+func block2ce(c *chip8.Context) {
+	// 0x2ce: 0x1388 JP 0x388
+	*c.PC = 0x388
+}
+
+func block2d0(c *chip8.Context) {
+	// 0x2d0: 0x603f LD V0, 0x3f
+	c.V[0x0] = 0x3f
+	// 0x2d2: 0x8102 AND V1, V0
+	c.V[0x1] &= c.V[0x0]
+	// 0x2d4: 0x601f LD V0, 0x1f
+	c.V[0x0] = 0x1f
+	// 0x2d6: 0x8202 AND V2, V0
+	c.V[0x2] &= c.V[0x0]
+	// 0x2d8: 0x80b0 LD V0, Vb
+	c.V[0x0] = c.V[0xb]
+	// 0x2da: 0x8017 SUBN V0, V1
+	c.Exec(0x2da)
+	// 0x2dc: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x2e0
+		return
+	}
+	*c.PC = 0x2de
+}
+
+func block2de(c *chip8.Context) {
+	// 0x2de: 0x1388 JP 0x388
+	*c.PC = 0x388
+}
+
+func block2e0(c *chip8.Context) {
+	// 0x2e0: 0x80b0 LD V0, Vb
+	c.V[0x0] = c.V[0xb]
+	// 0x2e2: 0x7003 ADD V0, 0x03
+	c.V[0x0] += 0x03
+	// 0x2e4: 0x8015 SUB V0, V1
+	c.Exec(0x2e4)
+	// 0x2e6: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x2ea
+		return
+	}
+	*c.PC = 0x2e8
+}
+
+func block2e8(c *chip8.Context) {
+	// 0x2e8: 0x1388 JP 0x388
+	*c.PC = 0x388
+}
+
+func block2ea(c *chip8.Context) {
+	// 0x2ea: 0x80c0 LD V0, Vc
+	c.V[0x0] = c.V[0xc]
+	// 0x2ec: 0x8027 SUBN V0, V2
+	c.Exec(0x2ec)
+	// 0x2ee: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x2f2
+		return
+	}
+	*c.PC = 0x2f0
+}
+
+func block2f0(c *chip8.Context) {
+	// 0x2f0: 0x1388 JP 0x388
+	*c.PC = 0x388
+}
+
+func block2f2(c *chip8.Context) {
+	// 0x2f2: 0x80c0 LD V0, Vc
+	c.V[0x0] = c.V[0xc]
+	// 0x2f4: 0x7004 ADD V0, 0x04
+	c.V[0x0] += 0x04
+	// 0x2f6: 0x8025 SUB V0, V2
+	c.Exec(0x2f6)
+	// 0x2f8: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x2fc
+		return
+	}
+	*c.PC = 0x2fa
+}
+
+func block2fa(c *chip8.Context) {
+	// 0x2fa: 0x1388 JP 0x388
+	*c.PC = 0x388
+}
+
+func block2fc(c *chip8.Context) {
+	// 0x2fc: 0x6004 LD V0, 0x04
+	c.V[0x0] = 0x04
+	// 0x2fe: 0xf018 LD ST, V0
+	*c.ST = c.V[0x0]
+	// 0x300: 0xce07 RND Ve, byte
+	c.Exec(0x300)
+	// 0x302: 0x7e02 ADD Ve, 0x02
+	c.V[0xe] += 0x02
+	// 0x304: 0x8ae4 ADD Va, Ve
+	c.Exec(0x304)
+	// 0x306: 0xa54c LD I, 0x54c
+	*c.I = 0x54c
+	// 0x308: 0xd121 DRW V1, V2, 0x1
+	c.Exec(0x308)
+	// 0x30a: 0x6000 LD V0, 0x00
+	c.V[0x0] = 0x00
+	// 0x30c: 0xf029 LD F, V0
+	*c.I = uint16(c.V[0x0] * 5)
+	// 0x30e: 0xdbc5 DRW Vb, Vc, 0x5
+	c.Exec(0x30e)
+	*c.PC = 0x310
+}
+
+func block31a(c *chip8.Context) {
+	// 0x31a: 0xf007 LD V0, DT
+	c.V[0x0] = *c.DT
+	// 0x31c: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x320
+		return
+	}
+	*c.PC = 0x31e
+}
+
+func block31e(c *chip8.Context) {
+	// 0x31e: 0x131a JP 0x31a
+	*c.PC = 0x31a
+}
+
+func block320(c *chip8.Context) {
+	// 0x320: 0xa54c LD I, 0x54c
+	*c.I = 0x54c
+	// 0x322: 0xd121 DRW V1, V2, 0x1
+	c.Exec(0x322)
+	*c.PC = 0x324
+}
+
+func block324(c *chip8.Context) {
+	// 0x324: 0x9350 SNE V3, V5
+	if c.V[0x3] != c.V[0x5] {
+		*c.PC = 0x328
+		return
+	}
+	*c.PC = 0x326
+}
+
+func block326(c *chip8.Context) {
+	// 0x326: 0x133e JP 0x33e
+	*c.PC = 0x33e
+}
+
+func block328(c *chip8.Context) {
+	// 0x328: 0x7401 ADD V4, 0x01
+	c.V[0x4] += 0x01
+	// 0x32a: 0xa800 LD I, 0x800
+	*c.I = 0x800
+	// 0x32c: 0xf41e ADD I, V4
+	*c.I += uint16(c.V[0x4])
+	// 0x32e: 0x8030 LD V0, V3
+	c.V[0x0] = c.V[0x3]
+	// 0x330: 0xf055 LD [I], V0
+	c.Exec(0x330)
+	*c.PC = 0x332
+}
+
+func block332(c *chip8.Context) {
+	// 0x332: 0x7401 ADD V4, 0x01
+	c.V[0x4] += 0x01
+	// 0x334: 0xa800 LD I, 0x800
+	*c.I = 0x800
+	// 0x336: 0xf41e ADD I, V4
+	*c.I += uint16(c.V[0x4])
+	// 0x338: 0x6000 LD V0, 0x00
+	c.V[0x0] = 0x00
+	// 0x33a: 0xf055 LD [I], V0
+	c.Exec(0x33a)
+	*c.PC = 0x33c
+}
+
+func block33c(c *chip8.Context) {
+	// 0x33c: 0x8530 LD V5, V3
+	c.V[0x5] = c.V[0x3]
+	*c.PC = 0x33e
+}
+
+func block33e(c *chip8.Context) {
+	// 0x33e: 0xa800 LD I, 0x800
+	*c.I = 0x800
+	// 0x340: 0xf41e ADD I, V4
+	*c.I += uint16(c.V[0x4])
+	// 0x342: 0xf065 LD V0, [I]
+	c.Exec(0x342)
+	// 0x344: 0x7001 ADD V0, 0x01
+	c.V[0x0] += 0x01
+	// 0x346: 0xf055 LD [I], V0
+	c.Exec(0x346)
+	*c.PC = 0x348
+}
+
+func block348(c *chip8.Context) {
+	// 0x348: 0x4a00 SNE Va, 0x00
+	if c.V[0xa] != 0x00 {
+		*c.PC = 0x34c
+		return
+	}
+	*c.PC = 0x34a
+}
+
+func block34a(c *chip8.Context) {
+	// 0x34a: 0x1358 JP 0x358
+	*c.PC = 0x358
+}
+
+func block34c(c *chip8.Context) {
+	// 0x34c: 0x600c LD V0, 0x0c
+	c.V[0x0] = 0x0c
+	*c.PC = 0x34e
+}
+
+func block34e(c *chip8.Context) {
+	// 0x34e: 0x70ff ADD V0, 0xff
+	c.V[0x0] += 0xff
+	// 0x350: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x354
+		return
+	}
+	*c.PC = 0x352
+}
+
+func block352(c *chip8.Context) {
+	// 0x352: 0x134e JP 0x34e
+	*c.PC = 0x34e
+}
+
+func block354(c *chip8.Context) {
+	// 0x354: 0x7aff ADD Va, 0xff
+	c.V[0xa] += 0xff
+	// 0x356: 0x1270 JP 0x270
+	*c.PC = 0x270
+}
+
+func block358(c *chip8.Context) {
+	// 0x358: 0xa54c LD I, 0x54c
+	*c.I = 0x54c
+	// 0x35a: 0xd671 DRW V6, V7, 0x1
+	c.Exec(0x35a)
+	// 0x35c: 0x4800 SNE V8, 0x00
+	if c.V[0x8] != 0x00 {
+		*c.PC = 0x360
+		return
+	}
+	*c.PC = 0x35e
+}
+
+func block35e(c *chip8.Context) {
+	// 0x35e: 0x77ff ADD V7, 0xff
+	c.V[0x7] += 0xff
+	*c.PC = 0x360
+}
+
+func block360(c *chip8.Context) {
+	// 0x360: 0x4801 SNE V8, 0x01
+	if c.V[0x8] != 0x01 {
+		*c.PC = 0x364
+		return
+	}
+	*c.PC = 0x362
+}
+
+func block362(c *chip8.Context) {
+	// 0x362: 0x7701 ADD V7, 0x01
+	c.V[0x7] += 0x01
+	*c.PC = 0x364
+}
+
+func block364(c *chip8.Context) {
+	// 0x364: 0x4802 SNE V8, 0x02
+	if c.V[0x8] != 0x02 {
+		*c.PC = 0x368
+		return
+	}
+	*c.PC = 0x366
+}
+
+func block366(c *chip8.Context) {
+	// 0x366: 0x76ff ADD V6, 0xff
+	c.V[0x6] += 0xff
+	*c.PC = 0x368
+}
+
+func block368(c *chip8.Context) {
+	// 0x368: 0x4803 SNE V8, 0x03
+	if c.V[0x8] != 0x03 {
+		*c.PC = 0x36c
+		return
+	}
+	*c.PC = 0x36a
+}
+
+func block36a(c *chip8.Context) {
+	// 0x36a: 0x7601 ADD V6, 0x01
+	c.V[0x6] += 0x01
+	*c.PC = 0x36c
+}
+
+func block36c(c *chip8.Context) {
+	// 0x36c: 0xa800 LD I, 0x800
+	*c.I = 0x800
+	// 0x36e: 0xf91e ADD I, V9
+	*c.I += uint16(c.V[0x9])
+	// 0x370: 0xf065 LD V0, [I]
+	c.Exec(0x370)
+	// 0x372: 0x70ff ADD V0, 0xff
+	c.V[0x0] += 0xff
+	// 0x374: 0xf055 LD [I], V0
+	c.Exec(0x374)
+	*c.PC = 0x376
+}
+
+func block376(c *chip8.Context) {
+	// 0x376: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x37a
+		return
+	}
+	*c.PC = 0x378
+}
+
+func block378(c *chip8.Context) {
+	// 0x378: 0x1270 JP 0x270
+	*c.PC = 0x270
+}
+
+func block37a(c *chip8.Context) {
+	// 0x37a: 0x7901 ADD V9, 0x01
+	c.V[0x9] += 0x01
+	// 0x37c: 0xa800 LD I, 0x800
+	*c.I = 0x800
+	// 0x37e: 0xf91e ADD I, V9
+	*c.I += uint16(c.V[0x9])
+	// 0x380: 0xf065 LD V0, [I]
+	c.Exec(0x380)
+	// 0x382: 0x8800 LD V8, V0
+	c.V[0x8] = c.V[0x0]
+	// 0x384: 0x7901 ADD V9, 0x01
+	c.V[0x9] += 0x01
+	// 0x386: 0x1270 JP 0x270
+	*c.PC = 0x270
+}
+
+func block388(c *chip8.Context) {
+	// 0x388: 0x600d LD V0, 0x0d
+	c.V[0x0] = 0x0d
+	// 0x38a: 0xf018 LD ST, V0
+	*c.ST = c.V[0x0]
+	// 0x38c: 0x600b LD V0, 0x0b
+	c.V[0x0] = 0x0b
+	*c.PC = 0x38e
+}
+
+func block38e(c *chip8.Context) {
+	// 0x38e: 0xe09e SKP V0
+	if c.Pressed(c.V[0x0]) {
+		*c.PC = 0x392
+		return
+	}
+	*c.PC = 0x390
+}
+
+func block390(c *chip8.Context) {
+	// 0x390: 0x138e JP 0x38e
+	*c.PC = 0x38e
+}
+
+func block392(c *chip8.Context) {
+	// 0x392: 0x6b01 LD Vb, 0x01
+	c.V[0xb] = 0x01
+	// 0x394: 0x6c00 LD Vc, 0x00
+	c.V[0xc] = 0x00
+	// 0x396: 0x6d00 LD Vd, 0x00
+	c.V[0xd] = 0x00
+	*c.PC = 0x398
+}
+
+func block398(c *chip8.Context) {
+	// 0x398: 0x7b01 ADD Vb, 0x01
+	c.V[0xb] += 0x01
+	// 0x39a: 0x3b0a SE Vb, 0x0a
+	if c.V[0xb] == 0x0a {
+		*c.PC = 0x39e
+		return
+	}
+	*c.PC = 0x39c
+}
+
+func block39c(c *chip8.Context) {
+	// 0x39c: 0x13aa JP 0x3aa
+	*c.PC = 0x3aa
+}
+
+func block39e(c *chip8.Context) {
+	// 0x39e: 0x6b00 LD Vb, 0x00
+	c.V[0xb] = 0x00
+	// 0x3a0: 0x7c01 ADD Vc, 0x01
+	c.V[0xc] += 0x01
+	// 0x3a2: 0x3c0a SE Vc, 0x0a
+	if c.V[0xc] == 0x0a {
+		*c.PC = 0x3a6
+		return
+	}
+	*c.PC = 0x3a4
+}
+
+func block3a4(c *chip8.Context) {
+	// 0x3a4: 0x13aa JP 0x3aa
+	*c.PC = 0x3aa
+}
+
+func block3a6(c *chip8.Context) {
+	// 0x3a6: 0x6c00 LD Vc, 0x00
+	c.V[0xc] = 0x00
+	// 0x3a8: 0x7d01 ADD Vd, 0x01
+	c.V[0xd] += 0x01
+	*c.PC = 0x3aa
+}
+
+func block3aa(c *chip8.Context) {
+	// 0x3aa: 0xa54c LD I, 0x54c
+	*c.I = 0x54c
+	// 0x3ac: 0xd671 DRW V6, V7, 0x1
+	c.Exec(0x3ac)
+	// 0x3ae: 0x4800 SNE V8, 0x00
+	if c.V[0x8] != 0x00 {
+		*c.PC = 0x3b2
+		return
+	}
+	*c.PC = 0x3b0
+}
+
+func block3b0(c *chip8.Context) {
+	// 0x3b0: 0x77ff ADD V7, 0xff
+	c.V[0x7] += 0xff
+	*c.PC = 0x3b2
+}
+
+func block3b2(c *chip8.Context) {
+	// 0x3b2: 0x4801 SNE V8, 0x01
+	if c.V[0x8] != 0x01 {
+		*c.PC = 0x3b6
+		return
+	}
+	*c.PC = 0x3b4
+}
+
+func block3b4(c *chip8.Context) {
+	// 0x3b4: 0x7701 ADD V7, 0x01
+	c.V[0x7] += 0x01
+	*c.PC = 0x3b6
+}
+
+func block3b6(c *chip8.Context) {
+	// 0x3b6: 0x4802 SNE V8, 0x02
+	if c.V[0x8] != 0x02 {
+		*c.PC = 0x3ba
+		return
+	}
+	*c.PC = 0x3b8
+}
+
+func block3b8(c *chip8.Context) {
+	// 0x3b8: 0x76ff ADD V6, 0xff
+	c.V[0x6] += 0xff
+	*c.PC = 0x3ba
+}
+
+func block3ba(c *chip8.Context) {
+	// 0x3ba: 0x4803 SNE V8, 0x03
+	if c.V[0x8] != 0x03 {
+		*c.PC = 0x3be
+		return
+	}
+	*c.PC = 0x3bc
+}
+
+func block3bc(c *chip8.Context) {
+	// 0x3bc: 0x7601 ADD V6, 0x01
+	c.V[0x6] += 0x01
+	*c.PC = 0x3be
+}
+
+func block3be(c *chip8.Context) {
+	// 0x3be: 0xa800 LD I, 0x800
+	*c.I = 0x800
+	// 0x3c0: 0xf91e ADD I, V9
+	*c.I += uint16(c.V[0x9])
+	// 0x3c2: 0xf065 LD V0, [I]
+	c.Exec(0x3c2)
+	// 0x3c4: 0x70ff ADD V0, 0xff
+	c.V[0x0] += 0xff
+	// 0x3c6: 0xf055 LD [I], V0
+	c.Exec(0x3c6)
+	*c.PC = 0x3c8
+}
+
+func block3c8(c *chip8.Context) {
+	// 0x3c8: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x3cc
+		return
+	}
+	*c.PC = 0x3ca
+}
+
+func block3ca(c *chip8.Context) {
+	// 0x3ca: 0x1398 JP 0x398
+	*c.PC = 0x398
+}
+
+func block3cc(c *chip8.Context) {
+	// 0x3cc: 0x9940 SNE V9, V4
+	if c.V[0x9] != c.V[0x4] {
+		*c.PC = 0x3d0
+		return
+	}
+	*c.PC = 0x3ce
+}
+
+func block3ce(c *chip8.Context) {
+	// 0x3ce: 0x13de JP 0x3de
+	*c.PC = 0x3de
+}
+
+func block3d0(c *chip8.Context) {
+	// 0x3d0: 0x7901 ADD V9, 0x01
+	c.V[0x9] += 0x01
+	// 0x3d2: 0xa800 LD I, 0x800
+	*c.I = 0x800
+	// 0x3d4: 0xf91e ADD I, V9
+	*c.I += uint16(c.V[0x9])
+	// 0x3d6: 0xf065 LD V0, [I]
+	c.Exec(0x3d6)
+	// 0x3d8: 0x8800 LD V8, V0
+	c.V[0x8] = c.V[0x0]
+	// 0x3da: 0x7901 ADD V9, 0x01
+	c.V[0x9] += 0x01
+	// 0x3dc: 0x1398 JP 0x398
+	*c.PC = 0x398
+}
+
+func block3de(c *chip8.Context) {
+	// 0x3de: 0x00e0 CLS
+	c.Exec(0x3de)
+	// 0x3e0: 0x6611 LD V6, 0x11
+	c.V[0x6] = 0x11
+	// 0x3e2: 0x6709 LD V7, 0x09
+	c.V[0x7] = 0x09
+	// 0x3e4: 0x682f LD V8, 0x2f
+	c.V[0x8] = 0x2f
+	// 0x3e6: 0x6917 LD V9, 0x17
+	c.V[0x9] = 0x17
+	// 0x3e8: 0xa552 LD I, 0x552
+	*c.I = 0x552
+	// 0x3ea: 0xd67e DRW V6, V7, 0xe
+	c.Exec(0x3ea)
+	// 0x3ec: 0xd87e DRW V8, V7, 0xe
+	c.Exec(0x3ec)
+	// 0x3ee: 0x77ff ADD V7, 0xff
+	c.V[0x7] += 0xff
+	// 0x3f0: 0xa54e LD I, 0x54e
+	*c.I = 0x54e
+	*c.PC = 0x3f2
+}
+
+func block412(c *chip8.Context) {
+	// 0x412: 0xa5ae LD I, 0x5ae
+	*c.I = 0x5ae
+	// 0x414: 0xf365 LD V3, [I]
+	c.Exec(0x414)
+	// 0x416: 0x93d0 SNE V3, Vd
+	if c.V[0x3] != c.V[0xd] {
+		*c.PC = 0x41a
+		return
+	}
+	*c.PC = 0x418
+}
+
+func block418(c *chip8.Context) {
+	// 0x418: 0x1424 JP 0x424
+	*c.PC = 0x424
+}
+
+func block41a(c *chip8.Context) {
+	// 0x41a: 0x8030 LD V0, V3
+	c.V[0x0] = c.V[0x3]
+	// 0x41c: 0x80d5 SUB V0, Vd
+	c.Exec(0x41c)
+	// 0x41e: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x422
+		return
+	}
+	*c.PC = 0x420
+}
+
+func block420(c *chip8.Context) {
+	// 0x420: 0x143a JP 0x43a
+	*c.PC = 0x43a
+}
+
+func block422(c *chip8.Context) {
+	// 0x422: 0x1444 JP 0x444
+	*c.PC = 0x444
+}
+
+func block424(c *chip8.Context) {
+	// 0x424: 0x92c0 SNE V2, Vc
+	if c.V[0x2] != c.V[0xc] {
+		*c.PC = 0x428
+		return
+	}
+	*c.PC = 0x426
+}
+
+func block426(c *chip8.Context) {
+	// 0x426: 0x1432 JP 0x432
+	*c.PC = 0x432
+}
+
+func block428(c *chip8.Context) {
+	// 0x428: 0x8020 LD V0, V2
+	c.V[0x0] = c.V[0x2]
+	// 0x42a: 0x80c5 SUB V0, Vc
+	c.Exec(0x42a)
+	// 0x42c: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x430
+		return
+	}
+	*c.PC = 0x42e
+}
+
+func block42e(c *chip8.Context) {
+	// 0x42e: 0x143a JP 0x43a
+	*c.PC = 0x43a
+}
+
+func block430(c *chip8.Context) {
+	// 0x430: 0x1444 JP 0x444
+	*c.PC = 0x444
+}
+
+func block432(c *chip8.Context) {
+	// 0x432: 0x8010 LD V0, V1
+	c.V[0x0] = c.V[0x1]
+	// 0x434: 0x80b5 SUB V0, Vb
+	c.Exec(0x434)
+	// 0x436: 0x3f00 SE Vf, 0x00
+	if c.V[0xf] == 0x00 {
+		*c.PC = 0x43a
+		return
+	}
+	*c.PC = 0x438
+}
+
+func block438(c *chip8.Context) {
+	// 0x438: 0x1444 JP 0x444
+	*c.PC = 0x444
+}
+
+func block43a(c *chip8.Context) {
+	// 0x43a: 0xa5ae LD I, 0x5ae
+	*c.I = 0x5ae
+	// 0x43c: 0x83d0 LD V3, Vd
+	c.V[0x3] = c.V[0xd]
+	// 0x43e: 0x82c0 LD V2, Vc
+	c.V[0x2] = c.V[0xc]
+	// 0x440: 0x81b0 LD V1, Vb
+	c.V[0x1] = c.V[0xb]
+	// 0x442: 0xf355 LD [I], V3
+	c.Exec(0x442)
+	*c.PC = 0x444
+}
+
+func block444(c *chip8.Context) {
+	// 0x444: 0xa5ae LD I, 0x5ae
+	*c.I = 0x5ae
+	// 0x446: 0xf365 LD V3, [I]
+	c.Exec(0x446)
+	// 0x448: 0x6613 LD V6, 0x13
+	c.V[0x6] = 0x13
+	// 0x44a: 0x77f9 ADD V7, 0xf9
+	c.V[0x7] += 0xf9
+	// 0x44c: 0x8d30 LD Vd, V3
+	c.V[0xd] = c.V[0x3]
+	// 0x44e: 0x8c20 LD Vc, V2
+	c.V[0xc] = c.V[0x2]
+	// 0x450: 0x8b10 LD Vb, V1
+	c.V[0xb] = c.V[0x1]
+	// 0x452: 0xa5a4 LD I, 0x5a4
+	*c.I = 0x5a4
+	*c.PC = 0x454
+}
+
+func block456(c *chip8.Context) {
+	// 0x456: 0xc13f RND V1, byte
+	c.Exec(0x456)
+	// 0x458: 0xc21f RND V2, byte
+	c.Exec(0x458)
+	// 0x45a: 0x600d LD V0, 0x0d
+	c.V[0x0] = 0x0d
+	// 0x45c: 0x8015 SUB V0, V1
+	c.Exec(0x45c)
+	// 0x45e: 0x3f00 SE Vf, 0x00
+	if c.V[0xf] == 0x00 {
+		*c.PC = 0x462
+		return
+	}
+	*c.PC = 0x460
+}
+
+func block460(c *chip8.Context) {
+	// 0x460: 0x147c JP 0x47c
+	*c.PC = 0x47c
+}
+
+func block462(c *chip8.Context) {
+	// 0x462: 0x6030 LD V0, 0x30
+	c.V[0x0] = 0x30
+	// 0x464: 0x8017 SUBN V0, V1
+	c.Exec(0x464)
+	// 0x466: 0x3f00 SE Vf, 0x00
+	if c.V[0xf] == 0x00 {
+		*c.PC = 0x46a
+		return
+	}
+	*c.PC = 0x468
+}
+
+func block468(c *chip8.Context) {
+	// 0x468: 0x147c JP 0x47c
+	*c.PC = 0x47c
+}
+
+func block46a(c *chip8.Context) {
+	// 0x46a: 0x6003 LD V0, 0x03
+	c.V[0x0] = 0x03
+	// 0x46c: 0x8025 SUB V0, V2
+	c.Exec(0x46c)
+	// 0x46e: 0x3f00 SE Vf, 0x00
+	if c.V[0xf] == 0x00 {
+		*c.PC = 0x472
+		return
+	}
+	*c.PC = 0x470
+}
+
+func block470(c *chip8.Context) {
+	// 0x470: 0x147c JP 0x47c
+	*c.PC = 0x47c
+}
+
+func block472(c *chip8.Context) {
+	// 0x472: 0x6018 LD V0, 0x18
+	c.V[0x0] = 0x18
+	// 0x474: 0x8027 SUBN V0, V2
+	c.Exec(0x474)
+	// 0x476: 0x3f00 SE Vf, 0x00
+	if c.V[0xf] == 0x00 {
+		*c.PC = 0x47a
+		return
+	}
+	*c.PC = 0x478
+}
+
+func block478(c *chip8.Context) {
+	// 0x478: 0x147c JP 0x47c
+	*c.PC = 0x47c
+}
+
+func block47a(c *chip8.Context) {
+	// 0x47a: 0x1482 JP 0x482
+	*c.PC = 0x482
+}
+
+func block47c(c *chip8.Context) {
+	// 0x47c: 0xc30f RND V3, byte
+	c.Exec(0x47c)
+	// 0x47e: 0xf329 LD F, V3
+	*c.I = uint16(c.V[0x3] * 5)
+	// 0x480: 0xd125 DRW V1, V2, 0x5
+	c.Exec(0x480)
+	*c.PC = 0x482
+}
+
+func block482(c *chip8.Context) {
+	// 0x482: 0x600f LD V0, 0x0f
+	c.V[0x0] = 0x0f
+	// 0x484: 0xe0a1 SKNP V0
+	if !c.Pressed(c.V[0x0]) {
+		*c.PC = 0x488
+		return
+	}
+	*c.PC = 0x486
+}
+
+func block486(c *chip8.Context) {
+	// 0x486: 0x1490 JP 0x490
+	*c.PC = 0x490
+}
+
+func block488(c *chip8.Context) {
+	// 0x488: 0x600e LD V0, 0x0e
+	c.V[0x0] = 0x0e
+	// 0x48a: 0xe0a1 SKNP V0
+	if !c.Pressed(c.V[0x0]) {
+		*c.PC = 0x48e
+		return
+	}
+	*c.PC = 0x48c
+}
+
+func block48c(c *chip8.Context) {
+	// 0x48c: 0x1496 JP 0x496
+	*c.PC = 0x496
+}
+
+func block48e(c *chip8.Context) {
+	// 0x48e: 0x1456 JP 0x456
+	*c.PC = 0x456
+}
+
+func block490(c *chip8.Context) {
+	// 0x490: 0x00e0 CLS
+	c.Exec(0x490)
+	*c.PC = 0x492
+}
+
+func block494(c *chip8.Context) {
+	// 0x494: 0x122c JP 0x22c
+	*c.PC = 0x22c
+}
+
+func block496(c *chip8.Context) {
+	// 0x496: 0x00e0 CLS
+	c.Exec(0x496)
+	// 0x498: 0x122c JP 0x22c
+	*c.PC = 0x22c
+}
+
+func block49a(c *chip8.Context) {
+	// 0x49a: 0xd675 DRW V6, V7, 0x5
+	c.Exec(0x49a)
+	// 0x49c: 0xa5aa LD I, 0x5aa
+	*c.I = 0x5aa
+	// 0x49e: 0x7602 ADD V6, 0x02
+	c.V[0x6] += 0x02
+	// 0x4a0: 0xd674 DRW V6, V7, 0x4
+	c.Exec(0x4a0)
+	// 0x4a2: 0xfd29 LD F, Vd
+	*c.I = uint16(c.V[0xd] * 5)
+	// 0x4a4: 0x760a ADD V6, 0x0a
+	c.V[0x6] += 0x0a
+	// 0x4a6: 0xd675 DRW V6, V7, 0x5
+	c.Exec(0x4a6)
+	// 0x4a8: 0xfc29 LD F, Vc
+	*c.I = uint16(c.V[0xc] * 5)
+	// 0x4aa: 0x7605 ADD V6, 0x05
+	c.V[0x6] += 0x05
+	// 0x4ac: 0xd675 DRW V6, V7, 0x5
+	c.Exec(0x4ac)
+	*c.PC = 0x4ae
+}
+
+func block4b6(c *chip8.Context) {
+	// 0x4b6: 0xa54e LD I, 0x54e
+	*c.I = 0x54e
+	// 0x4b8: 0x6100 LD V1, 0x00
+	c.V[0x1] = 0x00
+	// 0x4ba: 0x6200 LD V2, 0x00
+	c.V[0x2] = 0x00
+	// 0x4bc: 0x661f LD V6, 0x1f
+	c.V[0x6] = 0x1f
+	*c.PC = 0x4be
+}
+
+func block4be(c *chip8.Context) {
+	// 0x4be: 0xd121 DRW V1, V2, 0x1
+	c.Exec(0x4be)
+	// 0x4c0: 0xd161 DRW V1, V6, 0x1
+	c.Exec(0x4c0)
+	// 0x4c2: 0x7108 ADD V1, 0x08
+	c.V[0x1] += 0x08
+	// 0x4c4: 0x3140 SE V1, 0x40
+	if c.V[0x1] == 0x40 {
+		*c.PC = 0x4c8
+		return
+	}
+	*c.PC = 0x4c6
+}
+
+func block4c6(c *chip8.Context) {
+	// 0x4c6: 0x14be JP 0x4be
+	*c.PC = 0x4be
+}
+
+func block4c8(c *chip8.Context) {
+	// 0x4c8: 0xa552 LD I, 0x552
+	*c.I = 0x552
+	// 0x4ca: 0x6201 LD V2, 0x01
+	c.V[0x2] = 0x01
+	// 0x4cc: 0x653f LD V5, 0x3f
+	c.V[0x5] = 0x3f
+	// 0x4ce: 0xd12f DRW V1, V2, 0xf
+	c.Exec(0x4ce)
+	// 0x4d0: 0xd52f DRW V5, V2, 0xf
+	c.Exec(0x4d0)
+	// 0x4d2: 0x720f ADD V2, 0x0f
+	c.V[0x2] += 0x0f
+	// 0x4d4: 0xd12f DRW V1, V2, 0xf
+	c.Exec(0x4d4)
+	// 0x4d6: 0xd52f DRW V5, V2, 0xf
+	c.Exec(0x4d6)
+	*c.PC = 0x4d8
+}
+
+func block4da(c *chip8.Context) {
+	// 0x4da: 0x610c LD V1, 0x0c
+	c.V[0x1] = 0x0c
+	// 0x4dc: 0x6207 LD V2, 0x07
+	c.V[0x2] = 0x07
+	// 0x4de: 0xa562 LD I, 0x562
+	*c.I = 0x562
+	// 0x4e0: 0xd12a DRW V1, V2, 0xa
+	c.Exec(0x4e0)
+	// 0x4e2: 0xa56c LD I, 0x56c
+	*c.I = 0x56c
+	// 0x4e4: 0x7106 ADD V1, 0x06
+	c.V[0x1] += 0x06
+	// 0x4e6: 0xd12a DRW V1, V2, 0xa
+	c.Exec(0x4e6)
+	// 0x4e8: 0xa576 LD I, 0x576
+	*c.I = 0x576
+	// 0x4ea: 0x7106 ADD V1, 0x06
+	c.V[0x1] += 0x06
+	// 0x4ec: 0xd12a DRW V1, V2, 0xa
+	c.Exec(0x4ec)
+	*c.PC = 0x4ee
+}
+
+func block522(c *chip8.Context) {
+	// 0x522: 0x6dc5 LD Vd, 0xc5
+	c.V[0xd] = 0xc5
+	*c.PC = 0x524
+}
+
+func block524(c *chip8.Context) {
+	// 0x524: 0xcb3f RND Vb, byte
+	c.Exec(0x524)
+	// 0x526: 0x8eb0 LD Ve, Vb
+	c.V[0xe] = c.V[0xb]
+	// 0x528: 0x8ed4 ADD Ve, Vd
+	c.Exec(0x528)
+	// 0x52a: 0x4f01 SNE Vf, 0x01
+	if c.V[0xf] != 0x01 {
+		*c.PC = 0x52e
+		return
+	}
+	*c.PC = 0x52c
+}
+
+func block52c(c *chip8.Context) {
+	// 0x52c: 0x1524 JP 0x524
+	*c.PC = 0x524
+}
+
+func block52e(c *chip8.Context) {
+	// 0x52e: 0x7b01 ADD Vb, 0x01
+	c.V[0xb] += 0x01
+	// 0x530: 0x6de6 LD Vd, 0xe6
+	c.V[0xd] = 0xe6
+	*c.PC = 0x532
+}
+
+func block532(c *chip8.Context) {
+	// 0x532: 0xcc1f RND Vc, byte
+	c.Exec(0x532)
+	// 0x534: 0x8ec0 LD Ve, Vc
+	c.V[0xe] = c.V[0xc]
+	// 0x536: 0x8ed4 ADD Ve, Vd
+	c.Exec(0x536)
+	// 0x538: 0x4f01 SNE Vf, 0x01
+	if c.V[0xf] != 0x01 {
+		*c.PC = 0x53c
+		return
+	}
+	*c.PC = 0x53a
+}
+
+func block53a(c *chip8.Context) {
+	// 0x53a: 0x1532 JP 0x532
+	*c.PC = 0x532
+}
+
+func block53c(c *chip8.Context) {
+	// 0x53c: 0x7c01 ADD Vc, 0x01
+	c.V[0xc] += 0x01
+	// 0x53e: 0x6d00 LD Vd, 0x00
+	c.V[0xd] = 0x00
+	// 0x540: 0xce3f RND Ve, byte
+	c.Exec(0x540)
+	// 0x542: 0x7e40 ADD Ve, 0x40
+	c.V[0xe] += 0x40
+	// 0x544: 0xfe15 LD DT, Ve
+	*c.DT = c.V[0xe]
+	// 0x546: 0xce3f RND Ve, byte
+	c.Exec(0x546)
+	// 0x548: 0x7e40 ADD Ve, 0x40
+	c.V[0xe] += 0x40
+	*c.PC = 0x54a
+}
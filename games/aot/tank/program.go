@@ -0,0 +1,1034 @@
+// Code generated by go-chip8 recompile from TANK; DO NOT EDIT.
+
+package tank
+
+import "github.com/morinokami/go-chip8/chip8"
+
+var Program = &chip8.Program{
+	ROM: "48206f279f572b908e2599d81d1aaaffdd61b2d576f805a79cb447bf476c539d",
+	Blocks: map[uint16]*chip8.Block{
+		0x200: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block200},
+		0x202: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.LDVxByte, chip8.SUB, chip8.SNEVxByte}, Run: block202},
+		0x20a: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block20a},
+		0x20c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block20c},
+		0x230: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block230},
+		0x24c: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDDTVx}, Run: block24c},
+		0x250: {Insts: []chip8.Instruction{chip8.LDVxDT, chip8.SEVxByte}, Run: block250},
+		0x254: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block254},
+		0x25c: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxI}, Run: block25c},
+		0x266: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block266},
+		0x26a: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block26a},
+		0x26e: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block26e},
+		0x272: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block272},
+		0x276: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block276},
+		0x278: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block278},
+		0x27a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block27a},
+		0x27c: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxI, chip8.SNEVxByte}, Run: block27c},
+		0x282: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block282},
+		0x284: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block284},
+		0x286: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block286},
+		0x288: {Insts: []chip8.Instruction{chip8.SKNP}, Run: block288},
+		0x28a: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block28a},
+		0x28c: {Insts: []chip8.Instruction{chip8.SKNP}, Run: block28c},
+		0x28e: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block28e},
+		0x290: {Insts: []chip8.Instruction{chip8.SKNP}, Run: block290},
+		0x292: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block292},
+		0x294: {Insts: []chip8.Instruction{chip8.SKNP}, Run: block294},
+		0x296: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block296},
+		0x298: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block298},
+		0x29e: {Insts: []chip8.Instruction{chip8.LDVxVy}, Run: block29e},
+		0x2a4: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr, chip8.LDIVx}, Run: block2a4},
+		0x2ae: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.SNEVxByte}, Run: block2ae},
+		0x2b2: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2b2},
+		0x2b4: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2b4},
+		0x2b6: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2b6},
+		0x2b8: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2b8},
+		0x2ba: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2ba},
+		0x2bc: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2bc},
+		0x2be: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2be},
+		0x2c0: {Insts: []chip8.Instruction{chip8.ADDIVx, chip8.DRW}, Run: block2c0},
+		0x2c6: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKP}, Run: block2c6},
+		0x2cc: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2cc},
+		0x2d0: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte, chip8.LDIAddr, chip8.LDIVx}, Run: block2d0},
+		0x2d8: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.ADDVxByte}, Run: block2d8},
+		0x2e2: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDIVx}, Run: block2e2},
+		0x2e6: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW}, Run: block2e6},
+		0x2ec: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxI, chip8.SNEVxByte}, Run: block2ec},
+		0x2f4: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW}, Run: block2f4},
+		0x2fa: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2fa},
+		0x2fe: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2fe},
+		0x300: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block300},
+		0x302: {Insts: []chip8.Instruction{chip8.DRW}, Run: block302},
+		0x304: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDIVx}, Run: block304},
+		0x30a: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr, chip8.LDIVx}, Run: block30a},
+		0x312: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block312},
+		0x314: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxI, chip8.SEVxByte}, Run: block314},
+		0x31a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block31a},
+		0x31c: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.SEVxByte}, Run: block31c},
+		0x322: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block322},
+		0x324: {Insts: []chip8.Instruction{chip8.RND, chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.LDVxVy, chip8.RND, chip8.ADDVxByte}, Run: block324},
+		0x334: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxByte, chip8.ADDVxByte, chip8.LDVxByte, chip8.DRW}, Run: block334},
+		0x33e: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDIVx}, Run: block33e},
+		0x344: {Insts: []chip8.Instruction{chip8.RND, chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.LDVxVy, chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.LDVxVy, chip8.LDIAddr}, Run: block344},
+		0x362: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.JPAddr}, Run: block362},
+		0x366: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block366},
+		0x368: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block368},
+		0x36a: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block36a},
+		0x36c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block36c},
+		0x36e: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxI, chip8.SNEVxByte}, Run: block36e},
+		0x374: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block374},
+		0x376: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.LDVxByte, chip8.DRW, chip8.SEVxByte}, Run: block376},
+		0x380: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block380},
+		0x382: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block382},
+		0x384: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDSTVx, chip8.CLS, chip8.JPAddr}, Run: block384},
+		0x38c: {Insts: []chip8.Instruction{chip8.CLS}, Run: block38c},
+		0x390: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDSTVx}, Run: block390},
+		0x394: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block394},
+		0x39a: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block39a},
+		0x39c: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block39c},
+		0x39e: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block39e},
+		0x3a0: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block3a0},
+		0x3a2: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block3a2},
+		0x3a4: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block3a4},
+		0x3a6: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block3a6},
+		0x3a8: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block3a8},
+		0x3ac: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block3ac},
+		0x3ae: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block3ae},
+		0x3b0: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block3b0},
+		0x3b2: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block3b2},
+		0x3b4: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block3b4},
+		0x3b6: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block3b6},
+		0x3b8: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block3b8},
+		0x3ba: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block3ba},
+		0x3be: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SNEVxByte}, Run: block3be},
+		0x3c2: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block3c2},
+		0x3c4: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block3c4},
+		0x3c6: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block3c6},
+		0x3c8: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block3c8},
+		0x3ca: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block3ca},
+		0x3cc: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block3cc},
+		0x3ce: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block3ce},
+		0x3d0: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block3d0},
+		0x3d4: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr, chip8.LDBVx}, Run: block3d4},
+		0x3dc: {Insts: []chip8.Instruction{chip8.LDVxI}, Run: block3dc},
+		0x3e0: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDIAddr, chip8.LDBVx}, Run: block3e0},
+		0x3e6: {Insts: []chip8.Instruction{chip8.LDVxI}, Run: block3e6},
+		0x3ec: {Insts: []chip8.Instruction{chip8.LDFVx, chip8.DRW, chip8.ADDVxByte}, Run: block3ec},
+		0x3f2: {Insts: []chip8.Instruction{chip8.LDFVx, chip8.DRW, chip8.ADDVxByte, chip8.LDFVx, chip8.DRW}, Run: block3f2},
+	},
+}
+
+func block200(c *chip8.Context) {
+	// 0x200: 0x1230 JP 0x230
+	*c.PC = 0x230
+}
+
+func block202(c *chip8.Context) {
+	// 0x202: 0x76fb ADD V6, 0xfb
+	c.V[0x6] += 0xfb
+	// 0x204: 0x6020 LD V0, 0x20
+	c.V[0x0] = 0x20
+	// 0x206: 0x8065 SUB V0, V6
+	c.Exec(0x206)
+	// 0x208: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x20c
+		return
+	}
+	*c.PC = 0x20a
+}
+
+func block20a(c *chip8.Context) {
+	// 0x20a: 0x6600 LD V6, 0x00
+	c.V[0x6] = 0x00
+	*c.PC = 0x20c
+}
+
+func block20c(c *chip8.Context) {
+	// 0x20c: 0x1384 JP 0x384
+	*c.PC = 0x384
+}
+
+func block230(c *chip8.Context) {
+	// 0x230: 0x6e00 LD Ve, 0x00
+	c.V[0xe] = 0x00
+	// 0x232: 0x6da0 LD Vd, 0xa0
+	c.V[0xd] = 0xa0
+	// 0x234: 0x6a08 LD Va, 0x08
+	c.V[0xa] = 0x08
+	// 0x236: 0x6906 LD V9, 0x06
+	c.V[0x9] = 0x06
+	// 0x238: 0x6804 LD V8, 0x04
+	c.V[0x8] = 0x04
+	// 0x23a: 0x6702 LD V7, 0x02
+	c.V[0x7] = 0x02
+	// 0x23c: 0x6619 LD V6, 0x19
+	c.V[0x6] = 0x19
+	// 0x23e: 0x6410 LD V4, 0x10
+	c.V[0x4] = 0x10
+	// 0x240: 0x630c LD V3, 0x0c
+	c.V[0x3] = 0x0c
+	// 0x242: 0x6200 LD V2, 0x00
+	c.V[0x2] = 0x00
+	*c.PC = 0x244
+}
+
+func block24c(c *chip8.Context) {
+	// 0x24c: 0x6040 LD V0, 0x40
+	c.V[0x0] = 0x40
+	// 0x24e: 0xf015 LD DT, V0
+	*c.DT = c.V[0x0]
+	*c.PC = 0x250
+}
+
+func block250(c *chip8.Context) {
+	// 0x250: 0xf007 LD V0, DT
+	c.V[0x0] = *c.DT
+	// 0x252: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x256
+		return
+	}
+	*c.PC = 0x254
+}
+
+func block254(c *chip8.Context) {
+	// 0x254: 0x1250 JP 0x250
+	*c.PC = 0x250
+}
+
+func block25c(c *chip8.Context) {
+	// 0x25c: 0xa212 LD I, 0x212
+	*c.I = 0x212
+	// 0x25e: 0xf565 LD V5, [I]
+	c.Exec(0x25e)
+	*c.PC = 0x260
+}
+
+func block266(c *chip8.Context) {
+	// 0x266: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x26a
+		return
+	}
+	*c.PC = 0x268
+}
+
+func block26a(c *chip8.Context) {
+	// 0x26a: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x26e
+		return
+	}
+	*c.PC = 0x26c
+}
+
+func block26e(c *chip8.Context) {
+	// 0x26e: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x272
+		return
+	}
+	*c.PC = 0x270
+}
+
+func block272(c *chip8.Context) {
+	// 0x272: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x276
+		return
+	}
+	*c.PC = 0x274
+}
+
+func block276(c *chip8.Context) {
+	// 0x276: 0x4f01 SNE Vf, 0x01
+	if c.V[0xf] != 0x01 {
+		*c.PC = 0x27a
+		return
+	}
+	*c.PC = 0x278
+}
+
+func block278(c *chip8.Context) {
+	// 0x278: 0x1366 JP 0x366
+	*c.PC = 0x366
+}
+
+func block27a(c *chip8.Context) {
+	// 0x27a: 0x1262 JP 0x262
+	*c.PC = 0x262
+}
+
+func block27c(c *chip8.Context) {
+	// 0x27c: 0xa212 LD I, 0x212
+	*c.I = 0x212
+	// 0x27e: 0xf565 LD V5, [I]
+	c.Exec(0x27e)
+	// 0x280: 0x4600 SNE V6, 0x00
+	if c.V[0x6] != 0x00 {
+		*c.PC = 0x284
+		return
+	}
+	*c.PC = 0x282
+}
+
+func block282(c *chip8.Context) {
+	// 0x282: 0x3500 SE V5, 0x00
+	if c.V[0x5] == 0x00 {
+		*c.PC = 0x286
+		return
+	}
+	*c.PC = 0x284
+}
+
+func block284(c *chip8.Context) {
+	// 0x284: 0x1288 JP 0x288
+	*c.PC = 0x288
+}
+
+func block286(c *chip8.Context) {
+	// 0x286: 0x138c JP 0x38c
+	*c.PC = 0x38c
+}
+
+func block288(c *chip8.Context) {
+	// 0x288: 0xe7a1 SKNP V7
+	if !c.Pressed(c.V[0x7]) {
+		*c.PC = 0x28c
+		return
+	}
+	*c.PC = 0x28a
+}
+
+func block28a(c *chip8.Context) {
+	// 0x28a: 0x6209 LD V2, 0x09
+	c.V[0x2] = 0x09
+	*c.PC = 0x28c
+}
+
+func block28c(c *chip8.Context) {
+	// 0x28c: 0xe8a1 SKNP V8
+	if !c.Pressed(c.V[0x8]) {
+		*c.PC = 0x290
+		return
+	}
+	*c.PC = 0x28e
+}
+
+func block28e(c *chip8.Context) {
+	// 0x28e: 0x6204 LD V2, 0x04
+	c.V[0x2] = 0x04
+	*c.PC = 0x290
+}
+
+func block290(c *chip8.Context) {
+	// 0x290: 0xe9a1 SKNP V9
+	if !c.Pressed(c.V[0x9]) {
+		*c.PC = 0x294
+		return
+	}
+	*c.PC = 0x292
+}
+
+func block292(c *chip8.Context) {
+	// 0x292: 0x6206 LD V2, 0x06
+	c.V[0x2] = 0x06
+	*c.PC = 0x294
+}
+
+func block294(c *chip8.Context) {
+	// 0x294: 0xeaa1 SKNP Va
+	if !c.Pressed(c.V[0xa]) {
+		*c.PC = 0x298
+		return
+	}
+	*c.PC = 0x296
+}
+
+func block296(c *chip8.Context) {
+	// 0x296: 0x6201 LD V2, 0x01
+	c.V[0x2] = 0x01
+	*c.PC = 0x298
+}
+
+func block298(c *chip8.Context) {
+	// 0x298: 0x4200 SNE V2, 0x00
+	if c.V[0x2] != 0x00 {
+		*c.PC = 0x29c
+		return
+	}
+	*c.PC = 0x29a
+}
+
+func block29e(c *chip8.Context) {
+	// 0x29e: 0x8120 LD V1, V2
+	c.V[0x1] = c.V[0x2]
+	*c.PC = 0x2a0
+}
+
+func block2a4(c *chip8.Context) {
+	// 0x2a4: 0x6c01 LD Vc, 0x01
+	c.V[0xc] = 0x01
+	// 0x2a6: 0x6200 LD V2, 0x00
+	c.V[0x2] = 0x00
+	// 0x2a8: 0x6f00 LD Vf, 0x00
+	c.V[0xf] = 0x00
+	// 0x2aa: 0xa212 LD I, 0x212
+	*c.I = 0x212
+	// 0x2ac: 0xf555 LD [I], V5
+	c.Exec(0x2ac)
+	*c.PC = 0x2ae
+}
+
+func block2ae(c *chip8.Context) {
+	// 0x2ae: 0xa3ff LD I, 0x3ff
+	*c.I = 0x3ff
+	// 0x2b0: 0x4101 SNE V1, 0x01
+	if c.V[0x1] != 0x01 {
+		*c.PC = 0x2b4
+		return
+	}
+	*c.PC = 0x2b2
+}
+
+func block2b2(c *chip8.Context) {
+	// 0x2b2: 0x6000 LD V0, 0x00
+	c.V[0x0] = 0x00
+	*c.PC = 0x2b4
+}
+
+func block2b4(c *chip8.Context) {
+	// 0x2b4: 0x4104 SNE V1, 0x04
+	if c.V[0x1] != 0x04 {
+		*c.PC = 0x2b8
+		return
+	}
+	*c.PC = 0x2b6
+}
+
+func block2b6(c *chip8.Context) {
+	// 0x2b6: 0x6013 LD V0, 0x13
+	c.V[0x0] = 0x13
+	*c.PC = 0x2b8
+}
+
+func block2b8(c *chip8.Context) {
+	// 0x2b8: 0x4106 SNE V1, 0x06
+	if c.V[0x1] != 0x06 {
+		*c.PC = 0x2bc
+		return
+	}
+	*c.PC = 0x2ba
+}
+
+func block2ba(c *chip8.Context) {
+	// 0x2ba: 0x600d LD V0, 0x0d
+	c.V[0x0] = 0x0d
+	*c.PC = 0x2bc
+}
+
+func block2bc(c *chip8.Context) {
+	// 0x2bc: 0x4109 SNE V1, 0x09
+	if c.V[0x1] != 0x09 {
+		*c.PC = 0x2c0
+		return
+	}
+	*c.PC = 0x2be
+}
+
+func block2be(c *chip8.Context) {
+	// 0x2be: 0x6006 LD V0, 0x06
+	c.V[0x0] = 0x06
+	*c.PC = 0x2c0
+}
+
+func block2c0(c *chip8.Context) {
+	// 0x2c0: 0xf01e ADD I, V0
+	*c.I += uint16(c.V[0x0])
+	// 0x2c2: 0xd347 DRW V3, V4, 0x7
+	c.Exec(0x2c2)
+	*c.PC = 0x2c4
+}
+
+func block2c6(c *chip8.Context) {
+	// 0x2c6: 0x6005 LD V0, 0x05
+	c.V[0x0] = 0x05
+	// 0x2c8: 0xe09e SKP V0
+	if c.Pressed(c.V[0x0]) {
+		*c.PC = 0x2cc
+		return
+	}
+	*c.PC = 0x2ca
+}
+
+func block2cc(c *chip8.Context) {
+	// 0x2cc: 0x450f SNE V5, 0x0f
+	if c.V[0x5] != 0x0f {
+		*c.PC = 0x2d0
+		return
+	}
+	*c.PC = 0x2ce
+}
+
+func block2d0(c *chip8.Context) {
+	// 0x2d0: 0x650f LD V5, 0x0f
+	c.V[0x5] = 0x0f
+	// 0x2d2: 0x76ff ADD V6, 0xff
+	c.V[0x6] += 0xff
+	// 0x2d4: 0xa212 LD I, 0x212
+	*c.I = 0x212
+	// 0x2d6: 0xf555 LD [I], V5
+	c.Exec(0x2d6)
+	*c.PC = 0x2d8
+}
+
+func block2d8(c *chip8.Context) {
+	// 0x2d8: 0x7403 ADD V4, 0x03
+	c.V[0x4] += 0x03
+	// 0x2da: 0x7303 ADD V3, 0x03
+	c.V[0x3] += 0x03
+	*c.PC = 0x2dc
+}
+
+func block2e2(c *chip8.Context) {
+	// 0x2e2: 0xa223 LD I, 0x223
+	*c.I = 0x223
+	// 0x2e4: 0xf555 LD [I], V5
+	c.Exec(0x2e4)
+	*c.PC = 0x2e6
+}
+
+func block2e6(c *chip8.Context) {
+	// 0x2e6: 0xa419 LD I, 0x419
+	*c.I = 0x419
+	// 0x2e8: 0xd341 DRW V3, V4, 0x1
+	c.Exec(0x2e8)
+	*c.PC = 0x2ea
+}
+
+func block2ec(c *chip8.Context) {
+	// 0x2ec: 0xa223 LD I, 0x223
+	*c.I = 0x223
+	// 0x2ee: 0xf565 LD V5, [I]
+	c.Exec(0x2ee)
+	// 0x2f0: 0x4500 SNE V5, 0x00
+	if c.V[0x5] != 0x00 {
+		*c.PC = 0x2f4
+		return
+	}
+	*c.PC = 0x2f2
+}
+
+func block2f4(c *chip8.Context) {
+	// 0x2f4: 0xa419 LD I, 0x419
+	*c.I = 0x419
+	// 0x2f6: 0xd341 DRW V3, V4, 0x1
+	c.Exec(0x2f6)
+	*c.PC = 0x2f8
+}
+
+func block2fa(c *chip8.Context) {
+	// 0x2fa: 0x6c02 LD Vc, 0x02
+	c.V[0xc] = 0x02
+	*c.PC = 0x2fc
+}
+
+func block2fe(c *chip8.Context) {
+	// 0x2fe: 0x4bbb SNE Vb, 0xbb
+	if c.V[0xb] != 0xbb {
+		*c.PC = 0x302
+		return
+	}
+	*c.PC = 0x300
+}
+
+func block300(c *chip8.Context) {
+	// 0x300: 0x130a JP 0x30a
+	*c.PC = 0x30a
+}
+
+func block302(c *chip8.Context) {
+	// 0x302: 0xd341 DRW V3, V4, 0x1
+	c.Exec(0x302)
+	*c.PC = 0x304
+}
+
+func block304(c *chip8.Context) {
+	// 0x304: 0xa223 LD I, 0x223
+	*c.I = 0x223
+	// 0x306: 0xf555 LD [I], V5
+	c.Exec(0x306)
+	*c.PC = 0x308
+}
+
+func block30a(c *chip8.Context) {
+	// 0x30a: 0x6500 LD V5, 0x00
+	c.V[0x5] = 0x00
+	// 0x30c: 0x6000 LD V0, 0x00
+	c.V[0x0] = 0x00
+	// 0x30e: 0xa217 LD I, 0x217
+	*c.I = 0x217
+	// 0x310: 0xf055 LD [I], V0
+	c.Exec(0x310)
+	*c.PC = 0x312
+}
+
+func block312(c *chip8.Context) {
+	// 0x312: 0x1304 JP 0x304
+	*c.PC = 0x304
+}
+
+func block314(c *chip8.Context) {
+	// 0x314: 0xa21d LD I, 0x21d
+	*c.I = 0x21d
+	// 0x316: 0xf565 LD V5, [I]
+	c.Exec(0x316)
+	// 0x318: 0x350f SE V5, 0x0f
+	if c.V[0x5] == 0x0f {
+		*c.PC = 0x31c
+		return
+	}
+	*c.PC = 0x31a
+}
+
+func block31a(c *chip8.Context) {
+	// 0x31a: 0x1344 JP 0x344
+	*c.PC = 0x344
+}
+
+func block31c(c *chip8.Context) {
+	// 0x31c: 0xa41a LD I, 0x41a
+	*c.I = 0x41a
+	// 0x31e: 0xd345 DRW V3, V4, 0x5
+	c.Exec(0x31e)
+	// 0x320: 0x3200 SE V2, 0x00
+	if c.V[0x2] == 0x00 {
+		*c.PC = 0x324
+		return
+	}
+	*c.PC = 0x322
+}
+
+func block322(c *chip8.Context) {
+	// 0x322: 0x1332 JP 0x332
+	*c.PC = 0x332
+}
+
+func block324(c *chip8.Context) {
+	// 0x324: 0xc103 RND V1, byte
+	c.Exec(0x324)
+	// 0x326: 0xa219 LD I, 0x219
+	*c.I = 0x219
+	// 0x328: 0xf11e ADD I, V1
+	*c.I += uint16(c.V[0x1])
+	// 0x32a: 0xf065 LD V0, [I]
+	c.Exec(0x32a)
+	// 0x32c: 0x8100 LD V1, V0
+	c.V[0x1] = c.V[0x0]
+	// 0x32e: 0xc20f RND V2, byte
+	c.Exec(0x32e)
+	// 0x330: 0x7201 ADD V2, 0x01
+	c.V[0x2] += 0x01
+	*c.PC = 0x332
+}
+
+func block334(c *chip8.Context) {
+	// 0x334: 0xa41a LD I, 0x41a
+	*c.I = 0x41a
+	// 0x336: 0x6c03 LD Vc, 0x03
+	c.V[0xc] = 0x03
+	// 0x338: 0x72ff ADD V2, 0xff
+	c.V[0x2] += 0xff
+	// 0x33a: 0x6f00 LD Vf, 0x00
+	c.V[0xf] = 0x00
+	// 0x33c: 0xd345 DRW V3, V4, 0x5
+	c.Exec(0x33c)
+	*c.PC = 0x33e
+}
+
+func block33e(c *chip8.Context) {
+	// 0x33e: 0xa21d LD I, 0x21d
+	*c.I = 0x21d
+	// 0x340: 0xf555 LD [I], V5
+	c.Exec(0x340)
+	*c.PC = 0x342
+}
+
+func block344(c *chip8.Context) {
+	// 0x344: 0xc407 RND V4, byte
+	c.Exec(0x344)
+	// 0x346: 0xa41f LD I, 0x41f
+	*c.I = 0x41f
+	// 0x348: 0xf41e ADD I, V4
+	*c.I += uint16(c.V[0x4])
+	// 0x34a: 0xf065 LD V0, [I]
+	c.Exec(0x34a)
+	// 0x34c: 0x8300 LD V3, V0
+	c.V[0x3] = c.V[0x0]
+	// 0x34e: 0xa427 LD I, 0x427
+	*c.I = 0x427
+	// 0x350: 0xf41e ADD I, V4
+	*c.I += uint16(c.V[0x4])
+	// 0x352: 0xf065 LD V0, [I]
+	c.Exec(0x352)
+	// 0x354: 0x8400 LD V4, V0
+	c.V[0x4] = c.V[0x0]
+	// 0x356: 0xa41a LD I, 0x41a
+	*c.I = 0x41a
+	*c.PC = 0x358
+}
+
+func block362(c *chip8.Context) {
+	// 0x362: 0x6500 LD V5, 0x00
+	c.V[0x5] = 0x00
+	// 0x364: 0x133e JP 0x33e
+	*c.PC = 0x33e
+}
+
+func block366(c *chip8.Context) {
+	// 0x366: 0x4c01 SNE Vc, 0x01
+	if c.V[0xc] != 0x01 {
+		*c.PC = 0x36a
+		return
+	}
+	*c.PC = 0x368
+}
+
+func block368(c *chip8.Context) {
+	// 0x368: 0x1202 JP 0x202
+	*c.PC = 0x202
+}
+
+func block36a(c *chip8.Context) {
+	// 0x36a: 0x4c02 SNE Vc, 0x02
+	if c.V[0xc] != 0x02 {
+		*c.PC = 0x36e
+		return
+	}
+	*c.PC = 0x36c
+}
+
+func block36c(c *chip8.Context) {
+	// 0x36c: 0x1382 JP 0x382
+	*c.PC = 0x382
+}
+
+func block36e(c *chip8.Context) {
+	// 0x36e: 0xa223 LD I, 0x223
+	*c.I = 0x223
+	// 0x370: 0xf565 LD V5, [I]
+	c.Exec(0x370)
+	// 0x372: 0x4500 SNE V5, 0x00
+	if c.V[0x5] != 0x00 {
+		*c.PC = 0x376
+		return
+	}
+	*c.PC = 0x374
+}
+
+func block374(c *chip8.Context) {
+	// 0x374: 0x1202 JP 0x202
+	*c.PC = 0x202
+}
+
+func block376(c *chip8.Context) {
+	// 0x376: 0xa419 LD I, 0x419
+	*c.I = 0x419
+	// 0x378: 0xd341 DRW V3, V4, 0x1
+	c.Exec(0x378)
+	// 0x37a: 0x6f00 LD Vf, 0x00
+	c.V[0xf] = 0x00
+	// 0x37c: 0xd341 DRW V3, V4, 0x1
+	c.Exec(0x37c)
+	// 0x37e: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x382
+		return
+	}
+	*c.PC = 0x380
+}
+
+func block380(c *chip8.Context) {
+	// 0x380: 0x1202 JP 0x202
+	*c.PC = 0x202
+}
+
+func block382(c *chip8.Context) {
+	// 0x382: 0x7e0a ADD Ve, 0x0a
+	c.V[0xe] += 0x0a
+	*c.PC = 0x384
+}
+
+func block384(c *chip8.Context) {
+	// 0x384: 0x6040 LD V0, 0x40
+	c.V[0x0] = 0x40
+	// 0x386: 0xf018 LD ST, V0
+	*c.ST = c.V[0x0]
+	// 0x388: 0x00e0 CLS
+	c.Exec(0x388)
+	// 0x38a: 0x124a JP 0x24a
+	*c.PC = 0x24a
+}
+
+func block38c(c *chip8.Context) {
+	// 0x38c: 0x00e0 CLS
+	c.Exec(0x38c)
+	*c.PC = 0x38e
+}
+
+func block390(c *chip8.Context) {
+	// 0x390: 0x6060 LD V0, 0x60
+	c.V[0x0] = 0x60
+	// 0x392: 0xf018 LD ST, V0
+	*c.ST = c.V[0x0]
+	*c.PC = 0x394
+}
+
+func block394(c *chip8.Context) {
+	// 0x394: 0x1394 JP 0x394
+	*c.PC = 0x394
+}
+
+func block39a(c *chip8.Context) {
+	// 0x39a: 0x4101 SNE V1, 0x01
+	if c.V[0x1] != 0x01 {
+		*c.PC = 0x39e
+		return
+	}
+	*c.PC = 0x39c
+}
+
+func block39c(c *chip8.Context) {
+	// 0x39c: 0x74ff ADD V4, 0xff
+	c.V[0x4] += 0xff
+	*c.PC = 0x39e
+}
+
+func block39e(c *chip8.Context) {
+	// 0x39e: 0x4104 SNE V1, 0x04
+	if c.V[0x1] != 0x04 {
+		*c.PC = 0x3a2
+		return
+	}
+	*c.PC = 0x3a0
+}
+
+func block3a0(c *chip8.Context) {
+	// 0x3a0: 0x73ff ADD V3, 0xff
+	c.V[0x3] += 0xff
+	*c.PC = 0x3a2
+}
+
+func block3a2(c *chip8.Context) {
+	// 0x3a2: 0x4106 SNE V1, 0x06
+	if c.V[0x1] != 0x06 {
+		*c.PC = 0x3a6
+		return
+	}
+	*c.PC = 0x3a4
+}
+
+func block3a4(c *chip8.Context) {
+	// 0x3a4: 0x7301 ADD V3, 0x01
+	c.V[0x3] += 0x01
+	*c.PC = 0x3a6
+}
+
+func block3a6(c *chip8.Context) {
+	// 0x3a6: 0x4109 SNE V1, 0x09
+	if c.V[0x1] != 0x09 {
+		*c.PC = 0x3aa
+		return
+	}
+	*c.PC = 0x3a8
+}
+
+func block3a8(c *chip8.Context) {
+	// 0x3a8: 0x7401 ADD V4, 0x01
+	c.V[0x4] += 0x01
+	*c.PC = 0x3aa
+}
+
+func block3ac(c *chip8.Context) {
+	// 0x3ac: 0x4400 SNE V4, 0x00
+	if c.V[0x4] != 0x00 {
+		*c.PC = 0x3b0
+		return
+	}
+	*c.PC = 0x3ae
+}
+
+func block3ae(c *chip8.Context) {
+	// 0x3ae: 0x7401 ADD V4, 0x01
+	c.V[0x4] += 0x01
+	*c.PC = 0x3b0
+}
+
+func block3b0(c *chip8.Context) {
+	// 0x3b0: 0x4300 SNE V3, 0x00
+	if c.V[0x3] != 0x00 {
+		*c.PC = 0x3b4
+		return
+	}
+	*c.PC = 0x3b2
+}
+
+func block3b2(c *chip8.Context) {
+	// 0x3b2: 0x7301 ADD V3, 0x01
+	c.V[0x3] += 0x01
+	*c.PC = 0x3b4
+}
+
+func block3b4(c *chip8.Context) {
+	// 0x3b4: 0x4338 SNE V3, 0x38
+	if c.V[0x3] != 0x38 {
+		*c.PC = 0x3b8
+		return
+	}
+	*c.PC = 0x3b6
+}
+
+func block3b6(c *chip8.Context) {
+	// 0x3b6: 0x73ff ADD V3, 0xff
+	c.V[0x3] += 0xff
+	*c.PC = 0x3b8
+}
+
+func block3b8(c *chip8.Context) {
+	// 0x3b8: 0x4418 SNE V4, 0x18
+	if c.V[0x4] != 0x18 {
+		*c.PC = 0x3bc
+		return
+	}
+	*c.PC = 0x3ba
+}
+
+func block3ba(c *chip8.Context) {
+	// 0x3ba: 0x74ff ADD V4, 0xff
+	c.V[0x4] += 0xff
+	*c.PC = 0x3bc
+}
+
+func block3be(c *chip8.Context) {
+	// 0x3be: 0x6b00 LD Vb, 0x00
+	c.V[0xb] = 0x00
+	// 0x3c0: 0x4400 SNE V4, 0x00
+	if c.V[0x4] != 0x00 {
+		*c.PC = 0x3c4
+		return
+	}
+	*c.PC = 0x3c2
+}
+
+func block3c2(c *chip8.Context) {
+	// 0x3c2: 0x13ce JP 0x3ce
+	*c.PC = 0x3ce
+}
+
+func block3c4(c *chip8.Context) {
+	// 0x3c4: 0x4300 SNE V3, 0x00
+	if c.V[0x3] != 0x00 {
+		*c.PC = 0x3c8
+		return
+	}
+	*c.PC = 0x3c6
+}
+
+func block3c6(c *chip8.Context) {
+	// 0x3c6: 0x13ce JP 0x3ce
+	*c.PC = 0x3ce
+}
+
+func block3c8(c *chip8.Context) {
+	// 0x3c8: 0x433f SNE V3, 0x3f
+	if c.V[0x3] != 0x3f {
+		*c.PC = 0x3cc
+		return
+	}
+	*c.PC = 0x3ca
+}
+
+func block3ca(c *chip8.Context) {
+	// 0x3ca: 0x13ce JP 0x3ce
+	*c.PC = 0x3ce
+}
+
+func block3cc(c *chip8.Context) {
+	// 0x3cc: 0x441f SNE V4, 0x1f
+	if c.V[0x4] != 0x1f {
+		*c.PC = 0x3d0
+		return
+	}
+	*c.PC = 0x3ce
+}
+
+func block3ce(c *chip8.Context) {
+	// 0x3ce: 0x6bbb LD Vb, 0xbb
+	c.V[0xb] = 0xbb
+	*c.PC = 0x3d0
+}
+
+func block3d0(c *chip8.Context) {
+	// 0x3d0: 0x6f00 LD Vf, 0x00
+	c.V[0xf] = 0x00
+	*c.PC = 0x3d2
+}
+
+func block3d4(c *chip8.Context) {
+	// 0x3d4: 0x6308 LD V3, 0x08
+	c.V[0x3] = 0x08
+	// 0x3d6: 0x6408 LD V4, 0x08
+	c.V[0x4] = 0x08
+	// 0x3d8: 0xa229 LD I, 0x229
+	*c.I = 0x229
+	// 0x3da: 0xfe33 LD B, Ve
+	c.Exec(0x3da)
+	*c.PC = 0x3dc
+}
+
+func block3dc(c *chip8.Context) {
+	// 0x3dc: 0xf265 LD V2, [I]
+	c.Exec(0x3dc)
+	*c.PC = 0x3de
+}
+
+func block3e0(c *chip8.Context) {
+	// 0x3e0: 0x6328 LD V3, 0x28
+	c.V[0x3] = 0x28
+	// 0x3e2: 0xa229 LD I, 0x229
+	*c.I = 0x229
+	// 0x3e4: 0xf633 LD B, V6
+	c.Exec(0x3e4)
+	*c.PC = 0x3e6
+}
+
+func block3e6(c *chip8.Context) {
+	// 0x3e6: 0xf265 LD V2, [I]
+	c.Exec(0x3e6)
+	*c.PC = 0x3e8
+}
+
+func block3ec(c *chip8.Context) {
+	// 0x3ec: 0xf029 LD F, V0
+	*c.I = uint16(c.V[0x0] * 5)
+	// 0x3ee: 0xd345 DRW V3, V4, 0x5
+	c.Exec(0x3ee)
+	// 0x3f0: 0x7306 ADD V3, 0x06
+	c.V[0x3] += 0x06
+	*c.PC = 0x3f2
+}
+
+func block3f2(c *chip8.Context) {
+	// 0x3f2: 0xf129 LD F, V1
+	*c.I = uint16(c.V[0x1] * 5)
+	// 0x3f4: 0xd345 DRW V3, V4, 0x5
+	c.Exec(0x3f4)
+	// 0x3f6: 0x7306 ADD V3, 0x06
+	c.V[0x3] += 0x06
+	// 0x3f8: 0xf229 LD F, V2
+	*c.I = uint16(c.V[0x2] * 5)
+	// 0x3fa: 0xd345 DRW V3, V4, 0x5
+	c.Exec(0x3fa)
+	*c.PC = 0x3fc
+}
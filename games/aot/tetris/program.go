@@ -0,0 +1,805 @@
+// Code generated by go-chip8 recompile from TETRIS; DO NOT EDIT.
+
+package tetris
+
+import "github.com/morinokami/go-chip8/chip8"
+
+var Program = &chip8.Program{
+	ROM: "667cb026dee03f59f3a2fd81a2ffeab47da87731883f9601d37ba019976f94dd",
+	Blocks: map[uint16]*chip8.Block{
+		0x200: {Insts: []chip8.Instruction{chip8.LDIAddr}, Run: block200},
+		0x206: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.DRW, chip8.SEVxByte}, Run: block206},
+		0x20c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block20c},
+		0x20e: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.DRW, chip8.LDVxByte, chip8.DRW, chip8.LDVxByte, chip8.SEVxByte}, Run: block20e},
+		0x21a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block21a},
+		0x21c: {Insts: []chip8.Instruction{chip8.RND, chip8.SNEVxByte}, Run: block21c},
+		0x220: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block220},
+		0x222: {Insts: []chip8.Instruction{chip8.RND, chip8.LDVxByte, chip8.LDVxByte}, Run: block222},
+		0x22a: {Insts: []chip8.Instruction{chip8.LDDTVx, chip8.DRW, chip8.SEVxByte}, Run: block22a},
+		0x230: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block230},
+		0x232: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.DRW}, Run: block232},
+		0x23a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block23a},
+		0x23c: {Insts: []chip8.Instruction{chip8.SKNP}, Run: block23c},
+		0x240: {Insts: []chip8.Instruction{chip8.SKNP}, Run: block240},
+		0x244: {Insts: []chip8.Instruction{chip8.SKNP}, Run: block244},
+		0x248: {Insts: []chip8.Instruction{chip8.SKP}, Run: block248},
+		0x24a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block24a},
+		0x24c: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDDTVx}, Run: block24c},
+		0x250: {Insts: []chip8.Instruction{chip8.LDVxDT, chip8.SEVxByte}, Run: block250},
+		0x254: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block254},
+		0x256: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.JPAddr}, Run: block256},
+		0x25c: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxByte, chip8.SNEVxByte}, Run: block25c},
+		0x264: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block264},
+		0x266: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block266},
+		0x268: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block268},
+		0x26a: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block26a},
+		0x26c: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block26c},
+		0x26e: {Insts: []chip8.Instruction{chip8.ADDIVx}, Run: block26e},
+		0x272: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte}, Run: block272},
+		0x278: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block278},
+		0x27c: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte}, Run: block27c},
+		0x284: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte}, Run: block284},
+		0x28a: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block28a},
+		0x28e: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte}, Run: block28e},
+		0x296: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.SNEVxByte}, Run: block296},
+		0x29c: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block29c},
+		0x2a2: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block2a2},
+		0x2a6: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.SNEVxByte}, Run: block2a6},
+		0x2ac: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2ac},
+		0x2b6: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block2b6},
+		0x334: {Insts: []chip8.Instruction{chip8.DRW, chip8.LDVxByte}, Run: block334},
+		0x338: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SEVxByte}, Run: block338},
+		0x33c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block33c},
+		0x340: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxVy, chip8.SEVxByte}, Run: block340},
+		0x346: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block346},
+		0x348: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block348},
+		0x34a: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block34a},
+		0x34c: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block34c},
+		0x34e: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block34e},
+		0x352: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block352},
+		0x356: {Insts: []chip8.Instruction{chip8.SNEVxVy}, Run: block356},
+		0x35a: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.JPAddr}, Run: block35a},
+		0x35e: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte}, Run: block35e},
+		0x362: {Insts: []chip8.Instruction{chip8.DRW, chip8.SEVxByte}, Run: block362},
+		0x366: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block366},
+		0x368: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.SEVxByte}, Run: block368},
+		0x36e: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block36e},
+		0x372: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block372},
+		0x374: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.SEVxByte}, Run: block374},
+		0x37a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block37a},
+		0x37c: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxVy, chip8.ADDVxByte}, Run: block37c},
+		0x382: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte}, Run: block382},
+		0x386: {Insts: []chip8.Instruction{chip8.DRW, chip8.SEVxByte}, Run: block386},
+		0x38a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block38a},
+		0x38c: {Insts: []chip8.Instruction{chip8.DRW, chip8.JPAddr}, Run: block38c},
+		0x390: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte}, Run: block390},
+		0x394: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SEVxByte}, Run: block394},
+		0x398: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block398},
+		0x39a: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block39a},
+		0x39c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block39c},
+		0x39e: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.ADDVxByte, chip8.SEVxByte}, Run: block39e},
+		0x3a4: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block3a4},
+		0x3a8: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block3a8},
+		0x3ac: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block3ac},
+		0x3b0: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxByte, chip8.AND, chip8.SNEVxByte}, Run: block3b0},
+		0x3b8: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block3b8},
+		0x3ba: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block3ba},
+		0x3bc: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block3bc},
+		0x3c0: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDIVx}, Run: block3c0},
+		0x3c4: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDBVx}, Run: block3c4},
+		0x3c8: {Insts: []chip8.Instruction{chip8.LDVxI, chip8.LDFVx, chip8.LDVxByte, chip8.LDVxByte, chip8.DRW, chip8.ADDVxByte, chip8.LDFVx, chip8.DRW, chip8.ADDVxByte, chip8.LDFVx}, Run: block3c8},
+		0x3e6: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte}, Run: block3e6},
+	},
+}
+
+func block200(c *chip8.Context) {
+	// 0x200: 0xa2b4 LD I, 0x2b4
+	*c.I = 0x2b4
+	*c.PC = 0x202
+}
+
+func block206(c *chip8.Context) {
+	// 0x206: 0x7001 ADD V0, 0x01
+	c.V[0x0] += 0x01
+	// 0x208: 0xd011 DRW V0, V1, 0x1
+	c.Exec(0x208)
+	// 0x20a: 0x3025 SE V0, 0x25
+	if c.V[0x0] == 0x25 {
+		*c.PC = 0x20e
+		return
+	}
+	*c.PC = 0x20c
+}
+
+func block20c(c *chip8.Context) {
+	// 0x20c: 0x1206 JP 0x206
+	*c.PC = 0x206
+}
+
+func block20e(c *chip8.Context) {
+	// 0x20e: 0x71ff ADD V1, 0xff
+	c.V[0x1] += 0xff
+	// 0x210: 0xd011 DRW V0, V1, 0x1
+	c.Exec(0x210)
+	// 0x212: 0x601a LD V0, 0x1a
+	c.V[0x0] = 0x1a
+	// 0x214: 0xd011 DRW V0, V1, 0x1
+	c.Exec(0x214)
+	// 0x216: 0x6025 LD V0, 0x25
+	c.V[0x0] = 0x25
+	// 0x218: 0x3100 SE V1, 0x00
+	if c.V[0x1] == 0x00 {
+		*c.PC = 0x21c
+		return
+	}
+	*c.PC = 0x21a
+}
+
+func block21a(c *chip8.Context) {
+	// 0x21a: 0x120e JP 0x20e
+	*c.PC = 0x20e
+}
+
+func block21c(c *chip8.Context) {
+	// 0x21c: 0xc470 RND V4, byte
+	c.Exec(0x21c)
+	// 0x21e: 0x4470 SNE V4, 0x70
+	if c.V[0x4] != 0x70 {
+		*c.PC = 0x222
+		return
+	}
+	*c.PC = 0x220
+}
+
+func block220(c *chip8.Context) {
+	// 0x220: 0x121c JP 0x21c
+	*c.PC = 0x21c
+}
+
+func block222(c *chip8.Context) {
+	// 0x222: 0xc303 RND V3, byte
+	c.Exec(0x222)
+	// 0x224: 0x601e LD V0, 0x1e
+	c.V[0x0] = 0x1e
+	// 0x226: 0x6103 LD V1, 0x03
+	c.V[0x1] = 0x03
+	*c.PC = 0x228
+}
+
+func block22a(c *chip8.Context) {
+	// 0x22a: 0xf515 LD DT, V5
+	*c.DT = c.V[0x5]
+	// 0x22c: 0xd014 DRW V0, V1, 0x4
+	c.Exec(0x22c)
+	// 0x22e: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x232
+		return
+	}
+	*c.PC = 0x230
+}
+
+func block230(c *chip8.Context) {
+	// 0x230: 0x123c JP 0x23c
+	*c.PC = 0x23c
+}
+
+func block232(c *chip8.Context) {
+	// 0x232: 0xd014 DRW V0, V1, 0x4
+	c.Exec(0x232)
+	// 0x234: 0x71ff ADD V1, 0xff
+	c.V[0x1] += 0xff
+	// 0x236: 0xd014 DRW V0, V1, 0x4
+	c.Exec(0x236)
+	*c.PC = 0x238
+}
+
+func block23a(c *chip8.Context) {
+	// 0x23a: 0x121c JP 0x21c
+	*c.PC = 0x21c
+}
+
+func block23c(c *chip8.Context) {
+	// 0x23c: 0xe7a1 SKNP V7
+	if !c.Pressed(c.V[0x7]) {
+		*c.PC = 0x240
+		return
+	}
+	*c.PC = 0x23e
+}
+
+func block240(c *chip8.Context) {
+	// 0x240: 0xe8a1 SKNP V8
+	if !c.Pressed(c.V[0x8]) {
+		*c.PC = 0x244
+		return
+	}
+	*c.PC = 0x242
+}
+
+func block244(c *chip8.Context) {
+	// 0x244: 0xe9a1 SKNP V9
+	if !c.Pressed(c.V[0x9]) {
+		*c.PC = 0x248
+		return
+	}
+	*c.PC = 0x246
+}
+
+func block248(c *chip8.Context) {
+	// 0x248: 0xe29e SKP V2
+	if c.Pressed(c.V[0x2]) {
+		*c.PC = 0x24c
+		return
+	}
+	*c.PC = 0x24a
+}
+
+func block24a(c *chip8.Context) {
+	// 0x24a: 0x1250 JP 0x250
+	*c.PC = 0x250
+}
+
+func block24c(c *chip8.Context) {
+	// 0x24c: 0x6600 LD V6, 0x00
+	c.V[0x6] = 0x00
+	// 0x24e: 0xf615 LD DT, V6
+	*c.DT = c.V[0x6]
+	*c.PC = 0x250
+}
+
+func block250(c *chip8.Context) {
+	// 0x250: 0xf607 LD V6, DT
+	c.V[0x6] = *c.DT
+	// 0x252: 0x3600 SE V6, 0x00
+	if c.V[0x6] == 0x00 {
+		*c.PC = 0x256
+		return
+	}
+	*c.PC = 0x254
+}
+
+func block254(c *chip8.Context) {
+	// 0x254: 0x123c JP 0x23c
+	*c.PC = 0x23c
+}
+
+func block256(c *chip8.Context) {
+	// 0x256: 0xd014 DRW V0, V1, 0x4
+	c.Exec(0x256)
+	// 0x258: 0x7101 ADD V1, 0x01
+	c.V[0x1] += 0x01
+	// 0x25a: 0x122a JP 0x22a
+	*c.PC = 0x22a
+}
+
+func block25c(c *chip8.Context) {
+	// 0x25c: 0xa2c4 LD I, 0x2c4
+	*c.I = 0x2c4
+	// 0x25e: 0xf41e ADD I, V4
+	*c.I += uint16(c.V[0x4])
+	// 0x260: 0x6600 LD V6, 0x00
+	c.V[0x6] = 0x00
+	// 0x262: 0x4301 SNE V3, 0x01
+	if c.V[0x3] != 0x01 {
+		*c.PC = 0x266
+		return
+	}
+	*c.PC = 0x264
+}
+
+func block264(c *chip8.Context) {
+	// 0x264: 0x6604 LD V6, 0x04
+	c.V[0x6] = 0x04
+	*c.PC = 0x266
+}
+
+func block266(c *chip8.Context) {
+	// 0x266: 0x4302 SNE V3, 0x02
+	if c.V[0x3] != 0x02 {
+		*c.PC = 0x26a
+		return
+	}
+	*c.PC = 0x268
+}
+
+func block268(c *chip8.Context) {
+	// 0x268: 0x6608 LD V6, 0x08
+	c.V[0x6] = 0x08
+	*c.PC = 0x26a
+}
+
+func block26a(c *chip8.Context) {
+	// 0x26a: 0x4303 SNE V3, 0x03
+	if c.V[0x3] != 0x03 {
+		*c.PC = 0x26e
+		return
+	}
+	*c.PC = 0x26c
+}
+
+func block26c(c *chip8.Context) {
+	// 0x26c: 0x660c LD V6, 0x0c
+	c.V[0x6] = 0x0c
+	*c.PC = 0x26e
+}
+
+func block26e(c *chip8.Context) {
+	// 0x26e: 0xf61e ADD I, V6
+	*c.I += uint16(c.V[0x6])
+	*c.PC = 0x270
+}
+
+func block272(c *chip8.Context) {
+	// 0x272: 0xd014 DRW V0, V1, 0x4
+	c.Exec(0x272)
+	// 0x274: 0x70ff ADD V0, 0xff
+	c.V[0x0] += 0xff
+	*c.PC = 0x276
+}
+
+func block278(c *chip8.Context) {
+	// 0x278: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x27c
+		return
+	}
+	*c.PC = 0x27a
+}
+
+func block27c(c *chip8.Context) {
+	// 0x27c: 0xd014 DRW V0, V1, 0x4
+	c.Exec(0x27c)
+	// 0x27e: 0x7001 ADD V0, 0x01
+	c.V[0x0] += 0x01
+	*c.PC = 0x280
+}
+
+func block284(c *chip8.Context) {
+	// 0x284: 0xd014 DRW V0, V1, 0x4
+	c.Exec(0x284)
+	// 0x286: 0x7001 ADD V0, 0x01
+	c.V[0x0] += 0x01
+	*c.PC = 0x288
+}
+
+func block28a(c *chip8.Context) {
+	// 0x28a: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x28e
+		return
+	}
+	*c.PC = 0x28c
+}
+
+func block28e(c *chip8.Context) {
+	// 0x28e: 0xd014 DRW V0, V1, 0x4
+	c.Exec(0x28e)
+	// 0x290: 0x70ff ADD V0, 0xff
+	c.V[0x0] += 0xff
+	*c.PC = 0x292
+}
+
+func block296(c *chip8.Context) {
+	// 0x296: 0xd014 DRW V0, V1, 0x4
+	c.Exec(0x296)
+	// 0x298: 0x7301 ADD V3, 0x01
+	c.V[0x3] += 0x01
+	// 0x29a: 0x4304 SNE V3, 0x04
+	if c.V[0x3] != 0x04 {
+		*c.PC = 0x29e
+		return
+	}
+	*c.PC = 0x29c
+}
+
+func block29c(c *chip8.Context) {
+	// 0x29c: 0x6300 LD V3, 0x00
+	c.V[0x3] = 0x00
+	*c.PC = 0x29e
+}
+
+func block2a2(c *chip8.Context) {
+	// 0x2a2: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x2a6
+		return
+	}
+	*c.PC = 0x2a4
+}
+
+func block2a6(c *chip8.Context) {
+	// 0x2a6: 0xd014 DRW V0, V1, 0x4
+	c.Exec(0x2a6)
+	// 0x2a8: 0x73ff ADD V3, 0xff
+	c.V[0x3] += 0xff
+	// 0x2aa: 0x43ff SNE V3, 0xff
+	if c.V[0x3] != 0xff {
+		*c.PC = 0x2ae
+		return
+	}
+	*c.PC = 0x2ac
+}
+
+func block2ac(c *chip8.Context) {
+	// 0x2ac: 0x6303 LD V3, 0x03
+	c.V[0x3] = 0x03
+	*c.PC = 0x2ae
+}
+
+func block2b6(c *chip8.Context) {
+	// 0x2b6: 0x6705 LD V7, 0x05
+	c.V[0x7] = 0x05
+	// 0x2b8: 0x6806 LD V8, 0x06
+	c.V[0x8] = 0x06
+	// 0x2ba: 0x6904 LD V9, 0x04
+	c.V[0x9] = 0x04
+	// 0x2bc: 0x611f LD V1, 0x1f
+	c.V[0x1] = 0x1f
+	// 0x2be: 0x6510 LD V5, 0x10
+	c.V[0x5] = 0x10
+	// 0x2c0: 0x6207 LD V2, 0x07
+	c.V[0x2] = 0x07
+	*c.PC = 0x2c2
+}
+
+func block334(c *chip8.Context) {
+	// 0x334: 0xd014 DRW V0, V1, 0x4
+	c.Exec(0x334)
+	// 0x336: 0x6635 LD V6, 0x35
+	c.V[0x6] = 0x35
+	*c.PC = 0x338
+}
+
+func block338(c *chip8.Context) {
+	// 0x338: 0x76ff ADD V6, 0xff
+	c.V[0x6] += 0xff
+	// 0x33a: 0x3600 SE V6, 0x00
+	if c.V[0x6] == 0x00 {
+		*c.PC = 0x33e
+		return
+	}
+	*c.PC = 0x33c
+}
+
+func block33c(c *chip8.Context) {
+	// 0x33c: 0x1338 JP 0x338
+	*c.PC = 0x338
+}
+
+func block340(c *chip8.Context) {
+	// 0x340: 0xa2b4 LD I, 0x2b4
+	*c.I = 0x2b4
+	// 0x342: 0x8c10 LD Vc, V1
+	c.V[0xc] = c.V[0x1]
+	// 0x344: 0x3c1e SE Vc, 0x1e
+	if c.V[0xc] == 0x1e {
+		*c.PC = 0x348
+		return
+	}
+	*c.PC = 0x346
+}
+
+func block346(c *chip8.Context) {
+	// 0x346: 0x7c01 ADD Vc, 0x01
+	c.V[0xc] += 0x01
+	*c.PC = 0x348
+}
+
+func block348(c *chip8.Context) {
+	// 0x348: 0x3c1e SE Vc, 0x1e
+	if c.V[0xc] == 0x1e {
+		*c.PC = 0x34c
+		return
+	}
+	*c.PC = 0x34a
+}
+
+func block34a(c *chip8.Context) {
+	// 0x34a: 0x7c01 ADD Vc, 0x01
+	c.V[0xc] += 0x01
+	*c.PC = 0x34c
+}
+
+func block34c(c *chip8.Context) {
+	// 0x34c: 0x3c1e SE Vc, 0x1e
+	if c.V[0xc] == 0x1e {
+		*c.PC = 0x350
+		return
+	}
+	*c.PC = 0x34e
+}
+
+func block34e(c *chip8.Context) {
+	// 0x34e: 0x7c01 ADD Vc, 0x01
+	c.V[0xc] += 0x01
+	*c.PC = 0x350
+}
+
+func block352(c *chip8.Context) {
+	// 0x352: 0x4b0a SNE Vb, 0x0a
+	if c.V[0xb] != 0x0a {
+		*c.PC = 0x356
+		return
+	}
+	*c.PC = 0x354
+}
+
+func block356(c *chip8.Context) {
+	// 0x356: 0x91c0 SNE V1, Vc
+	if c.V[0x1] != c.V[0xc] {
+		*c.PC = 0x35a
+		return
+	}
+	*c.PC = 0x358
+}
+
+func block35a(c *chip8.Context) {
+	// 0x35a: 0x7101 ADD V1, 0x01
+	c.V[0x1] += 0x01
+	// 0x35c: 0x1350 JP 0x350
+	*c.PC = 0x350
+}
+
+func block35e(c *chip8.Context) {
+	// 0x35e: 0x601b LD V0, 0x1b
+	c.V[0x0] = 0x1b
+	// 0x360: 0x6b00 LD Vb, 0x00
+	c.V[0xb] = 0x00
+	*c.PC = 0x362
+}
+
+func block362(c *chip8.Context) {
+	// 0x362: 0xd011 DRW V0, V1, 0x1
+	c.Exec(0x362)
+	// 0x364: 0x3f00 SE Vf, 0x00
+	if c.V[0xf] == 0x00 {
+		*c.PC = 0x368
+		return
+	}
+	*c.PC = 0x366
+}
+
+func block366(c *chip8.Context) {
+	// 0x366: 0x7b01 ADD Vb, 0x01
+	c.V[0xb] += 0x01
+	*c.PC = 0x368
+}
+
+func block368(c *chip8.Context) {
+	// 0x368: 0xd011 DRW V0, V1, 0x1
+	c.Exec(0x368)
+	// 0x36a: 0x7001 ADD V0, 0x01
+	c.V[0x0] += 0x01
+	// 0x36c: 0x3025 SE V0, 0x25
+	if c.V[0x0] == 0x25 {
+		*c.PC = 0x370
+		return
+	}
+	*c.PC = 0x36e
+}
+
+func block36e(c *chip8.Context) {
+	// 0x36e: 0x1362 JP 0x362
+	*c.PC = 0x362
+}
+
+func block372(c *chip8.Context) {
+	// 0x372: 0x601b LD V0, 0x1b
+	c.V[0x0] = 0x1b
+	*c.PC = 0x374
+}
+
+func block374(c *chip8.Context) {
+	// 0x374: 0xd011 DRW V0, V1, 0x1
+	c.Exec(0x374)
+	// 0x376: 0x7001 ADD V0, 0x01
+	c.V[0x0] += 0x01
+	// 0x378: 0x3025 SE V0, 0x25
+	if c.V[0x0] == 0x25 {
+		*c.PC = 0x37c
+		return
+	}
+	*c.PC = 0x37a
+}
+
+func block37a(c *chip8.Context) {
+	// 0x37a: 0x1374 JP 0x374
+	*c.PC = 0x374
+}
+
+func block37c(c *chip8.Context) {
+	// 0x37c: 0x8e10 LD Ve, V1
+	c.V[0xe] = c.V[0x1]
+	// 0x37e: 0x8de0 LD Vd, Ve
+	c.V[0xd] = c.V[0xe]
+	// 0x380: 0x7eff ADD Ve, 0xff
+	c.V[0xe] += 0xff
+	*c.PC = 0x382
+}
+
+func block382(c *chip8.Context) {
+	// 0x382: 0x601b LD V0, 0x1b
+	c.V[0x0] = 0x1b
+	// 0x384: 0x6b00 LD Vb, 0x00
+	c.V[0xb] = 0x00
+	*c.PC = 0x386
+}
+
+func block386(c *chip8.Context) {
+	// 0x386: 0xd0e1 DRW V0, Ve, 0x1
+	c.Exec(0x386)
+	// 0x388: 0x3f00 SE Vf, 0x00
+	if c.V[0xf] == 0x00 {
+		*c.PC = 0x38c
+		return
+	}
+	*c.PC = 0x38a
+}
+
+func block38a(c *chip8.Context) {
+	// 0x38a: 0x1390 JP 0x390
+	*c.PC = 0x390
+}
+
+func block38c(c *chip8.Context) {
+	// 0x38c: 0xd0e1 DRW V0, Ve, 0x1
+	c.Exec(0x38c)
+	// 0x38e: 0x1394 JP 0x394
+	*c.PC = 0x394
+}
+
+func block390(c *chip8.Context) {
+	// 0x390: 0xd0d1 DRW V0, Vd, 0x1
+	c.Exec(0x390)
+	// 0x392: 0x7b01 ADD Vb, 0x01
+	c.V[0xb] += 0x01
+	*c.PC = 0x394
+}
+
+func block394(c *chip8.Context) {
+	// 0x394: 0x7001 ADD V0, 0x01
+	c.V[0x0] += 0x01
+	// 0x396: 0x3025 SE V0, 0x25
+	if c.V[0x0] == 0x25 {
+		*c.PC = 0x39a
+		return
+	}
+	*c.PC = 0x398
+}
+
+func block398(c *chip8.Context) {
+	// 0x398: 0x1386 JP 0x386
+	*c.PC = 0x386
+}
+
+func block39a(c *chip8.Context) {
+	// 0x39a: 0x4b00 SNE Vb, 0x00
+	if c.V[0xb] != 0x00 {
+		*c.PC = 0x39e
+		return
+	}
+	*c.PC = 0x39c
+}
+
+func block39c(c *chip8.Context) {
+	// 0x39c: 0x13a6 JP 0x3a6
+	*c.PC = 0x3a6
+}
+
+func block39e(c *chip8.Context) {
+	// 0x39e: 0x7dff ADD Vd, 0xff
+	c.V[0xd] += 0xff
+	// 0x3a0: 0x7eff ADD Ve, 0xff
+	c.V[0xe] += 0xff
+	// 0x3a2: 0x3d01 SE Vd, 0x01
+	if c.V[0xd] == 0x01 {
+		*c.PC = 0x3a6
+		return
+	}
+	*c.PC = 0x3a4
+}
+
+func block3a4(c *chip8.Context) {
+	// 0x3a4: 0x1382 JP 0x382
+	*c.PC = 0x382
+}
+
+func block3a8(c *chip8.Context) {
+	// 0x3a8: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x3ac
+		return
+	}
+	*c.PC = 0x3aa
+}
+
+func block3ac(c *chip8.Context) {
+	// 0x3ac: 0x7a01 ADD Va, 0x01
+	c.V[0xa] += 0x01
+	*c.PC = 0x3ae
+}
+
+func block3b0(c *chip8.Context) {
+	// 0x3b0: 0x80a0 LD V0, Va
+	c.V[0x0] = c.V[0xa]
+	// 0x3b2: 0x6d07 LD Vd, 0x07
+	c.V[0xd] = 0x07
+	// 0x3b4: 0x80d2 AND V0, Vd
+	c.V[0x0] &= c.V[0xd]
+	// 0x3b6: 0x4004 SNE V0, 0x04
+	if c.V[0x0] != 0x04 {
+		*c.PC = 0x3ba
+		return
+	}
+	*c.PC = 0x3b8
+}
+
+func block3b8(c *chip8.Context) {
+	// 0x3b8: 0x75fe ADD V5, 0xfe
+	c.V[0x5] += 0xfe
+	*c.PC = 0x3ba
+}
+
+func block3ba(c *chip8.Context) {
+	// 0x3ba: 0x4502 SNE V5, 0x02
+	if c.V[0x5] != 0x02 {
+		*c.PC = 0x3be
+		return
+	}
+	*c.PC = 0x3bc
+}
+
+func block3bc(c *chip8.Context) {
+	// 0x3bc: 0x6504 LD V5, 0x04
+	c.V[0x5] = 0x04
+	*c.PC = 0x3be
+}
+
+func block3c0(c *chip8.Context) {
+	// 0x3c0: 0xa700 LD I, 0x700
+	*c.I = 0x700
+	// 0x3c2: 0xf255 LD [I], V2
+	c.Exec(0x3c2)
+	*c.PC = 0x3c4
+}
+
+func block3c4(c *chip8.Context) {
+	// 0x3c4: 0xa804 LD I, 0x804
+	*c.I = 0x804
+	// 0x3c6: 0xfa33 LD B, Va
+	c.Exec(0x3c6)
+	*c.PC = 0x3c8
+}
+
+func block3c8(c *chip8.Context) {
+	// 0x3c8: 0xf265 LD V2, [I]
+	c.Exec(0x3c8)
+	// 0x3ca: 0xf029 LD F, V0
+	*c.I = uint16(c.V[0x0] * 5)
+	// 0x3cc: 0x6d32 LD Vd, 0x32
+	c.V[0xd] = 0x32
+	// 0x3ce: 0x6e00 LD Ve, 0x00
+	c.V[0xe] = 0x00
+	// 0x3d0: 0xdde5 DRW Vd, Ve, 0x5
+	c.Exec(0x3d0)
+	// 0x3d2: 0x7d05 ADD Vd, 0x05
+	c.V[0xd] += 0x05
+	// 0x3d4: 0xf129 LD F, V1
+	*c.I = uint16(c.V[0x1] * 5)
+	// 0x3d6: 0xdde5 DRW Vd, Ve, 0x5
+	c.Exec(0x3d6)
+	// 0x3d8: 0x7d05 ADD Vd, 0x05
+	c.V[0xd] += 0x05
+	// 0x3da: 0xf229 LD F, V2
+	*c.I = uint16(c.V[0x2] * 5)
+	*c.PC = 0x3dc
+}
+
+func block3e6(c *chip8.Context) {
+	// 0x3e6: 0x6a00 LD Va, 0x00
+	c.V[0xa] = 0x00
+	// 0x3e8: 0x6019 LD V0, 0x19
+	c.V[0x0] = 0x19
+	*c.PC = 0x3ea
+}
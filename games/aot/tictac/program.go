@@ -0,0 +1,706 @@
+// Code generated by go-chip8 recompile from TICTAC; DO NOT EDIT.
+
+package tictac
+
+import "github.com/morinokami/go-chip8/chip8"
+
+var Program = &chip8.Program{
+	ROM: "4a07eed424eb5bbea779386f1c600f61ec7f6125539f64e4073cae2aeba7c039",
+	Blocks: map[uint16]*chip8.Block{
+		0x200: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block200},
+		0x218: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte}, Run: block218},
+		0x21c: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxVy, chip8.LDIAddr, chip8.LDIVx}, Run: block21c},
+		0x224: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxI, chip8.LDIAddr, chip8.LDIVx}, Run: block224},
+		0x22c: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxI, chip8.LDVxVy, chip8.LDVxVy, chip8.CLS, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr}, Run: block22c},
+		0x23e: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.SEVxByte}, Run: block23e},
+		0x244: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block244},
+		0x246: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte, chip8.SEVxByte}, Run: block246},
+		0x24c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block24c},
+		0x24e: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr}, Run: block24e},
+		0x254: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.SEVxByte}, Run: block254},
+		0x25a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block25a},
+		0x25c: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxByte}, Run: block25c},
+		0x260: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.SEVxByte}, Run: block260},
+		0x266: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block266},
+		0x26c: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.SNEVxByte}, Run: block26c},
+		0x276: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block276},
+		0x27a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block27a},
+		0x27c: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDSTVx, chip8.LDDTVx}, Run: block27c},
+		0x282: {Insts: []chip8.Instruction{chip8.LDVxDT, chip8.SEVxByte}, Run: block282},
+		0x286: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block286},
+		0x28a: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.XOR, chip8.LDVxVy, chip8.LDIVx}, Run: block28a},
+		0x292: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxVy, chip8.ADDVxByte, chip8.ADDVxVy, chip8.ADDIVx, chip8.LDVxI, chip8.LDIAddr, chip8.SEVxByte}, Run: block292},
+		0x2a2: {Insts: []chip8.Instruction{chip8.LDIAddr}, Run: block2a2},
+		0x2a4: {Insts: []chip8.Instruction{chip8.DRW}, Run: block2a4},
+		0x2a8: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block2a8},
+		0x2aa: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2aa},
+		0x2ac: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block2ac},
+		0x2b4: {Insts: []chip8.Instruction{chip8.LDVxI, chip8.SEVxByte}, Run: block2b4},
+		0x2b8: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block2b8},
+		0x2ba: {Insts: []chip8.Instruction{chip8.ADDIVx, chip8.ADDVxByte, chip8.SEVxByte}, Run: block2ba},
+		0x2c0: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2c0},
+		0x2c2: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block2c2},
+		0x2c4: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2c4},
+		0x2c6: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2c6},
+		0x2c8: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDIAddr, chip8.LDVxByte, chip8.ADDIVx, chip8.LDVxI, chip8.LDVxByte, chip8.ADDVxVy}, Run: block2c8},
+		0x2d8: {Insts: []chip8.Instruction{chip8.ADDVxVy}, Run: block2d8},
+		0x2dc: {Insts: []chip8.Instruction{chip8.ADDVxVy}, Run: block2dc},
+		0x2e0: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxVy}, Run: block2e0},
+		0x2e6: {Insts: []chip8.Instruction{chip8.ADDVxVy}, Run: block2e6},
+		0x2ea: {Insts: []chip8.Instruction{chip8.ADDVxVy}, Run: block2ea},
+		0x2ee: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxVy}, Run: block2ee},
+		0x2f4: {Insts: []chip8.Instruction{chip8.ADDVxVy}, Run: block2f4},
+		0x2f8: {Insts: []chip8.Instruction{chip8.ADDVxVy}, Run: block2f8},
+		0x2fc: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxVy}, Run: block2fc},
+		0x302: {Insts: []chip8.Instruction{chip8.ADDVxVy}, Run: block302},
+		0x306: {Insts: []chip8.Instruction{chip8.ADDVxVy}, Run: block306},
+		0x30a: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxVy}, Run: block30a},
+		0x310: {Insts: []chip8.Instruction{chip8.ADDVxVy}, Run: block310},
+		0x314: {Insts: []chip8.Instruction{chip8.ADDVxVy}, Run: block314},
+		0x318: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxVy}, Run: block318},
+		0x31e: {Insts: []chip8.Instruction{chip8.ADDVxVy}, Run: block31e},
+		0x322: {Insts: []chip8.Instruction{chip8.ADDVxVy}, Run: block322},
+		0x326: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxVy}, Run: block326},
+		0x32c: {Insts: []chip8.Instruction{chip8.ADDVxVy}, Run: block32c},
+		0x330: {Insts: []chip8.Instruction{chip8.ADDVxVy}, Run: block330},
+		0x334: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.ADDVxVy}, Run: block334},
+		0x33a: {Insts: []chip8.Instruction{chip8.ADDVxVy}, Run: block33a},
+		0x33e: {Insts: []chip8.Instruction{chip8.ADDVxVy}, Run: block33e},
+		0x344: {Insts: []chip8.Instruction{chip8.SHL, chip8.SHL}, Run: block344},
+		0x34a: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block34a},
+		0x34c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block34c},
+		0x34e: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block34e},
+		0x350: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block350},
+		0x356: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.JPAddr}, Run: block356},
+		0x35c: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block35c},
+		0x360: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block360},
+		0x366: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr, chip8.DRW, chip8.LDVxByte, chip8.ADDVxByte, chip8.LDIAddr, chip8.LDBVx}, Run: block366},
+		0x378: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr, chip8.DRW, chip8.LDVxByte, chip8.ADDVxByte, chip8.LDIAddr, chip8.LDBVx}, Run: block378},
+		0x388: {Insts: []chip8.Instruction{chip8.LDVxI, chip8.LDFVx}, Run: block388},
+		0x38e: {Insts: []chip8.Instruction{chip8.LDFVx}, Run: block38e},
+		0x392: {Insts: []chip8.Instruction{chip8.LDFVx}, Run: block392},
+		0x394: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte}, Run: block394},
+	},
+}
+
+func block200(c *chip8.Context) {
+	// 0x200: 0x1218 JP 0x218
+	*c.PC = 0x218
+}
+
+func block218(c *chip8.Context) {
+	// 0x218: 0x6b00 LD Vb, 0x00
+	c.V[0xb] = 0x00
+	// 0x21a: 0x6c00 LD Vc, 0x00
+	c.V[0xc] = 0x00
+	*c.PC = 0x21c
+}
+
+func block21c(c *chip8.Context) {
+	// 0x21c: 0x80b0 LD V0, Vb
+	c.V[0x0] = c.V[0xb]
+	// 0x21e: 0x81c0 LD V1, Vc
+	c.V[0x1] = c.V[0xc]
+	// 0x220: 0xa3e6 LD I, 0x3e6
+	*c.I = 0x3e6
+	// 0x222: 0xf155 LD [I], V1
+	c.Exec(0x222)
+	*c.PC = 0x224
+}
+
+func block224(c *chip8.Context) {
+	// 0x224: 0xa3c4 LD I, 0x3c4
+	*c.I = 0x3c4
+	// 0x226: 0xff65 LD Vf, [I]
+	c.Exec(0x226)
+	// 0x228: 0xa3b4 LD I, 0x3b4
+	*c.I = 0x3b4
+	// 0x22a: 0xff55 LD [I], Vf
+	c.Exec(0x22a)
+	*c.PC = 0x22c
+}
+
+func block22c(c *chip8.Context) {
+	// 0x22c: 0xa3e6 LD I, 0x3e6
+	*c.I = 0x3e6
+	// 0x22e: 0xf165 LD V1, [I]
+	c.Exec(0x22e)
+	// 0x230: 0x8b00 LD Vb, V0
+	c.V[0xb] = c.V[0x0]
+	// 0x232: 0x8c10 LD Vc, V1
+	c.V[0xc] = c.V[0x1]
+	// 0x234: 0x00e0 CLS
+	c.Exec(0x234)
+	// 0x236: 0x6e01 LD Ve, 0x01
+	c.V[0xe] = 0x01
+	// 0x238: 0x6013 LD V0, 0x13
+	c.V[0x0] = 0x13
+	// 0x23a: 0x6103 LD V1, 0x03
+	c.V[0x1] = 0x03
+	// 0x23c: 0xa39a LD I, 0x39a
+	*c.I = 0x39a
+	*c.PC = 0x23e
+}
+
+func block23e(c *chip8.Context) {
+	// 0x23e: 0xd011 DRW V0, V1, 0x1
+	c.Exec(0x23e)
+	// 0x240: 0x7008 ADD V0, 0x08
+	c.V[0x0] += 0x08
+	// 0x242: 0x302b SE V0, 0x2b
+	if c.V[0x0] == 0x2b {
+		*c.PC = 0x246
+		return
+	}
+	*c.PC = 0x244
+}
+
+func block244(c *chip8.Context) {
+	// 0x244: 0x123e JP 0x23e
+	*c.PC = 0x23e
+}
+
+func block246(c *chip8.Context) {
+	// 0x246: 0x6013 LD V0, 0x13
+	c.V[0x0] = 0x13
+	// 0x248: 0x7108 ADD V1, 0x08
+	c.V[0x1] += 0x08
+	// 0x24a: 0x3123 SE V1, 0x23
+	if c.V[0x1] == 0x23 {
+		*c.PC = 0x24e
+		return
+	}
+	*c.PC = 0x24c
+}
+
+func block24c(c *chip8.Context) {
+	// 0x24c: 0x123e JP 0x23e
+	*c.PC = 0x23e
+}
+
+func block24e(c *chip8.Context) {
+	// 0x24e: 0x6013 LD V0, 0x13
+	c.V[0x0] = 0x13
+	// 0x250: 0x6103 LD V1, 0x03
+	c.V[0x1] = 0x03
+	// 0x252: 0xa39b LD I, 0x39b
+	*c.I = 0x39b
+	*c.PC = 0x254
+}
+
+func block254(c *chip8.Context) {
+	// 0x254: 0xd01f DRW V0, V1, 0xf
+	c.Exec(0x254)
+	// 0x256: 0x7008 ADD V0, 0x08
+	c.V[0x0] += 0x08
+	// 0x258: 0x3033 SE V0, 0x33
+	if c.V[0x0] == 0x33 {
+		*c.PC = 0x25c
+		return
+	}
+	*c.PC = 0x25a
+}
+
+func block25a(c *chip8.Context) {
+	// 0x25a: 0x1254 JP 0x254
+	*c.PC = 0x254
+}
+
+func block25c(c *chip8.Context) {
+	// 0x25c: 0x6013 LD V0, 0x13
+	c.V[0x0] = 0x13
+	// 0x25e: 0x710f ADD V1, 0x0f
+	c.V[0x1] += 0x0f
+	*c.PC = 0x260
+}
+
+func block260(c *chip8.Context) {
+	// 0x260: 0xd01a DRW V0, V1, 0xa
+	c.Exec(0x260)
+	// 0x262: 0x7008 ADD V0, 0x08
+	c.V[0x0] += 0x08
+	// 0x264: 0x3033 SE V0, 0x33
+	if c.V[0x0] == 0x33 {
+		*c.PC = 0x268
+		return
+	}
+	*c.PC = 0x266
+}
+
+func block266(c *chip8.Context) {
+	// 0x266: 0x1260 JP 0x260
+	*c.PC = 0x260
+}
+
+func block26c(c *chip8.Context) {
+	// 0x26c: 0x8100 LD V1, V0
+	c.V[0x1] = c.V[0x0]
+	// 0x26e: 0xa3b4 LD I, 0x3b4
+	*c.I = 0x3b4
+	// 0x270: 0xf01e ADD I, V0
+	*c.I += uint16(c.V[0x0])
+	// 0x272: 0xf065 LD V0, [I]
+	c.Exec(0x272)
+	// 0x274: 0x4000 SNE V0, 0x00
+	if c.V[0x0] != 0x00 {
+		*c.PC = 0x278
+		return
+	}
+	*c.PC = 0x276
+}
+
+func block276(c *chip8.Context) {
+	// 0x276: 0x128a JP 0x28a
+	*c.PC = 0x28a
+}
+
+func block27a(c *chip8.Context) {
+	// 0x27a: 0x126a JP 0x26a
+	*c.PC = 0x26a
+}
+
+func block27c(c *chip8.Context) {
+	// 0x27c: 0x6010 LD V0, 0x10
+	c.V[0x0] = 0x10
+	// 0x27e: 0xf018 LD ST, V0
+	*c.ST = c.V[0x0]
+	// 0x280: 0xf015 LD DT, V0
+	*c.DT = c.V[0x0]
+	*c.PC = 0x282
+}
+
+func block282(c *chip8.Context) {
+	// 0x282: 0xf007 LD V0, DT
+	c.V[0x0] = *c.DT
+	// 0x284: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x288
+		return
+	}
+	*c.PC = 0x286
+}
+
+func block286(c *chip8.Context) {
+	// 0x286: 0x1282 JP 0x282
+	*c.PC = 0x282
+}
+
+func block28a(c *chip8.Context) {
+	// 0x28a: 0x6002 LD V0, 0x02
+	c.V[0x0] = 0x02
+	// 0x28c: 0x8e03 XOR Ve, V0
+	c.V[0xe] ^= c.V[0x0]
+	// 0x28e: 0x80e0 LD V0, Ve
+	c.V[0x0] = c.V[0xe]
+	// 0x290: 0xf055 LD [I], V0
+	c.Exec(0x290)
+	*c.PC = 0x292
+}
+
+func block292(c *chip8.Context) {
+	// 0x292: 0xa3d4 LD I, 0x3d4
+	*c.I = 0x3d4
+	// 0x294: 0x8010 LD V0, V1
+	c.V[0x0] = c.V[0x1]
+	// 0x296: 0x70ff ADD V0, 0xff
+	c.V[0x0] += 0xff
+	// 0x298: 0x8004 ADD V0, V0
+	c.Exec(0x298)
+	// 0x29a: 0xf01e ADD I, V0
+	*c.I += uint16(c.V[0x0])
+	// 0x29c: 0xf165 LD V1, [I]
+	c.Exec(0x29c)
+	// 0x29e: 0xa3aa LD I, 0x3aa
+	*c.I = 0x3aa
+	// 0x2a0: 0x3e03 SE Ve, 0x03
+	if c.V[0xe] == 0x03 {
+		*c.PC = 0x2a4
+		return
+	}
+	*c.PC = 0x2a2
+}
+
+func block2a2(c *chip8.Context) {
+	// 0x2a2: 0xa3af LD I, 0x3af
+	*c.I = 0x3af
+	*c.PC = 0x2a4
+}
+
+func block2a4(c *chip8.Context) {
+	// 0x2a4: 0xd015 DRW V0, V1, 0x5
+	c.Exec(0x2a4)
+	*c.PC = 0x2a6
+}
+
+func block2a8(c *chip8.Context) {
+	// 0x2a8: 0x3a00 SE Va, 0x00
+	if c.V[0xa] == 0x00 {
+		*c.PC = 0x2ac
+		return
+	}
+	*c.PC = 0x2aa
+}
+
+func block2aa(c *chip8.Context) {
+	// 0x2aa: 0x121c JP 0x21c
+	*c.PC = 0x21c
+}
+
+func block2ac(c *chip8.Context) {
+	// 0x2ac: 0xa3b4 LD I, 0x3b4
+	*c.I = 0x3b4
+	// 0x2ae: 0x6100 LD V1, 0x00
+	c.V[0x1] = 0x00
+	// 0x2b0: 0x6200 LD V2, 0x00
+	c.V[0x2] = 0x00
+	// 0x2b2: 0x6301 LD V3, 0x01
+	c.V[0x3] = 0x01
+	*c.PC = 0x2b4
+}
+
+func block2b4(c *chip8.Context) {
+	// 0x2b4: 0xf065 LD V0, [I]
+	c.Exec(0x2b4)
+	// 0x2b6: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x2ba
+		return
+	}
+	*c.PC = 0x2b8
+}
+
+func block2b8(c *chip8.Context) {
+	// 0x2b8: 0x7101 ADD V1, 0x01
+	c.V[0x1] += 0x01
+	*c.PC = 0x2ba
+}
+
+func block2ba(c *chip8.Context) {
+	// 0x2ba: 0xf31e ADD I, V3
+	*c.I += uint16(c.V[0x3])
+	// 0x2bc: 0x7201 ADD V2, 0x01
+	c.V[0x2] += 0x01
+	// 0x2be: 0x3210 SE V2, 0x10
+	if c.V[0x2] == 0x10 {
+		*c.PC = 0x2c2
+		return
+	}
+	*c.PC = 0x2c0
+}
+
+func block2c0(c *chip8.Context) {
+	// 0x2c0: 0x12b4 JP 0x2b4
+	*c.PC = 0x2b4
+}
+
+func block2c2(c *chip8.Context) {
+	// 0x2c2: 0x3110 SE V1, 0x10
+	if c.V[0x1] == 0x10 {
+		*c.PC = 0x2c6
+		return
+	}
+	*c.PC = 0x2c4
+}
+
+func block2c4(c *chip8.Context) {
+	// 0x2c4: 0x126a JP 0x26a
+	*c.PC = 0x26a
+}
+
+func block2c6(c *chip8.Context) {
+	// 0x2c6: 0x121c JP 0x21c
+	*c.PC = 0x21c
+}
+
+func block2c8(c *chip8.Context) {
+	// 0x2c8: 0x6a00 LD Va, 0x00
+	c.V[0xa] = 0x00
+	// 0x2ca: 0xa3b4 LD I, 0x3b4
+	*c.I = 0x3b4
+	// 0x2cc: 0x6001 LD V0, 0x01
+	c.V[0x0] = 0x01
+	// 0x2ce: 0xf01e ADD I, V0
+	*c.I += uint16(c.V[0x0])
+	// 0x2d0: 0xf865 LD V8, [I]
+	c.Exec(0x2d0)
+	// 0x2d2: 0x6900 LD V9, 0x00
+	c.V[0x9] = 0x00
+	// 0x2d4: 0x8904 ADD V9, V0
+	c.Exec(0x2d4)
+	*c.PC = 0x2d6
+}
+
+func block2d8(c *chip8.Context) {
+	// 0x2d8: 0x8914 ADD V9, V1
+	c.Exec(0x2d8)
+	*c.PC = 0x2da
+}
+
+func block2dc(c *chip8.Context) {
+	// 0x2dc: 0x8924 ADD V9, V2
+	c.Exec(0x2dc)
+	*c.PC = 0x2de
+}
+
+func block2e0(c *chip8.Context) {
+	// 0x2e0: 0x6900 LD V9, 0x00
+	c.V[0x9] = 0x00
+	// 0x2e2: 0x8934 ADD V9, V3
+	c.Exec(0x2e2)
+	*c.PC = 0x2e4
+}
+
+func block2e6(c *chip8.Context) {
+	// 0x2e6: 0x8944 ADD V9, V4
+	c.Exec(0x2e6)
+	*c.PC = 0x2e8
+}
+
+func block2ea(c *chip8.Context) {
+	// 0x2ea: 0x8954 ADD V9, V5
+	c.Exec(0x2ea)
+	*c.PC = 0x2ec
+}
+
+func block2ee(c *chip8.Context) {
+	// 0x2ee: 0x6900 LD V9, 0x00
+	c.V[0x9] = 0x00
+	// 0x2f0: 0x8964 ADD V9, V6
+	c.Exec(0x2f0)
+	*c.PC = 0x2f2
+}
+
+func block2f4(c *chip8.Context) {
+	// 0x2f4: 0x8974 ADD V9, V7
+	c.Exec(0x2f4)
+	*c.PC = 0x2f6
+}
+
+func block2f8(c *chip8.Context) {
+	// 0x2f8: 0x8984 ADD V9, V8
+	c.Exec(0x2f8)
+	*c.PC = 0x2fa
+}
+
+func block2fc(c *chip8.Context) {
+	// 0x2fc: 0x6900 LD V9, 0x00
+	c.V[0x9] = 0x00
+	// 0x2fe: 0x8964 ADD V9, V6
+	c.Exec(0x2fe)
+	*c.PC = 0x300
+}
+
+func block302(c *chip8.Context) {
+	// 0x302: 0x8934 ADD V9, V3
+	c.Exec(0x302)
+	*c.PC = 0x304
+}
+
+func block306(c *chip8.Context) {
+	// 0x306: 0x8904 ADD V9, V0
+	c.Exec(0x306)
+	*c.PC = 0x308
+}
+
+func block30a(c *chip8.Context) {
+	// 0x30a: 0x6900 LD V9, 0x00
+	c.V[0x9] = 0x00
+	// 0x30c: 0x8974 ADD V9, V7
+	c.Exec(0x30c)
+	*c.PC = 0x30e
+}
+
+func block310(c *chip8.Context) {
+	// 0x310: 0x8944 ADD V9, V4
+	c.Exec(0x310)
+	*c.PC = 0x312
+}
+
+func block314(c *chip8.Context) {
+	// 0x314: 0x8914 ADD V9, V1
+	c.Exec(0x314)
+	*c.PC = 0x316
+}
+
+func block318(c *chip8.Context) {
+	// 0x318: 0x6900 LD V9, 0x00
+	c.V[0x9] = 0x00
+	// 0x31a: 0x8984 ADD V9, V8
+	c.Exec(0x31a)
+	*c.PC = 0x31c
+}
+
+func block31e(c *chip8.Context) {
+	// 0x31e: 0x8954 ADD V9, V5
+	c.Exec(0x31e)
+	*c.PC = 0x320
+}
+
+func block322(c *chip8.Context) {
+	// 0x322: 0x8924 ADD V9, V2
+	c.Exec(0x322)
+	*c.PC = 0x324
+}
+
+func block326(c *chip8.Context) {
+	// 0x326: 0x6900 LD V9, 0x00
+	c.V[0x9] = 0x00
+	// 0x328: 0x8984 ADD V9, V8
+	c.Exec(0x328)
+	*c.PC = 0x32a
+}
+
+func block32c(c *chip8.Context) {
+	// 0x32c: 0x8944 ADD V9, V4
+	c.Exec(0x32c)
+	*c.PC = 0x32e
+}
+
+func block330(c *chip8.Context) {
+	// 0x330: 0x8904 ADD V9, V0
+	c.Exec(0x330)
+	*c.PC = 0x332
+}
+
+func block334(c *chip8.Context) {
+	// 0x334: 0x6900 LD V9, 0x00
+	c.V[0x9] = 0x00
+	// 0x336: 0x8964 ADD V9, V6
+	c.Exec(0x336)
+	*c.PC = 0x338
+}
+
+func block33a(c *chip8.Context) {
+	// 0x33a: 0x8944 ADD V9, V4
+	c.Exec(0x33a)
+	*c.PC = 0x33c
+}
+
+func block33e(c *chip8.Context) {
+	// 0x33e: 0x8924 ADD V9, V2
+	c.Exec(0x33e)
+	*c.PC = 0x340
+}
+
+func block344(c *chip8.Context) {
+	// 0x344: 0x890e SHL V9, V0
+	c.Exec(0x344)
+	// 0x346: 0x890e SHL V9, V0
+	c.Exec(0x346)
+	*c.PC = 0x348
+}
+
+func block34a(c *chip8.Context) {
+	// 0x34a: 0x4915 SNE V9, 0x15
+	if c.V[0x9] != 0x15 {
+		*c.PC = 0x34e
+		return
+	}
+	*c.PC = 0x34c
+}
+
+func block34c(c *chip8.Context) {
+	// 0x34c: 0x1354 JP 0x354
+	*c.PC = 0x354
+}
+
+func block34e(c *chip8.Context) {
+	// 0x34e: 0x493f SNE V9, 0x3f
+	if c.V[0x9] != 0x3f {
+		*c.PC = 0x352
+		return
+	}
+	*c.PC = 0x350
+}
+
+func block350(c *chip8.Context) {
+	// 0x350: 0x135a JP 0x35a
+	*c.PC = 0x35a
+}
+
+func block356(c *chip8.Context) {
+	// 0x356: 0x7b01 ADD Vb, 0x01
+	c.V[0xb] += 0x01
+	// 0x358: 0x135e JP 0x35e
+	*c.PC = 0x35e
+}
+
+func block35c(c *chip8.Context) {
+	// 0x35c: 0x7c01 ADD Vc, 0x01
+	c.V[0xc] += 0x01
+	*c.PC = 0x35e
+}
+
+func block360(c *chip8.Context) {
+	// 0x360: 0x6a01 LD Va, 0x01
+	c.V[0xa] = 0x01
+	*c.PC = 0x362
+}
+
+func block366(c *chip8.Context) {
+	// 0x366: 0x6305 LD V3, 0x05
+	c.V[0x3] = 0x05
+	// 0x368: 0x640a LD V4, 0x0a
+	c.V[0x4] = 0x0a
+	// 0x36a: 0xa3af LD I, 0x3af
+	*c.I = 0x3af
+	// 0x36c: 0xd345 DRW V3, V4, 0x5
+	c.Exec(0x36c)
+	// 0x36e: 0x6302 LD V3, 0x02
+	c.V[0x3] = 0x02
+	// 0x370: 0x7406 ADD V4, 0x06
+	c.V[0x4] += 0x06
+	// 0x372: 0xa3e6 LD I, 0x3e6
+	*c.I = 0x3e6
+	// 0x374: 0xfb33 LD B, Vb
+	c.Exec(0x374)
+	*c.PC = 0x376
+}
+
+func block378(c *chip8.Context) {
+	// 0x378: 0x6332 LD V3, 0x32
+	c.V[0x3] = 0x32
+	// 0x37a: 0x640a LD V4, 0x0a
+	c.V[0x4] = 0x0a
+	// 0x37c: 0xa3aa LD I, 0x3aa
+	*c.I = 0x3aa
+	// 0x37e: 0xd345 DRW V3, V4, 0x5
+	c.Exec(0x37e)
+	// 0x380: 0x632f LD V3, 0x2f
+	c.V[0x3] = 0x2f
+	// 0x382: 0x7406 ADD V4, 0x06
+	c.V[0x4] += 0x06
+	// 0x384: 0xa3e6 LD I, 0x3e6
+	*c.I = 0x3e6
+	// 0x386: 0xfc33 LD B, Vc
+	c.Exec(0x386)
+	*c.PC = 0x388
+}
+
+func block388(c *chip8.Context) {
+	// 0x388: 0xf265 LD V2, [I]
+	c.Exec(0x388)
+	// 0x38a: 0xf029 LD F, V0
+	*c.I = uint16(c.V[0x0] * 5)
+	*c.PC = 0x38c
+}
+
+func block38e(c *chip8.Context) {
+	// 0x38e: 0xf129 LD F, V1
+	*c.I = uint16(c.V[0x1] * 5)
+	*c.PC = 0x390
+}
+
+func block392(c *chip8.Context) {
+	// 0x392: 0xf229 LD F, V2
+	*c.I = uint16(c.V[0x2] * 5)
+	*c.PC = 0x394
+}
+
+func block394(c *chip8.Context) {
+	// 0x394: 0xd345 DRW V3, V4, 0x5
+	c.Exec(0x394)
+	// 0x396: 0x7305 ADD V3, 0x05
+	c.V[0x3] += 0x05
+	*c.PC = 0x398
+}
@@ -0,0 +1,394 @@
+// Code generated by go-chip8 recompile from UFO; DO NOT EDIT.
+
+package ufo
+
+import "github.com/morinokami/go-chip8/chip8"
+
+var Program = &chip8.Program{
+	ROM: "281d3bcc61227e15a5d3294b0e10facc156ec1bd819a3018d92e3ccf3a07acf1",
+	Blocks: map[uint16]*chip8.Block{
+		0x200: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxByte, chip8.LDVxByte, chip8.DRW, chip8.LDIAddr, chip8.LDVxByte, chip8.LDVxByte, chip8.DRW, chip8.LDIAddr, chip8.LDVxByte}, Run: block200},
+		0x220: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block220},
+		0x222: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block222},
+		0x224: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr, chip8.DRW, chip8.LDVxByte}, Run: block224},
+		0x22e: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.SKNP}, Run: block22e},
+		0x234: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block234},
+		0x236: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block236},
+		0x23a: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block23a},
+		0x23c: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block23c},
+		0x240: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block240},
+		0x242: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block242},
+		0x246: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.RND, chip8.ADDVxVy, chip8.DRW, chip8.SEVxByte}, Run: block246},
+		0x252: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block252},
+		0x254: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.RND, chip8.SEVxByte}, Run: block254},
+		0x25c: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block25c},
+		0x25e: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.DRW, chip8.SEVxByte}, Run: block25e},
+		0x264: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block264},
+		0x266: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block266},
+		0x268: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block268},
+		0x26a: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.SNEVxByte}, Run: block26a},
+		0x270: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block270},
+		0x272: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.ADDVxVy, chip8.DRW, chip8.SEVxByte}, Run: block272},
+		0x27a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block27a},
+		0x27c: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.AND, chip8.SNEVxByte}, Run: block27c},
+		0x282: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block282},
+		0x284: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block284},
+		0x288: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.JPAddr}, Run: block288},
+		0x28e: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.JPAddr}, Run: block28e},
+		0x294: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block294},
+		0x298: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDSTVx, chip8.LDIAddr, chip8.DRW, chip8.JPAddr}, Run: block298},
+		0x2a2: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDBVx}, Run: block2a2},
+		0x2a6: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2a6},
+		0x2ac: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDBVx}, Run: block2ac},
+		0x2b0: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2b0},
+		0x2b6: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxI, chip8.LDFVx, chip8.DRW, chip8.ADDVxByte, chip8.LDFVx, chip8.DRW, chip8.ADDVxByte, chip8.LDFVx, chip8.DRW}, Run: block2b6},
+		0x2d8: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDSTVx}, Run: block2d8},
+	},
+}
+
+func block200(c *chip8.Context) {
+	// 0x200: 0xa2cd LD I, 0x2cd
+	*c.I = 0x2cd
+	// 0x202: 0x6938 LD V9, 0x38
+	c.V[0x9] = 0x38
+	// 0x204: 0x6a08 LD Va, 0x08
+	c.V[0xa] = 0x08
+	// 0x206: 0xd9a3 DRW V9, Va, 0x3
+	c.Exec(0x206)
+	// 0x208: 0xa2d0 LD I, 0x2d0
+	*c.I = 0x2d0
+	// 0x20a: 0x6b00 LD Vb, 0x00
+	c.V[0xb] = 0x00
+	// 0x20c: 0x6c03 LD Vc, 0x03
+	c.V[0xc] = 0x03
+	// 0x20e: 0xdbc3 DRW Vb, Vc, 0x3
+	c.Exec(0x20e)
+	// 0x210: 0xa2d6 LD I, 0x2d6
+	*c.I = 0x2d6
+	// 0x212: 0x641d LD V4, 0x1d
+	c.V[0x4] = 0x1d
+	*c.PC = 0x214
+}
+
+func block220(c *chip8.Context) {
+	// 0x220: 0x4800 SNE V8, 0x00
+	if c.V[0x8] != 0x00 {
+		*c.PC = 0x224
+		return
+	}
+	*c.PC = 0x222
+}
+
+func block222(c *chip8.Context) {
+	// 0x222: 0x1222 JP 0x222
+	*c.PC = 0x222
+}
+
+func block224(c *chip8.Context) {
+	// 0x224: 0x641e LD V4, 0x1e
+	c.V[0x4] = 0x1e
+	// 0x226: 0x651c LD V5, 0x1c
+	c.V[0x5] = 0x1c
+	// 0x228: 0xa2d3 LD I, 0x2d3
+	*c.I = 0x2d3
+	// 0x22a: 0xd453 DRW V4, V5, 0x3
+	c.Exec(0x22a)
+	// 0x22c: 0x6e00 LD Ve, 0x00
+	c.V[0xe] = 0x00
+	*c.PC = 0x22e
+}
+
+func block22e(c *chip8.Context) {
+	// 0x22e: 0x6680 LD V6, 0x80
+	c.V[0x6] = 0x80
+	// 0x230: 0x6d04 LD Vd, 0x04
+	c.V[0xd] = 0x04
+	// 0x232: 0xeda1 SKNP Vd
+	if !c.Pressed(c.V[0xd]) {
+		*c.PC = 0x236
+		return
+	}
+	*c.PC = 0x234
+}
+
+func block234(c *chip8.Context) {
+	// 0x234: 0x66ff LD V6, 0xff
+	c.V[0x6] = 0xff
+	*c.PC = 0x236
+}
+
+func block236(c *chip8.Context) {
+	// 0x236: 0x6d05 LD Vd, 0x05
+	c.V[0xd] = 0x05
+	// 0x238: 0xeda1 SKNP Vd
+	if !c.Pressed(c.V[0xd]) {
+		*c.PC = 0x23c
+		return
+	}
+	*c.PC = 0x23a
+}
+
+func block23a(c *chip8.Context) {
+	// 0x23a: 0x6600 LD V6, 0x00
+	c.V[0x6] = 0x00
+	*c.PC = 0x23c
+}
+
+func block23c(c *chip8.Context) {
+	// 0x23c: 0x6d06 LD Vd, 0x06
+	c.V[0xd] = 0x06
+	// 0x23e: 0xeda1 SKNP Vd
+	if !c.Pressed(c.V[0xd]) {
+		*c.PC = 0x242
+		return
+	}
+	*c.PC = 0x240
+}
+
+func block240(c *chip8.Context) {
+	// 0x240: 0x6601 LD V6, 0x01
+	c.V[0x6] = 0x01
+	*c.PC = 0x242
+}
+
+func block242(c *chip8.Context) {
+	// 0x242: 0x3680 SE V6, 0x80
+	if c.V[0x6] == 0x80 {
+		*c.PC = 0x246
+		return
+	}
+	*c.PC = 0x244
+}
+
+func block246(c *chip8.Context) {
+	// 0x246: 0xa2d0 LD I, 0x2d0
+	*c.I = 0x2d0
+	// 0x248: 0xdbc3 DRW Vb, Vc, 0x3
+	c.Exec(0x248)
+	// 0x24a: 0xcd01 RND Vd, byte
+	c.Exec(0x24a)
+	// 0x24c: 0x8bd4 ADD Vb, Vd
+	c.Exec(0x24c)
+	// 0x24e: 0xdbc3 DRW Vb, Vc, 0x3
+	c.Exec(0x24e)
+	// 0x250: 0x3f00 SE Vf, 0x00
+	if c.V[0xf] == 0x00 {
+		*c.PC = 0x254
+		return
+	}
+	*c.PC = 0x252
+}
+
+func block252(c *chip8.Context) {
+	// 0x252: 0x1292 JP 0x292
+	*c.PC = 0x292
+}
+
+func block254(c *chip8.Context) {
+	// 0x254: 0xa2cd LD I, 0x2cd
+	*c.I = 0x2cd
+	// 0x256: 0xd9a3 DRW V9, Va, 0x3
+	c.Exec(0x256)
+	// 0x258: 0xcd01 RND Vd, byte
+	c.Exec(0x258)
+	// 0x25a: 0x3d00 SE Vd, 0x00
+	if c.V[0xd] == 0x00 {
+		*c.PC = 0x25e
+		return
+	}
+	*c.PC = 0x25c
+}
+
+func block25c(c *chip8.Context) {
+	// 0x25c: 0x6dff LD Vd, 0xff
+	c.V[0xd] = 0xff
+	*c.PC = 0x25e
+}
+
+func block25e(c *chip8.Context) {
+	// 0x25e: 0x79fe ADD V9, 0xfe
+	c.V[0x9] += 0xfe
+	// 0x260: 0xd9a3 DRW V9, Va, 0x3
+	c.Exec(0x260)
+	// 0x262: 0x3f00 SE Vf, 0x00
+	if c.V[0xf] == 0x00 {
+		*c.PC = 0x266
+		return
+	}
+	*c.PC = 0x264
+}
+
+func block264(c *chip8.Context) {
+	// 0x264: 0x128c JP 0x28c
+	*c.PC = 0x28c
+}
+
+func block266(c *chip8.Context) {
+	// 0x266: 0x4e00 SNE Ve, 0x00
+	if c.V[0xe] != 0x00 {
+		*c.PC = 0x26a
+		return
+	}
+	*c.PC = 0x268
+}
+
+func block268(c *chip8.Context) {
+	// 0x268: 0x122e JP 0x22e
+	*c.PC = 0x22e
+}
+
+func block26a(c *chip8.Context) {
+	// 0x26a: 0xa2d3 LD I, 0x2d3
+	*c.I = 0x2d3
+	// 0x26c: 0xd453 DRW V4, V5, 0x3
+	c.Exec(0x26c)
+	// 0x26e: 0x4500 SNE V5, 0x00
+	if c.V[0x5] != 0x00 {
+		*c.PC = 0x272
+		return
+	}
+	*c.PC = 0x270
+}
+
+func block270(c *chip8.Context) {
+	// 0x270: 0x1286 JP 0x286
+	*c.PC = 0x286
+}
+
+func block272(c *chip8.Context) {
+	// 0x272: 0x75ff ADD V5, 0xff
+	c.V[0x5] += 0xff
+	// 0x274: 0x8464 ADD V4, V6
+	c.Exec(0x274)
+	// 0x276: 0xd453 DRW V4, V5, 0x3
+	c.Exec(0x276)
+	// 0x278: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x27c
+		return
+	}
+	*c.PC = 0x27a
+}
+
+func block27a(c *chip8.Context) {
+	// 0x27a: 0x1246 JP 0x246
+	*c.PC = 0x246
+}
+
+func block27c(c *chip8.Context) {
+	// 0x27c: 0x6d08 LD Vd, 0x08
+	c.V[0xd] = 0x08
+	// 0x27e: 0x8d52 AND Vd, V5
+	c.V[0xd] &= c.V[0x5]
+	// 0x280: 0x4d08 SNE Vd, 0x08
+	if c.V[0xd] != 0x08 {
+		*c.PC = 0x284
+		return
+	}
+	*c.PC = 0x282
+}
+
+func block282(c *chip8.Context) {
+	// 0x282: 0x128c JP 0x28c
+	*c.PC = 0x28c
+}
+
+func block284(c *chip8.Context) {
+	// 0x284: 0x1292 JP 0x292
+	*c.PC = 0x292
+}
+
+func block288(c *chip8.Context) {
+	// 0x288: 0x78ff ADD V8, 0xff
+	c.V[0x8] += 0xff
+	// 0x28a: 0x121e JP 0x21e
+	*c.PC = 0x21e
+}
+
+func block28e(c *chip8.Context) {
+	// 0x28e: 0x7705 ADD V7, 0x05
+	c.V[0x7] += 0x05
+	// 0x290: 0x1296 JP 0x296
+	*c.PC = 0x296
+}
+
+func block294(c *chip8.Context) {
+	// 0x294: 0x770f ADD V7, 0x0f
+	c.V[0x7] += 0x0f
+	*c.PC = 0x296
+}
+
+func block298(c *chip8.Context) {
+	// 0x298: 0x6d03 LD Vd, 0x03
+	c.V[0xd] = 0x03
+	// 0x29a: 0xfd18 LD ST, Vd
+	*c.ST = c.V[0xd]
+	// 0x29c: 0xa2d3 LD I, 0x2d3
+	*c.I = 0x2d3
+	// 0x29e: 0xd453 DRW V4, V5, 0x3
+	c.Exec(0x29e)
+	// 0x2a0: 0x1286 JP 0x286
+	*c.PC = 0x286
+}
+
+func block2a2(c *chip8.Context) {
+	// 0x2a2: 0xa2f8 LD I, 0x2f8
+	*c.I = 0x2f8
+	// 0x2a4: 0xf733 LD B, V7
+	c.Exec(0x2a4)
+	*c.PC = 0x2a6
+}
+
+func block2a6(c *chip8.Context) {
+	// 0x2a6: 0x6300 LD V3, 0x00
+	c.V[0x3] = 0x00
+	*c.PC = 0x2a8
+}
+
+func block2ac(c *chip8.Context) {
+	// 0x2ac: 0xa2f8 LD I, 0x2f8
+	*c.I = 0x2f8
+	// 0x2ae: 0xf833 LD B, V8
+	c.Exec(0x2ae)
+	*c.PC = 0x2b0
+}
+
+func block2b0(c *chip8.Context) {
+	// 0x2b0: 0x6332 LD V3, 0x32
+	c.V[0x3] = 0x32
+	*c.PC = 0x2b2
+}
+
+func block2b6(c *chip8.Context) {
+	// 0x2b6: 0x6d1b LD Vd, 0x1b
+	c.V[0xd] = 0x1b
+	// 0x2b8: 0xf265 LD V2, [I]
+	c.Exec(0x2b8)
+	// 0x2ba: 0xf029 LD F, V0
+	*c.I = uint16(c.V[0x0] * 5)
+	// 0x2bc: 0xd3d5 DRW V3, Vd, 0x5
+	c.Exec(0x2bc)
+	// 0x2be: 0x7305 ADD V3, 0x05
+	c.V[0x3] += 0x05
+	// 0x2c0: 0xf129 LD F, V1
+	*c.I = uint16(c.V[0x1] * 5)
+	// 0x2c2: 0xd3d5 DRW V3, Vd, 0x5
+	c.Exec(0x2c2)
+	// 0x2c4: 0x7305 ADD V3, 0x05
+	c.V[0x3] += 0x05
+	// 0x2c6: 0xf229 LD F, V2
+	*c.I = uint16(c.V[0x2] * 5)
+	// 0x2c8: 0xd3d5 DRW V3, Vd, 0x5
+	c.Exec(0x2c8)
+	*c.PC = 0x2ca
+}
+
+func block2d8(c *chip8.Context) {
+	// 0x2d8: 0x6e01 LD Ve, 0x01
+	c.V[0xe] = 0x01
+	// 0x2da: 0x6d10 LD Vd, 0x10
+	c.V[0xd] = 0x10
+	// 0x2dc: 0xfd18 LD ST, Vd
+	*c.ST = c.V[0xd]
+	*c.PC = 0x2de
+}
@@ -0,0 +1,788 @@
+// Code generated by go-chip8 recompile from VBRIX; DO NOT EDIT.
+
+package vbrix
+
+import "github.com/morinokami/go-chip8/chip8"
+
+var Program = &chip8.Program{
+	ROM: "c4f452abdd1a6a31a5ee3726fad52eea085f27c29ea28307d38a4ebf08d60278",
+	Blocks: map[uint16]*chip8.Block{
+		0x200: {Insts: []chip8.Instruction{chip8.CLS}, Run: block200},
+		0x204: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKP}, Run: block204},
+		0x208: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block208},
+		0x20a: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte}, Run: block20a},
+		0x224: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block224},
+		0x226: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block226},
+		0x228: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block228},
+		0x22c: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block22c},
+		0x230: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDDTVx}, Run: block230},
+		0x234: {Insts: []chip8.Instruction{chip8.LDVxDT, chip8.SEVxByte}, Run: block234},
+		0x238: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block238},
+		0x23a: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block23a},
+		0x23c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block23c},
+		0x240: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block240},
+		0x242: {Insts: []chip8.Instruction{chip8.SKP}, Run: block242},
+		0x244: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block244},
+		0x246: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block246},
+		0x24a: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr, chip8.DRW}, Run: block24a},
+		0x25a: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block25a},
+		0x25e: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block25e},
+		0x260: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block260},
+		0x264: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block264},
+		0x268: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.ADDVxByte, chip8.SNEVxByte}, Run: block268},
+		0x270: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block270},
+		0x272: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.ADDVxByte, chip8.SNEVxByte}, Run: block272},
+		0x27a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block27a},
+		0x27c: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDIAddr, chip8.DRW, chip8.DRW, chip8.LDVxVy}, Run: block27c},
+		0x288: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.ADDVxByte, chip8.SEVxByte}, Run: block288},
+		0x290: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.SUB, chip8.SNEVxByte}, Run: block290},
+		0x298: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxByte, chip8.SUB, chip8.SEVxByte}, Run: block298},
+		0x2a2: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.ADDIVx, chip8.LDVxI, chip8.LDVxVy, chip8.SNEVxByte}, Run: block2a2},
+		0x2ac: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2ac},
+		0x2ae: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2ae},
+		0x2b0: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2b0},
+		0x2b2: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDSTVx}, Run: block2b2},
+		0x2c0: {Insts: []chip8.Instruction{chip8.RND, chip8.ADDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr, chip8.DRW}, Run: block2c0},
+		0x2d0: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxVy, chip8.ADDVxVy, chip8.ADDVxVy, chip8.LDIAddr, chip8.SNEVxByte}, Run: block2d0},
+		0x2dc: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2dc},
+		0x2de: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2de},
+		0x2e0: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2e0},
+		0x2e2: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2e2},
+		0x2e4: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2e4},
+		0x2e6: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2e6},
+		0x2e8: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2e8},
+		0x2ea: {Insts: []chip8.Instruction{chip8.DRW, chip8.DRW, chip8.SNEVxByte}, Run: block2ea},
+		0x2f2: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxByte, chip8.SUB, chip8.SNEVxByte}, Run: block2f2},
+		0x2fc: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxVy, chip8.ADDVxByte, chip8.ADDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte}, Run: block2fc},
+		0x30a: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SUB, chip8.SEVxByte}, Run: block30a},
+		0x310: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block310},
+		0x312: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SUB, chip8.SEVxByte}, Run: block312},
+		0x318: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block318},
+		0x31a: {Insts: []chip8.Instruction{chip8.LDVxVy, chip8.LDVxVy, chip8.ADDVxVy, chip8.ADDVxVy, chip8.ADDVxVy, chip8.ADDVxVy, chip8.ADDVxByte, chip8.ADDVxByte, chip8.LDIAddr, chip8.DRW}, Run: block31a},
+		0x33a: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block33a},
+		0x33e: {Insts: []chip8.Instruction{chip8.SEVxByte}, Run: block33e},
+		0x346: {Insts: []chip8.Instruction{chip8.CLS, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr}, Run: block346},
+		0x350: {Insts: []chip8.Instruction{chip8.DRW, chip8.DRW, chip8.ADDVxByte, chip8.SEVxByte}, Run: block350},
+		0x358: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block358},
+		0x35a: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.SEVxByte}, Run: block35a},
+		0x360: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block360},
+		0x366: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr}, Run: block366},
+		0x36c: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte}, Run: block36c},
+		0x370: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.ADDVxByte, chip8.SEVxByte}, Run: block370},
+		0x378: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block378},
+		0x37a: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.ADDVxByte, chip8.SEVxByte}, Run: block37a},
+		0x380: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block380},
+		0x382: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block382},
+		0x38a: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDBVx}, Run: block38a},
+		0x38e: {Insts: []chip8.Instruction{chip8.LDVxI, chip8.LDVxByte, chip8.LDVxByte, chip8.LDFVx, chip8.DRW, chip8.ADDVxByte, chip8.LDFVx, chip8.DRW, chip8.ADDVxByte, chip8.LDFVx}, Run: block38e},
+		0x3ac: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDFVx, chip8.DRW}, Run: block3ac},
+		0x3b6: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr}, Run: block3b6},
+		0x3c0: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDIVx, chip8.ADDVxByte, chip8.ADDVxByte, chip8.SEVxByte}, Run: block3c0},
+		0x3ca: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block3ca},
+	},
+}
+
+func block200(c *chip8.Context) {
+	// 0x200: 0x00e0 CLS
+	c.Exec(0x200)
+	*c.PC = 0x202
+}
+
+func block204(c *chip8.Context) {
+	// 0x204: 0x6007 LD V0, 0x07
+	c.V[0x0] = 0x07
+	// 0x206: 0xe09e SKP V0
+	if c.Pressed(c.V[0x0]) {
+		*c.PC = 0x20a
+		return
+	}
+	*c.PC = 0x208
+}
+
+func block208(c *chip8.Context) {
+	// 0x208: 0x1204 JP 0x204
+	*c.PC = 0x204
+}
+
+func block20a(c *chip8.Context) {
+	// 0x20a: 0x6800 LD V8, 0x00
+	c.V[0x8] = 0x00
+	// 0x20c: 0x6703 LD V7, 0x03
+	c.V[0x7] = 0x03
+	*c.PC = 0x20e
+}
+
+func block224(c *chip8.Context) {
+	// 0x224: 0x3a00 SE Va, 0x00
+	if c.V[0xa] == 0x00 {
+		*c.PC = 0x228
+		return
+	}
+	*c.PC = 0x226
+}
+
+func block226(c *chip8.Context) {
+	// 0x226: 0x121c JP 0x21c
+	*c.PC = 0x21c
+}
+
+func block228(c *chip8.Context) {
+	// 0x228: 0x6c01 LD Vc, 0x01
+	c.V[0xc] = 0x01
+	*c.PC = 0x22a
+}
+
+func block22c(c *chip8.Context) {
+	// 0x22c: 0x77ff ADD V7, 0xff
+	c.V[0x7] += 0xff
+	*c.PC = 0x22e
+}
+
+func block230(c *chip8.Context) {
+	// 0x230: 0x6078 LD V0, 0x78
+	c.V[0x0] = 0x78
+	// 0x232: 0xf015 LD DT, V0
+	*c.DT = c.V[0x0]
+	*c.PC = 0x234
+}
+
+func block234(c *chip8.Context) {
+	// 0x234: 0xf007 LD V0, DT
+	c.V[0x0] = *c.DT
+	// 0x236: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x23a
+		return
+	}
+	*c.PC = 0x238
+}
+
+func block238(c *chip8.Context) {
+	// 0x238: 0x1234 JP 0x234
+	*c.PC = 0x234
+}
+
+func block23a(c *chip8.Context) {
+	// 0x23a: 0x3700 SE V7, 0x00
+	if c.V[0x7] == 0x00 {
+		*c.PC = 0x23e
+		return
+	}
+	*c.PC = 0x23c
+}
+
+func block23c(c *chip8.Context) {
+	// 0x23c: 0x121c JP 0x21c
+	*c.PC = 0x21c
+}
+
+func block240(c *chip8.Context) {
+	// 0x240: 0x6007 LD V0, 0x07
+	c.V[0x0] = 0x07
+	*c.PC = 0x242
+}
+
+func block242(c *chip8.Context) {
+	// 0x242: 0xe09e SKP V0
+	if c.Pressed(c.V[0x0]) {
+		*c.PC = 0x246
+		return
+	}
+	*c.PC = 0x244
+}
+
+func block244(c *chip8.Context) {
+	// 0x244: 0x1242 JP 0x242
+	*c.PC = 0x242
+}
+
+func block246(c *chip8.Context) {
+	// 0x246: 0x120a JP 0x20a
+	*c.PC = 0x20a
+}
+
+func block24a(c *chip8.Context) {
+	// 0x24a: 0x6910 LD V9, 0x10
+	c.V[0x9] = 0x10
+	// 0x24c: 0x6002 LD V0, 0x02
+	c.V[0x0] = 0x02
+	// 0x24e: 0xa254 LD I, 0x254
+	*c.I = 0x254
+	// 0x250: 0xd095 DRW V0, V9, 0x5
+	c.Exec(0x250)
+	*c.PC = 0x252
+}
+
+func block25a(c *chip8.Context) {
+	// 0x25a: 0x6001 LD V0, 0x01
+	c.V[0x0] = 0x01
+	// 0x25c: 0xe0a1 SKNP V0
+	if !c.Pressed(c.V[0x0]) {
+		*c.PC = 0x260
+		return
+	}
+	*c.PC = 0x25e
+}
+
+func block25e(c *chip8.Context) {
+	// 0x25e: 0x1268 JP 0x268
+	*c.PC = 0x268
+}
+
+func block260(c *chip8.Context) {
+	// 0x260: 0x6004 LD V0, 0x04
+	c.V[0x0] = 0x04
+	// 0x262: 0xe0a1 SKNP V0
+	if !c.Pressed(c.V[0x0]) {
+		*c.PC = 0x266
+		return
+	}
+	*c.PC = 0x264
+}
+
+func block264(c *chip8.Context) {
+	// 0x264: 0x1272 JP 0x272
+	*c.PC = 0x272
+}
+
+func block268(c *chip8.Context) {
+	// 0x268: 0x8090 LD V0, V9
+	c.V[0x0] = c.V[0x9]
+	// 0x26a: 0x70ff ADD V0, 0xff
+	c.V[0x0] += 0xff
+	// 0x26c: 0x4000 SNE V0, 0x00
+	if c.V[0x0] != 0x00 {
+		*c.PC = 0x270
+		return
+	}
+	*c.PC = 0x26e
+}
+
+func block270(c *chip8.Context) {
+	// 0x270: 0x127c JP 0x27c
+	*c.PC = 0x27c
+}
+
+func block272(c *chip8.Context) {
+	// 0x272: 0x8090 LD V0, V9
+	c.V[0x0] = c.V[0x9]
+	// 0x274: 0x7001 ADD V0, 0x01
+	c.V[0x0] += 0x01
+	// 0x276: 0x401b SNE V0, 0x1b
+	if c.V[0x0] != 0x1b {
+		*c.PC = 0x27a
+		return
+	}
+	*c.PC = 0x278
+}
+
+func block27a(c *chip8.Context) {
+	// 0x27a: 0x127c JP 0x27c
+	*c.PC = 0x27c
+}
+
+func block27c(c *chip8.Context) {
+	// 0x27c: 0x6102 LD V1, 0x02
+	c.V[0x1] = 0x02
+	// 0x27e: 0xa254 LD I, 0x254
+	*c.I = 0x254
+	// 0x280: 0xd195 DRW V1, V9, 0x5
+	c.Exec(0x280)
+	// 0x282: 0xd105 DRW V1, V0, 0x5
+	c.Exec(0x282)
+	// 0x284: 0x8900 LD V9, V0
+	c.V[0x9] = c.V[0x0]
+	*c.PC = 0x286
+}
+
+func block288(c *chip8.Context) {
+	// 0x288: 0x80a0 LD V0, Va
+	c.V[0x0] = c.V[0xa]
+	// 0x28a: 0x70fe ADD V0, 0xfe
+	c.V[0x0] += 0xfe
+	// 0x28c: 0x3000 SE V0, 0x00
+	if c.V[0x0] == 0x00 {
+		*c.PC = 0x290
+		return
+	}
+	*c.PC = 0x28e
+}
+
+func block290(c *chip8.Context) {
+	// 0x290: 0x80b0 LD V0, Vb
+	c.V[0x0] = c.V[0xb]
+	// 0x292: 0x8095 SUB V0, V9
+	c.Exec(0x292)
+	// 0x294: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x298
+		return
+	}
+	*c.PC = 0x296
+}
+
+func block298(c *chip8.Context) {
+	// 0x298: 0x8100 LD V1, V0
+	c.V[0x1] = c.V[0x0]
+	// 0x29a: 0x6205 LD V2, 0x05
+	c.V[0x2] = 0x05
+	// 0x29c: 0x8125 SUB V1, V2
+	c.Exec(0x29c)
+	// 0x29e: 0x3f00 SE Vf, 0x00
+	if c.V[0xf] == 0x00 {
+		*c.PC = 0x2a2
+		return
+	}
+	*c.PC = 0x2a0
+}
+
+func block2a2(c *chip8.Context) {
+	// 0x2a2: 0xa2ba LD I, 0x2ba
+	*c.I = 0x2ba
+	// 0x2a4: 0xf01e ADD I, V0
+	*c.I += uint16(c.V[0x0])
+	// 0x2a6: 0xf065 LD V0, [I]
+	c.Exec(0x2a6)
+	// 0x2a8: 0x8d00 LD Vd, V0
+	c.V[0xd] = c.V[0x0]
+	// 0x2aa: 0x4b01 SNE Vb, 0x01
+	if c.V[0xb] != 0x01 {
+		*c.PC = 0x2ae
+		return
+	}
+	*c.PC = 0x2ac
+}
+
+func block2ac(c *chip8.Context) {
+	// 0x2ac: 0x6d01 LD Vd, 0x01
+	c.V[0xd] = 0x01
+	*c.PC = 0x2ae
+}
+
+func block2ae(c *chip8.Context) {
+	// 0x2ae: 0x4b1e SNE Vb, 0x1e
+	if c.V[0xb] != 0x1e {
+		*c.PC = 0x2b2
+		return
+	}
+	*c.PC = 0x2b0
+}
+
+func block2b0(c *chip8.Context) {
+	// 0x2b0: 0x6dff LD Vd, 0xff
+	c.V[0xd] = 0xff
+	*c.PC = 0x2b2
+}
+
+func block2b2(c *chip8.Context) {
+	// 0x2b2: 0x6c01 LD Vc, 0x01
+	c.V[0xc] = 0x01
+	// 0x2b4: 0x600a LD V0, 0x0a
+	c.V[0x0] = 0x0a
+	// 0x2b6: 0xf018 LD ST, V0
+	*c.ST = c.V[0x0]
+	*c.PC = 0x2b8
+}
+
+func block2c0(c *chip8.Context) {
+	// 0x2c0: 0xcb20 RND Vb, byte
+	c.Exec(0x2c0)
+	// 0x2c2: 0x7b01 ADD Vb, 0x01
+	c.V[0xb] += 0x01
+	// 0x2c4: 0x6a04 LD Va, 0x04
+	c.V[0xa] = 0x04
+	// 0x2c6: 0x6c01 LD Vc, 0x01
+	c.V[0xc] = 0x01
+	// 0x2c8: 0x6d01 LD Vd, 0x01
+	c.V[0xd] = 0x01
+	// 0x2ca: 0xa364 LD I, 0x364
+	*c.I = 0x364
+	// 0x2cc: 0xdab1 DRW Va, Vb, 0x1
+	c.Exec(0x2cc)
+	*c.PC = 0x2ce
+}
+
+func block2d0(c *chip8.Context) {
+	// 0x2d0: 0x80a0 LD V0, Va
+	c.V[0x0] = c.V[0xa]
+	// 0x2d2: 0x81b0 LD V1, Vb
+	c.V[0x1] = c.V[0xb]
+	// 0x2d4: 0x8ac4 ADD Va, Vc
+	c.Exec(0x2d4)
+	// 0x2d6: 0x8bd4 ADD Vb, Vd
+	c.Exec(0x2d6)
+	// 0x2d8: 0xa364 LD I, 0x364
+	*c.I = 0x364
+	// 0x2da: 0x4b01 SNE Vb, 0x01
+	if c.V[0xb] != 0x01 {
+		*c.PC = 0x2de
+		return
+	}
+	*c.PC = 0x2dc
+}
+
+func block2dc(c *chip8.Context) {
+	// 0x2dc: 0x6d01 LD Vd, 0x01
+	c.V[0xd] = 0x01
+	*c.PC = 0x2de
+}
+
+func block2de(c *chip8.Context) {
+	// 0x2de: 0x4b1e SNE Vb, 0x1e
+	if c.V[0xb] != 0x1e {
+		*c.PC = 0x2e2
+		return
+	}
+	*c.PC = 0x2e0
+}
+
+func block2e0(c *chip8.Context) {
+	// 0x2e0: 0x6dff LD Vd, 0xff
+	c.V[0xd] = 0xff
+	*c.PC = 0x2e2
+}
+
+func block2e2(c *chip8.Context) {
+	// 0x2e2: 0x4a3e SNE Va, 0x3e
+	if c.V[0xa] != 0x3e {
+		*c.PC = 0x2e6
+		return
+	}
+	*c.PC = 0x2e4
+}
+
+func block2e4(c *chip8.Context) {
+	// 0x2e4: 0x6cff LD Vc, 0xff
+	c.V[0xc] = 0xff
+	*c.PC = 0x2e6
+}
+
+func block2e6(c *chip8.Context) {
+	// 0x2e6: 0x4a00 SNE Va, 0x00
+	if c.V[0xa] != 0x00 {
+		*c.PC = 0x2ea
+		return
+	}
+	*c.PC = 0x2e8
+}
+
+func block2e8(c *chip8.Context) {
+	// 0x2e8: 0x6c01 LD Vc, 0x01
+	c.V[0xc] = 0x01
+	*c.PC = 0x2ea
+}
+
+func block2ea(c *chip8.Context) {
+	// 0x2ea: 0xd011 DRW V0, V1, 0x1
+	c.Exec(0x2ea)
+	// 0x2ec: 0xdab1 DRW Va, Vb, 0x1
+	c.Exec(0x2ec)
+	// 0x2ee: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x2f2
+		return
+	}
+	*c.PC = 0x2f0
+}
+
+func block2f2(c *chip8.Context) {
+	// 0x2f2: 0x80a0 LD V0, Va
+	c.V[0x0] = c.V[0xa]
+	// 0x2f4: 0x6121 LD V1, 0x21
+	c.V[0x1] = 0x21
+	// 0x2f6: 0x8015 SUB V0, V1
+	c.Exec(0x2f6)
+	// 0x2f8: 0x4f00 SNE Vf, 0x00
+	if c.V[0xf] != 0x00 {
+		*c.PC = 0x2fc
+		return
+	}
+	*c.PC = 0x2fa
+}
+
+func block2fc(c *chip8.Context) {
+	// 0x2fc: 0x80a0 LD V0, Va
+	c.V[0x0] = c.V[0xa]
+	// 0x2fe: 0x81b0 LD V1, Vb
+	c.V[0x1] = c.V[0xb]
+	// 0x300: 0x70de ADD V0, 0xde
+	c.V[0x0] += 0xde
+	// 0x302: 0x71ff ADD V1, 0xff
+	c.V[0x1] += 0xff
+	// 0x304: 0x62ff LD V2, 0xff
+	c.V[0x2] = 0xff
+	// 0x306: 0x63ff LD V3, 0xff
+	c.V[0x3] = 0xff
+	// 0x308: 0x6403 LD V4, 0x03
+	c.V[0x4] = 0x03
+	*c.PC = 0x30a
+}
+
+func block30a(c *chip8.Context) {
+	// 0x30a: 0x7201 ADD V2, 0x01
+	c.V[0x2] += 0x01
+	// 0x30c: 0x8045 SUB V0, V4
+	c.Exec(0x30c)
+	// 0x30e: 0x3f00 SE Vf, 0x00
+	if c.V[0xf] == 0x00 {
+		*c.PC = 0x312
+		return
+	}
+	*c.PC = 0x310
+}
+
+func block310(c *chip8.Context) {
+	// 0x310: 0x130a JP 0x30a
+	*c.PC = 0x30a
+}
+
+func block312(c *chip8.Context) {
+	// 0x312: 0x7301 ADD V3, 0x01
+	c.V[0x3] += 0x01
+	// 0x314: 0x8145 SUB V1, V4
+	c.Exec(0x314)
+	// 0x316: 0x3f00 SE Vf, 0x00
+	if c.V[0xf] == 0x00 {
+		*c.PC = 0x31a
+		return
+	}
+	*c.PC = 0x318
+}
+
+func block318(c *chip8.Context) {
+	// 0x318: 0x1312 JP 0x312
+	*c.PC = 0x312
+}
+
+func block31a(c *chip8.Context) {
+	// 0x31a: 0x8020 LD V0, V2
+	c.V[0x0] = c.V[0x2]
+	// 0x31c: 0x8130 LD V1, V3
+	c.V[0x1] = c.V[0x3]
+	// 0x31e: 0x8024 ADD V0, V2
+	c.Exec(0x31e)
+	// 0x320: 0x8024 ADD V0, V2
+	c.Exec(0x320)
+	// 0x322: 0x8134 ADD V1, V3
+	c.Exec(0x322)
+	// 0x324: 0x8134 ADD V1, V3
+	c.Exec(0x324)
+	// 0x326: 0x7022 ADD V0, 0x22
+	c.V[0x0] += 0x22
+	// 0x328: 0x7101 ADD V1, 0x01
+	c.V[0x1] += 0x01
+	// 0x32a: 0xa386 LD I, 0x386
+	*c.I = 0x386
+	// 0x32c: 0xd013 DRW V0, V1, 0x3
+	c.Exec(0x32c)
+	*c.PC = 0x32e
+}
+
+func block33a(c *chip8.Context) {
+	// 0x33a: 0x7801 ADD V8, 0x01
+	c.V[0x8] += 0x01
+	*c.PC = 0x33c
+}
+
+func block33e(c *chip8.Context) {
+	// 0x33e: 0x3e00 SE Ve, 0x00
+	if c.V[0xe] == 0x00 {
+		*c.PC = 0x342
+		return
+	}
+	*c.PC = 0x340
+}
+
+func block346(c *chip8.Context) {
+	// 0x346: 0x00e0 CLS
+	c.Exec(0x346)
+	// 0x348: 0x6000 LD V0, 0x00
+	c.V[0x0] = 0x00
+	// 0x34a: 0x6100 LD V1, 0x00
+	c.V[0x1] = 0x00
+	// 0x34c: 0x621f LD V2, 0x1f
+	c.V[0x2] = 0x1f
+	// 0x34e: 0xa364 LD I, 0x364
+	*c.I = 0x364
+	*c.PC = 0x350
+}
+
+func block350(c *chip8.Context) {
+	// 0x350: 0xd011 DRW V0, V1, 0x1
+	c.Exec(0x350)
+	// 0x352: 0xd021 DRW V0, V2, 0x1
+	c.Exec(0x352)
+	// 0x354: 0x7001 ADD V0, 0x01
+	c.V[0x0] += 0x01
+	// 0x356: 0x303f SE V0, 0x3f
+	if c.V[0x0] == 0x3f {
+		*c.PC = 0x35a
+		return
+	}
+	*c.PC = 0x358
+}
+
+func block358(c *chip8.Context) {
+	// 0x358: 0x1350 JP 0x350
+	*c.PC = 0x350
+}
+
+func block35a(c *chip8.Context) {
+	// 0x35a: 0xd011 DRW V0, V1, 0x1
+	c.Exec(0x35a)
+	// 0x35c: 0x7101 ADD V1, 0x01
+	c.V[0x1] += 0x01
+	// 0x35e: 0x3120 SE V1, 0x20
+	if c.V[0x1] == 0x20 {
+		*c.PC = 0x362
+		return
+	}
+	*c.PC = 0x360
+}
+
+func block360(c *chip8.Context) {
+	// 0x360: 0x135a JP 0x35a
+	*c.PC = 0x35a
+}
+
+func block366(c *chip8.Context) {
+	// 0x366: 0x6101 LD V1, 0x01
+	c.V[0x1] = 0x01
+	// 0x368: 0x630a LD V3, 0x0a
+	c.V[0x3] = 0x0a
+	// 0x36a: 0xa386 LD I, 0x386
+	*c.I = 0x386
+	*c.PC = 0x36c
+}
+
+func block36c(c *chip8.Context) {
+	// 0x36c: 0x6022 LD V0, 0x22
+	c.V[0x0] = 0x22
+	// 0x36e: 0x6207 LD V2, 0x07
+	c.V[0x2] = 0x07
+	*c.PC = 0x370
+}
+
+func block370(c *chip8.Context) {
+	// 0x370: 0xd013 DRW V0, V1, 0x3
+	c.Exec(0x370)
+	// 0x372: 0x7003 ADD V0, 0x03
+	c.V[0x0] += 0x03
+	// 0x374: 0x72ff ADD V2, 0xff
+	c.V[0x2] += 0xff
+	// 0x376: 0x3200 SE V2, 0x00
+	if c.V[0x2] == 0x00 {
+		*c.PC = 0x37a
+		return
+	}
+	*c.PC = 0x378
+}
+
+func block378(c *chip8.Context) {
+	// 0x378: 0x1370 JP 0x370
+	*c.PC = 0x370
+}
+
+func block37a(c *chip8.Context) {
+	// 0x37a: 0x7103 ADD V1, 0x03
+	c.V[0x1] += 0x03
+	// 0x37c: 0x73ff ADD V3, 0xff
+	c.V[0x3] += 0xff
+	// 0x37e: 0x3300 SE V3, 0x00
+	if c.V[0x3] == 0x00 {
+		*c.PC = 0x382
+		return
+	}
+	*c.PC = 0x380
+}
+
+func block380(c *chip8.Context) {
+	// 0x380: 0x136c JP 0x36c
+	*c.PC = 0x36c
+}
+
+func block382(c *chip8.Context) {
+	// 0x382: 0x6e46 LD Ve, 0x46
+	c.V[0xe] = 0x46
+	*c.PC = 0x384
+}
+
+func block38a(c *chip8.Context) {
+	// 0x38a: 0xa3a6 LD I, 0x3a6
+	*c.I = 0x3a6
+	// 0x38c: 0xf833 LD B, V8
+	c.Exec(0x38c)
+	*c.PC = 0x38e
+}
+
+func block38e(c *chip8.Context) {
+	// 0x38e: 0xf265 LD V2, [I]
+	c.Exec(0x38e)
+	// 0x390: 0x6303 LD V3, 0x03
+	c.V[0x3] = 0x03
+	// 0x392: 0x6402 LD V4, 0x02
+	c.V[0x4] = 0x02
+	// 0x394: 0xf029 LD F, V0
+	*c.I = uint16(c.V[0x0] * 5)
+	// 0x396: 0xd345 DRW V3, V4, 0x5
+	c.Exec(0x396)
+	// 0x398: 0x7305 ADD V3, 0x05
+	c.V[0x3] += 0x05
+	// 0x39a: 0xf129 LD F, V1
+	*c.I = uint16(c.V[0x1] * 5)
+	// 0x39c: 0xd345 DRW V3, V4, 0x5
+	c.Exec(0x39c)
+	// 0x39e: 0x7305 ADD V3, 0x05
+	c.V[0x3] += 0x05
+	// 0x3a0: 0xf229 LD F, V2
+	*c.I = uint16(c.V[0x2] * 5)
+	*c.PC = 0x3a2
+}
+
+func block3ac(c *chip8.Context) {
+	// 0x3ac: 0x6014 LD V0, 0x14
+	c.V[0x0] = 0x14
+	// 0x3ae: 0x6102 LD V1, 0x02
+	c.V[0x1] = 0x02
+	// 0x3b0: 0xf729 LD F, V7
+	*c.I = uint16(c.V[0x7] * 5)
+	// 0x3b2: 0xd015 DRW V0, V1, 0x5
+	c.Exec(0x3b2)
+	*c.PC = 0x3b4
+}
+
+func block3b6(c *chip8.Context) {
+	// 0x3b6: 0x600a LD V0, 0x0a
+	c.V[0x0] = 0x0a
+	// 0x3b8: 0x610c LD V1, 0x0c
+	c.V[0x1] = 0x0c
+	// 0x3ba: 0x6209 LD V2, 0x09
+	c.V[0x2] = 0x09
+	// 0x3bc: 0x6305 LD V3, 0x05
+	c.V[0x3] = 0x05
+	// 0x3be: 0xa3ce LD I, 0x3ce
+	*c.I = 0x3ce
+	*c.PC = 0x3c0
+}
+
+func block3c0(c *chip8.Context) {
+	// 0x3c0: 0xd015 DRW V0, V1, 0x5
+	c.Exec(0x3c0)
+	// 0x3c2: 0xf31e ADD I, V3
+	*c.I += uint16(c.V[0x3])
+	// 0x3c4: 0x7005 ADD V0, 0x05
+	c.V[0x0] += 0x05
+	// 0x3c6: 0x72ff ADD V2, 0xff
+	c.V[0x2] += 0xff
+	// 0x3c8: 0x3200 SE V2, 0x00
+	if c.V[0x2] == 0x00 {
+		*c.PC = 0x3cc
+		return
+	}
+	*c.PC = 0x3ca
+}
+
+func block3ca(c *chip8.Context) {
+	// 0x3ca: 0x13c0 JP 0x3c0
+	*c.PC = 0x3c0
+}
@@ -0,0 +1,576 @@
+// Code generated by go-chip8 recompile from VERS; DO NOT EDIT.
+
+package vers
+
+import "github.com/morinokami/go-chip8/chip8"
+
+var Program = &chip8.Program{
+	ROM: "78fdc4cceb3942bcfcebe75de9f3651906bd3a968cd1f9c24b6bebe65a10ceea",
+	Blocks: map[uint16]*chip8.Block{
+		0x200: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block200},
+		0x21a: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte}, Run: block21a},
+		0x21e: {Insts: []chip8.Instruction{chip8.CLS, chip8.LDIAddr, chip8.LDVxByte, chip8.LDVxByte}, Run: block21e},
+		0x226: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.DRW, chip8.ADDVxByte, chip8.ADDVxByte, chip8.SEVxByte}, Run: block226},
+		0x232: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block232},
+		0x234: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.LDIAddr}, Run: block234},
+		0x238: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.DRW, chip8.ADDVxByte, chip8.ADDVxByte, chip8.SEVxByte}, Run: block238},
+		0x244: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block244},
+		0x246: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.LDVxByte, chip8.DRW, chip8.DRW}, Run: block246},
+		0x256: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block256},
+		0x25a: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block25a},
+		0x25c: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block25c},
+		0x260: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block260},
+		0x262: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block262},
+		0x266: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block266},
+		0x268: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block268},
+		0x26c: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block26c},
+		0x26e: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block26e},
+		0x272: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block272},
+		0x274: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block274},
+		0x278: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block278},
+		0x27a: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block27a},
+		0x27e: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block27e},
+		0x280: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block280},
+		0x284: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block284},
+		0x286: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block286},
+		0x288: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block288},
+		0x28a: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block28a},
+		0x28c: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block28c},
+		0x28e: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block28e},
+		0x290: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block290},
+		0x292: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block292},
+		0x294: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block294},
+		0x296: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block296},
+		0x298: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block298},
+		0x29a: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block29a},
+		0x29c: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block29c},
+		0x29e: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block29e},
+		0x2a0: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block2a0},
+		0x2a2: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2a2},
+		0x2a4: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block2a4},
+		0x2a6: {Insts: []chip8.Instruction{chip8.DRW, chip8.SEVxByte}, Run: block2a6},
+		0x2aa: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2aa},
+		0x2ac: {Insts: []chip8.Instruction{chip8.DRW, chip8.SEVxByte}, Run: block2ac},
+		0x2b0: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2b0},
+		0x2b2: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2b2},
+		0x2b4: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.JPAddr}, Run: block2b4},
+		0x2b8: {Insts: []chip8.Instruction{chip8.ADDVxByte}, Run: block2b8},
+		0x2ba: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block2ba},
+		0x2bc: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SEVxByte}, Run: block2bc},
+		0x2c0: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2c0},
+		0x2c2: {Insts: []chip8.Instruction{chip8.CLS, chip8.LDVxByte, chip8.LDVxByte, chip8.LDFVx, chip8.DRW, chip8.LDVxByte, chip8.LDFVx, chip8.DRW, chip8.LDVxByte}, Run: block2c2},
+		0x2d4: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.SEVxByte}, Run: block2d4},
+		0x2d8: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2d8},
+		0x2da: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2da},
+		0x2dc: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2dc},
+		0x2de: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block2de},
+		0x2e0: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2e0},
+		0x2e2: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2e2},
+		0x2e4: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2e4},
+	},
+}
+
+func block200(c *chip8.Context) {
+	// 0x200: 0x121a JP 0x21a
+	*c.PC = 0x21a
+}
+
+func block21a(c *chip8.Context) {
+	// 0x21a: 0x6300 LD V3, 0x00
+	c.V[0x3] = 0x00
+	// 0x21c: 0x6700 LD V7, 0x00
+	c.V[0x7] = 0x00
+	*c.PC = 0x21e
+}
+
+func block21e(c *chip8.Context) {
+	// 0x21e: 0x00e0 CLS
+	c.Exec(0x21e)
+	// 0x220: 0xa217 LD I, 0x217
+	*c.I = 0x217
+	// 0x222: 0x6000 LD V0, 0x00
+	c.V[0x0] = 0x00
+	// 0x224: 0x6100 LD V1, 0x00
+	c.V[0x1] = 0x00
+	*c.PC = 0x226
+}
+
+func block226(c *chip8.Context) {
+	// 0x226: 0xd011 DRW V0, V1, 0x1
+	c.Exec(0x226)
+	// 0x228: 0x71ff ADD V1, 0xff
+	c.V[0x1] += 0xff
+	// 0x22a: 0xd011 DRW V0, V1, 0x1
+	c.Exec(0x22a)
+	// 0x22c: 0x7101 ADD V1, 0x01
+	c.V[0x1] += 0x01
+	// 0x22e: 0x7008 ADD V0, 0x08
+	c.V[0x0] += 0x08
+	// 0x230: 0x3040 SE V0, 0x40
+	if c.V[0x0] == 0x40 {
+		*c.PC = 0x234
+		return
+	}
+	*c.PC = 0x232
+}
+
+func block232(c *chip8.Context) {
+	// 0x232: 0x1226 JP 0x226
+	*c.PC = 0x226
+}
+
+func block234(c *chip8.Context) {
+	// 0x234: 0x7101 ADD V1, 0x01
+	c.V[0x1] += 0x01
+	// 0x236: 0xa215 LD I, 0x215
+	*c.I = 0x215
+	*c.PC = 0x238
+}
+
+func block238(c *chip8.Context) {
+	// 0x238: 0xd012 DRW V0, V1, 0x2
+	c.Exec(0x238)
+	// 0x23a: 0x70ff ADD V0, 0xff
+	c.V[0x0] += 0xff
+	// 0x23c: 0xd012 DRW V0, V1, 0x2
+	c.Exec(0x23c)
+	// 0x23e: 0x7001 ADD V0, 0x01
+	c.V[0x0] += 0x01
+	// 0x240: 0x7102 ADD V1, 0x02
+	c.V[0x1] += 0x02
+	// 0x242: 0x311f SE V1, 0x1f
+	if c.V[0x1] == 0x1f {
+		*c.PC = 0x246
+		return
+	}
+	*c.PC = 0x244
+}
+
+func block244(c *chip8.Context) {
+	// 0x244: 0x1238 JP 0x238
+	*c.PC = 0x238
+}
+
+func block246(c *chip8.Context) {
+	// 0x246: 0x6008 LD V0, 0x08
+	c.V[0x0] = 0x08
+	// 0x248: 0x6110 LD V1, 0x10
+	c.V[0x1] = 0x10
+	// 0x24a: 0x6204 LD V2, 0x04
+	c.V[0x2] = 0x04
+	// 0x24c: 0x6437 LD V4, 0x37
+	c.V[0x4] = 0x37
+	// 0x24e: 0x650f LD V5, 0x0f
+	c.V[0x5] = 0x0f
+	// 0x250: 0x6602 LD V6, 0x02
+	c.V[0x6] = 0x02
+	// 0x252: 0xd011 DRW V0, V1, 0x1
+	c.Exec(0x252)
+	// 0x254: 0xd451 DRW V4, V5, 0x1
+	c.Exec(0x254)
+	*c.PC = 0x256
+}
+
+func block256(c *chip8.Context) {
+	// 0x256: 0x6801 LD V8, 0x01
+	c.V[0x8] = 0x01
+	// 0x258: 0xe8a1 SKNP V8
+	if !c.Pressed(c.V[0x8]) {
+		*c.PC = 0x25c
+		return
+	}
+	*c.PC = 0x25a
+}
+
+func block25a(c *chip8.Context) {
+	// 0x25a: 0x6202 LD V2, 0x02
+	c.V[0x2] = 0x02
+	*c.PC = 0x25c
+}
+
+func block25c(c *chip8.Context) {
+	// 0x25c: 0x6802 LD V8, 0x02
+	c.V[0x8] = 0x02
+	// 0x25e: 0xe8a1 SKNP V8
+	if !c.Pressed(c.V[0x8]) {
+		*c.PC = 0x262
+		return
+	}
+	*c.PC = 0x260
+}
+
+func block260(c *chip8.Context) {
+	// 0x260: 0x6204 LD V2, 0x04
+	c.V[0x2] = 0x04
+	*c.PC = 0x262
+}
+
+func block262(c *chip8.Context) {
+	// 0x262: 0x6807 LD V8, 0x07
+	c.V[0x8] = 0x07
+	// 0x264: 0xe8a1 SKNP V8
+	if !c.Pressed(c.V[0x8]) {
+		*c.PC = 0x268
+		return
+	}
+	*c.PC = 0x266
+}
+
+func block266(c *chip8.Context) {
+	// 0x266: 0x6201 LD V2, 0x01
+	c.V[0x2] = 0x01
+	*c.PC = 0x268
+}
+
+func block268(c *chip8.Context) {
+	// 0x268: 0x680a LD V8, 0x0a
+	c.V[0x8] = 0x0a
+	// 0x26a: 0xe8a1 SKNP V8
+	if !c.Pressed(c.V[0x8]) {
+		*c.PC = 0x26e
+		return
+	}
+	*c.PC = 0x26c
+}
+
+func block26c(c *chip8.Context) {
+	// 0x26c: 0x6203 LD V2, 0x03
+	c.V[0x2] = 0x03
+	*c.PC = 0x26e
+}
+
+func block26e(c *chip8.Context) {
+	// 0x26e: 0x680b LD V8, 0x0b
+	c.V[0x8] = 0x0b
+	// 0x270: 0xe8a1 SKNP V8
+	if !c.Pressed(c.V[0x8]) {
+		*c.PC = 0x274
+		return
+	}
+	*c.PC = 0x272
+}
+
+func block272(c *chip8.Context) {
+	// 0x272: 0x6602 LD V6, 0x02
+	c.V[0x6] = 0x02
+	*c.PC = 0x274
+}
+
+func block274(c *chip8.Context) {
+	// 0x274: 0x680f LD V8, 0x0f
+	c.V[0x8] = 0x0f
+	// 0x276: 0xe8a1 SKNP V8
+	if !c.Pressed(c.V[0x8]) {
+		*c.PC = 0x27a
+		return
+	}
+	*c.PC = 0x278
+}
+
+func block278(c *chip8.Context) {
+	// 0x278: 0x6604 LD V6, 0x04
+	c.V[0x6] = 0x04
+	*c.PC = 0x27a
+}
+
+func block27a(c *chip8.Context) {
+	// 0x27a: 0x680c LD V8, 0x0c
+	c.V[0x8] = 0x0c
+	// 0x27c: 0xe8a1 SKNP V8
+	if !c.Pressed(c.V[0x8]) {
+		*c.PC = 0x280
+		return
+	}
+	*c.PC = 0x27e
+}
+
+func block27e(c *chip8.Context) {
+	// 0x27e: 0x6601 LD V6, 0x01
+	c.V[0x6] = 0x01
+	*c.PC = 0x280
+}
+
+func block280(c *chip8.Context) {
+	// 0x280: 0x680d LD V8, 0x0d
+	c.V[0x8] = 0x0d
+	// 0x282: 0xe8a1 SKNP V8
+	if !c.Pressed(c.V[0x8]) {
+		*c.PC = 0x286
+		return
+	}
+	*c.PC = 0x284
+}
+
+func block284(c *chip8.Context) {
+	// 0x284: 0x6603 LD V6, 0x03
+	c.V[0x6] = 0x03
+	*c.PC = 0x286
+}
+
+func block286(c *chip8.Context) {
+	// 0x286: 0x4201 SNE V2, 0x01
+	if c.V[0x2] != 0x01 {
+		*c.PC = 0x28a
+		return
+	}
+	*c.PC = 0x288
+}
+
+func block288(c *chip8.Context) {
+	// 0x288: 0x71ff ADD V1, 0xff
+	c.V[0x1] += 0xff
+	*c.PC = 0x28a
+}
+
+func block28a(c *chip8.Context) {
+	// 0x28a: 0x4202 SNE V2, 0x02
+	if c.V[0x2] != 0x02 {
+		*c.PC = 0x28e
+		return
+	}
+	*c.PC = 0x28c
+}
+
+func block28c(c *chip8.Context) {
+	// 0x28c: 0x70ff ADD V0, 0xff
+	c.V[0x0] += 0xff
+	*c.PC = 0x28e
+}
+
+func block28e(c *chip8.Context) {
+	// 0x28e: 0x4203 SNE V2, 0x03
+	if c.V[0x2] != 0x03 {
+		*c.PC = 0x292
+		return
+	}
+	*c.PC = 0x290
+}
+
+func block290(c *chip8.Context) {
+	// 0x290: 0x7101 ADD V1, 0x01
+	c.V[0x1] += 0x01
+	*c.PC = 0x292
+}
+
+func block292(c *chip8.Context) {
+	// 0x292: 0x4204 SNE V2, 0x04
+	if c.V[0x2] != 0x04 {
+		*c.PC = 0x296
+		return
+	}
+	*c.PC = 0x294
+}
+
+func block294(c *chip8.Context) {
+	// 0x294: 0x7001 ADD V0, 0x01
+	c.V[0x0] += 0x01
+	*c.PC = 0x296
+}
+
+func block296(c *chip8.Context) {
+	// 0x296: 0x4601 SNE V6, 0x01
+	if c.V[0x6] != 0x01 {
+		*c.PC = 0x29a
+		return
+	}
+	*c.PC = 0x298
+}
+
+func block298(c *chip8.Context) {
+	// 0x298: 0x75ff ADD V5, 0xff
+	c.V[0x5] += 0xff
+	*c.PC = 0x29a
+}
+
+func block29a(c *chip8.Context) {
+	// 0x29a: 0x4602 SNE V6, 0x02
+	if c.V[0x6] != 0x02 {
+		*c.PC = 0x29e
+		return
+	}
+	*c.PC = 0x29c
+}
+
+func block29c(c *chip8.Context) {
+	// 0x29c: 0x74ff ADD V4, 0xff
+	c.V[0x4] += 0xff
+	*c.PC = 0x29e
+}
+
+func block29e(c *chip8.Context) {
+	// 0x29e: 0x4603 SNE V6, 0x03
+	if c.V[0x6] != 0x03 {
+		*c.PC = 0x2a2
+		return
+	}
+	*c.PC = 0x2a0
+}
+
+func block2a0(c *chip8.Context) {
+	// 0x2a0: 0x7501 ADD V5, 0x01
+	c.V[0x5] += 0x01
+	*c.PC = 0x2a2
+}
+
+func block2a2(c *chip8.Context) {
+	// 0x2a2: 0x4604 SNE V6, 0x04
+	if c.V[0x6] != 0x04 {
+		*c.PC = 0x2a6
+		return
+	}
+	*c.PC = 0x2a4
+}
+
+func block2a4(c *chip8.Context) {
+	// 0x2a4: 0x7401 ADD V4, 0x01
+	c.V[0x4] += 0x01
+	*c.PC = 0x2a6
+}
+
+func block2a6(c *chip8.Context) {
+	// 0x2a6: 0xd011 DRW V0, V1, 0x1
+	c.Exec(0x2a6)
+	// 0x2a8: 0x3f00 SE Vf, 0x00
+	if c.V[0xf] == 0x00 {
+		*c.PC = 0x2ac
+		return
+	}
+	*c.PC = 0x2aa
+}
+
+func block2aa(c *chip8.Context) {
+	// 0x2aa: 0x12b4 JP 0x2b4
+	*c.PC = 0x2b4
+}
+
+func block2ac(c *chip8.Context) {
+	// 0x2ac: 0xd451 DRW V4, V5, 0x1
+	c.Exec(0x2ac)
+	// 0x2ae: 0x3f00 SE Vf, 0x00
+	if c.V[0xf] == 0x00 {
+		*c.PC = 0x2b2
+		return
+	}
+	*c.PC = 0x2b0
+}
+
+func block2b0(c *chip8.Context) {
+	// 0x2b0: 0x12b8 JP 0x2b8
+	*c.PC = 0x2b8
+}
+
+func block2b2(c *chip8.Context) {
+	// 0x2b2: 0x1256 JP 0x256
+	*c.PC = 0x256
+}
+
+func block2b4(c *chip8.Context) {
+	// 0x2b4: 0x7701 ADD V7, 0x01
+	c.V[0x7] += 0x01
+	// 0x2b6: 0x12ba JP 0x2ba
+	*c.PC = 0x2ba
+}
+
+func block2b8(c *chip8.Context) {
+	// 0x2b8: 0x7301 ADD V3, 0x01
+	c.V[0x3] += 0x01
+	*c.PC = 0x2ba
+}
+
+func block2ba(c *chip8.Context) {
+	// 0x2ba: 0x6800 LD V8, 0x00
+	c.V[0x8] = 0x00
+	*c.PC = 0x2bc
+}
+
+func block2bc(c *chip8.Context) {
+	// 0x2bc: 0x7801 ADD V8, 0x01
+	c.V[0x8] += 0x01
+	// 0x2be: 0x3800 SE V8, 0x00
+	if c.V[0x8] == 0x00 {
+		*c.PC = 0x2c2
+		return
+	}
+	*c.PC = 0x2c0
+}
+
+func block2c0(c *chip8.Context) {
+	// 0x2c0: 0x12bc JP 0x2bc
+	*c.PC = 0x2bc
+}
+
+func block2c2(c *chip8.Context) {
+	// 0x2c2: 0x00e0 CLS
+	c.Exec(0x2c2)
+	// 0x2c4: 0x6008 LD V0, 0x08
+	c.V[0x0] = 0x08
+	// 0x2c6: 0x6104 LD V1, 0x04
+	c.V[0x1] = 0x04
+	// 0x2c8: 0xf329 LD F, V3
+	*c.I = uint16(c.V[0x3] * 5)
+	// 0x2ca: 0xd015 DRW V0, V1, 0x5
+	c.Exec(0x2ca)
+	// 0x2cc: 0x6034 LD V0, 0x34
+	c.V[0x0] = 0x34
+	// 0x2ce: 0xf729 LD F, V7
+	*c.I = uint16(c.V[0x7] * 5)
+	// 0x2d0: 0xd015 DRW V0, V1, 0x5
+	c.Exec(0x2d0)
+	// 0x2d2: 0x6800 LD V8, 0x00
+	c.V[0x8] = 0x00
+	*c.PC = 0x2d4
+}
+
+func block2d4(c *chip8.Context) {
+	// 0x2d4: 0x7801 ADD V8, 0x01
+	c.V[0x8] += 0x01
+	// 0x2d6: 0x3800 SE V8, 0x00
+	if c.V[0x8] == 0x00 {
+		*c.PC = 0x2da
+		return
+	}
+	*c.PC = 0x2d8
+}
+
+func block2d8(c *chip8.Context) {
+	// 0x2d8: 0x12d4 JP 0x2d4
+	*c.PC = 0x2d4
+}
+
+func block2da(c *chip8.Context) {
+	// 0x2da: 0x4308 SNE V3, 0x08
+	if c.V[0x3] != 0x08 {
+		*c.PC = 0x2de
+		return
+	}
+	*c.PC = 0x2dc
+}
+
+func block2dc(c *chip8.Context) {
+	// 0x2dc: 0x12e4 JP 0x2e4
+	*c.PC = 0x2e4
+}
+
+func block2de(c *chip8.Context) {
+	// 0x2de: 0x4708 SNE V7, 0x08
+	if c.V[0x7] != 0x08 {
+		*c.PC = 0x2e2
+		return
+	}
+	*c.PC = 0x2e0
+}
+
+func block2e0(c *chip8.Context) {
+	// 0x2e0: 0x12e4 JP 0x2e4
+	*c.PC = 0x2e4
+}
+
+func block2e2(c *chip8.Context) {
+	// 0x2e2: 0x121e JP 0x21e
+	*c.PC = 0x21e
+}
+
+func block2e4(c *chip8.Context) {
+	// 0x2e4: 0x12e4 JP 0x2e4
+	*c.PC = 0x2e4
+}
@@ -0,0 +1,450 @@
+// Code generated by go-chip8 recompile from WIPEOFF; DO NOT EDIT.
+
+package wipeoff
+
+import "github.com/morinokami/go-chip8/chip8"
+
+var Program = &chip8.Program{
+	ROM: "4304cafe94cc85802ec52b330f7ab3dcd7aee3a91b2c653aa441aad3cc741420",
+	Blocks: map[uint16]*chip8.Block{
+		0x200: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxByte, chip8.LDVxByte}, Run: block200},
+		0x206: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte}, Run: block206},
+		0x20a: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxByte, chip8.ADDVxByte, chip8.SEVxByte}, Run: block20a},
+		0x212: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block212},
+		0x214: {Insts: []chip8.Instruction{chip8.ADDVxByte, chip8.ADDVxByte, chip8.SEVxByte}, Run: block214},
+		0x21a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block21a},
+		0x21c: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.LDIAddr, chip8.LDVxByte, chip8.LDVxByte, chip8.DRW}, Run: block21c},
+		0x228: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDVxByte, chip8.AND, chip8.ADDVxByte, chip8.SNEVxByte}, Run: block228},
+		0x232: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block232},
+		0x236: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.LDVxByte, chip8.RND, chip8.SEVxByte}, Run: block236},
+		0x240: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block240},
+		0x242: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.LDVxByte, chip8.LDVxByte, chip8.SKNP}, Run: block242},
+		0x24a: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block24a},
+		0x24c: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.SKNP}, Run: block24c},
+		0x250: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block250},
+		0x252: {Insts: []chip8.Instruction{chip8.DRW, chip8.ADDVxVy, chip8.DRW, chip8.SNEVxByte}, Run: block252},
+		0x25a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block25a},
+		0x25c: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block25c},
+		0x25e: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block25e},
+		0x260: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block260},
+		0x262: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block262},
+		0x264: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block264},
+		0x266: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block266},
+		0x268: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block268},
+		0x26a: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block26a},
+		0x26c: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.ADDVxVy, chip8.ADDVxVy, chip8.DRW, chip8.SEVxByte}, Run: block26c},
+		0x278: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block278},
+		0x27a: {Insts: []chip8.Instruction{chip8.SNEVxByte}, Run: block27a},
+		0x27c: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block27c},
+		0x27e: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDSTVx, chip8.ADDVxByte, chip8.SNEVxByte}, Run: block27e},
+		0x286: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block286},
+		0x288: {Insts: []chip8.Instruction{chip8.DRW, chip8.RND, chip8.SEVxByte}, Run: block288},
+		0x28e: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block28e},
+		0x290: {Insts: []chip8.Instruction{chip8.RND, chip8.SEVxByte}, Run: block290},
+		0x294: {Insts: []chip8.Instruction{chip8.LDVxByte}, Run: block294},
+		0x296: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block296},
+		0x298: {Insts: []chip8.Instruction{chip8.LDVxByte, chip8.LDSTVx, chip8.LDIAddr, chip8.DRW, chip8.ADDVxByte, chip8.JPAddr}, Run: block298},
+		0x2a4: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.JPAddr}, Run: block2a4},
+		0x2aa: {Insts: []chip8.Instruction{chip8.LDIAddr, chip8.DRW, chip8.LDIAddr, chip8.LDBVx}, Run: block2aa},
+		0x2b2: {Insts: []chip8.Instruction{chip8.LDVxI, chip8.LDVxByte, chip8.LDVxByte, chip8.LDFVx, chip8.DRW, chip8.ADDVxByte, chip8.LDFVx, chip8.DRW, chip8.ADDVxByte, chip8.LDFVx}, Run: block2b2},
+		0x2c8: {Insts: []chip8.Instruction{chip8.JPAddr}, Run: block2c8},
+	},
+}
+
+func block200(c *chip8.Context) {
+	// 0x200: 0xa2cc LD I, 0x2cc
+	*c.I = 0x2cc
+	// 0x202: 0x6a07 LD Va, 0x07
+	c.V[0xa] = 0x07
+	// 0x204: 0x6100 LD V1, 0x00
+	c.V[0x1] = 0x00
+	*c.PC = 0x206
+}
+
+func block206(c *chip8.Context) {
+	// 0x206: 0x6b08 LD Vb, 0x08
+	c.V[0xb] = 0x08
+	// 0x208: 0x6000 LD V0, 0x00
+	c.V[0x0] = 0x00
+	*c.PC = 0x20a
+}
+
+func block20a(c *chip8.Context) {
+	// 0x20a: 0xd011 DRW V0, V1, 0x1
+	c.Exec(0x20a)
+	// 0x20c: 0x7008 ADD V0, 0x08
+	c.V[0x0] += 0x08
+	// 0x20e: 0x7bff ADD Vb, 0xff
+	c.V[0xb] += 0xff
+	// 0x210: 0x3b00 SE Vb, 0x00
+	if c.V[0xb] == 0x00 {
+		*c.PC = 0x214
+		return
+	}
+	*c.PC = 0x212
+}
+
+func block212(c *chip8.Context) {
+	// 0x212: 0x120a JP 0x20a
+	*c.PC = 0x20a
+}
+
+func block214(c *chip8.Context) {
+	// 0x214: 0x7104 ADD V1, 0x04
+	c.V[0x1] += 0x04
+	// 0x216: 0x7aff ADD Va, 0xff
+	c.V[0xa] += 0xff
+	// 0x218: 0x3a00 SE Va, 0x00
+	if c.V[0xa] == 0x00 {
+		*c.PC = 0x21c
+		return
+	}
+	*c.PC = 0x21a
+}
+
+func block21a(c *chip8.Context) {
+	// 0x21a: 0x1206 JP 0x206
+	*c.PC = 0x206
+}
+
+func block21c(c *chip8.Context) {
+	// 0x21c: 0x6600 LD V6, 0x00
+	c.V[0x6] = 0x00
+	// 0x21e: 0x6710 LD V7, 0x10
+	c.V[0x7] = 0x10
+	// 0x220: 0xa2cd LD I, 0x2cd
+	*c.I = 0x2cd
+	// 0x222: 0x6020 LD V0, 0x20
+	c.V[0x0] = 0x20
+	// 0x224: 0x611e LD V1, 0x1e
+	c.V[0x1] = 0x1e
+	// 0x226: 0xd011 DRW V0, V1, 0x1
+	c.Exec(0x226)
+	*c.PC = 0x228
+}
+
+func block228(c *chip8.Context) {
+	// 0x228: 0x631d LD V3, 0x1d
+	c.V[0x3] = 0x1d
+	// 0x22a: 0x623f LD V2, 0x3f
+	c.V[0x2] = 0x3f
+	// 0x22c: 0x8202 AND V2, V0
+	c.V[0x2] &= c.V[0x0]
+	// 0x22e: 0x77ff ADD V7, 0xff
+	c.V[0x7] += 0xff
+	// 0x230: 0x4700 SNE V7, 0x00
+	if c.V[0x7] != 0x00 {
+		*c.PC = 0x234
+		return
+	}
+	*c.PC = 0x232
+}
+
+func block232(c *chip8.Context) {
+	// 0x232: 0x12aa JP 0x2aa
+	*c.PC = 0x2aa
+}
+
+func block236(c *chip8.Context) {
+	// 0x236: 0xa2cb LD I, 0x2cb
+	*c.I = 0x2cb
+	// 0x238: 0xd231 DRW V2, V3, 0x1
+	c.Exec(0x238)
+	// 0x23a: 0x65ff LD V5, 0xff
+	c.V[0x5] = 0xff
+	// 0x23c: 0xc401 RND V4, byte
+	c.Exec(0x23c)
+	// 0x23e: 0x3401 SE V4, 0x01
+	if c.V[0x4] == 0x01 {
+		*c.PC = 0x242
+		return
+	}
+	*c.PC = 0x240
+}
+
+func block240(c *chip8.Context) {
+	// 0x240: 0x64ff LD V4, 0xff
+	c.V[0x4] = 0xff
+	*c.PC = 0x242
+}
+
+func block242(c *chip8.Context) {
+	// 0x242: 0xa2cd LD I, 0x2cd
+	*c.I = 0x2cd
+	// 0x244: 0x6c00 LD Vc, 0x00
+	c.V[0xc] = 0x00
+	// 0x246: 0x6e04 LD Ve, 0x04
+	c.V[0xe] = 0x04
+	// 0x248: 0xeea1 SKNP Ve
+	if !c.Pressed(c.V[0xe]) {
+		*c.PC = 0x24c
+		return
+	}
+	*c.PC = 0x24a
+}
+
+func block24a(c *chip8.Context) {
+	// 0x24a: 0x6cff LD Vc, 0xff
+	c.V[0xc] = 0xff
+	*c.PC = 0x24c
+}
+
+func block24c(c *chip8.Context) {
+	// 0x24c: 0x6e06 LD Ve, 0x06
+	c.V[0xe] = 0x06
+	// 0x24e: 0xeea1 SKNP Ve
+	if !c.Pressed(c.V[0xe]) {
+		*c.PC = 0x252
+		return
+	}
+	*c.PC = 0x250
+}
+
+func block250(c *chip8.Context) {
+	// 0x250: 0x6c01 LD Vc, 0x01
+	c.V[0xc] = 0x01
+	*c.PC = 0x252
+}
+
+func block252(c *chip8.Context) {
+	// 0x252: 0xd011 DRW V0, V1, 0x1
+	c.Exec(0x252)
+	// 0x254: 0x80c4 ADD V0, Vc
+	c.Exec(0x254)
+	// 0x256: 0xd011 DRW V0, V1, 0x1
+	c.Exec(0x256)
+	// 0x258: 0x4f01 SNE Vf, 0x01
+	if c.V[0xf] != 0x01 {
+		*c.PC = 0x25c
+		return
+	}
+	*c.PC = 0x25a
+}
+
+func block25a(c *chip8.Context) {
+	// 0x25a: 0x1298 JP 0x298
+	*c.PC = 0x298
+}
+
+func block25c(c *chip8.Context) {
+	// 0x25c: 0x4200 SNE V2, 0x00
+	if c.V[0x2] != 0x00 {
+		*c.PC = 0x260
+		return
+	}
+	*c.PC = 0x25e
+}
+
+func block25e(c *chip8.Context) {
+	// 0x25e: 0x6401 LD V4, 0x01
+	c.V[0x4] = 0x01
+	*c.PC = 0x260
+}
+
+func block260(c *chip8.Context) {
+	// 0x260: 0x423f SNE V2, 0x3f
+	if c.V[0x2] != 0x3f {
+		*c.PC = 0x264
+		return
+	}
+	*c.PC = 0x262
+}
+
+func block262(c *chip8.Context) {
+	// 0x262: 0x64ff LD V4, 0xff
+	c.V[0x4] = 0xff
+	*c.PC = 0x264
+}
+
+func block264(c *chip8.Context) {
+	// 0x264: 0x4300 SNE V3, 0x00
+	if c.V[0x3] != 0x00 {
+		*c.PC = 0x268
+		return
+	}
+	*c.PC = 0x266
+}
+
+func block266(c *chip8.Context) {
+	// 0x266: 0x6501 LD V5, 0x01
+	c.V[0x5] = 0x01
+	*c.PC = 0x268
+}
+
+func block268(c *chip8.Context) {
+	// 0x268: 0x431f SNE V3, 0x1f
+	if c.V[0x3] != 0x1f {
+		*c.PC = 0x26c
+		return
+	}
+	*c.PC = 0x26a
+}
+
+func block26a(c *chip8.Context) {
+	// 0x26a: 0x12a4 JP 0x2a4
+	*c.PC = 0x2a4
+}
+
+func block26c(c *chip8.Context) {
+	// 0x26c: 0xa2cb LD I, 0x2cb
+	*c.I = 0x2cb
+	// 0x26e: 0xd231 DRW V2, V3, 0x1
+	c.Exec(0x26e)
+	// 0x270: 0x8244 ADD V2, V4
+	c.Exec(0x270)
+	// 0x272: 0x8354 ADD V3, V5
+	c.Exec(0x272)
+	// 0x274: 0xd231 DRW V2, V3, 0x1
+	c.Exec(0x274)
+	// 0x276: 0x3f01 SE Vf, 0x01
+	if c.V[0xf] == 0x01 {
+		*c.PC = 0x27a
+		return
+	}
+	*c.PC = 0x278
+}
+
+func block278(c *chip8.Context) {
+	// 0x278: 0x1242 JP 0x242
+	*c.PC = 0x242
+}
+
+func block27a(c *chip8.Context) {
+	// 0x27a: 0x431e SNE V3, 0x1e
+	if c.V[0x3] != 0x1e {
+		*c.PC = 0x27e
+		return
+	}
+	*c.PC = 0x27c
+}
+
+func block27c(c *chip8.Context) {
+	// 0x27c: 0x1298 JP 0x298
+	*c.PC = 0x298
+}
+
+func block27e(c *chip8.Context) {
+	// 0x27e: 0x6a02 LD Va, 0x02
+	c.V[0xa] = 0x02
+	// 0x280: 0xfa18 LD ST, Va
+	*c.ST = c.V[0xa]
+	// 0x282: 0x7601 ADD V6, 0x01
+	c.V[0x6] += 0x01
+	// 0x284: 0x4670 SNE V6, 0x70
+	if c.V[0x6] != 0x70 {
+		*c.PC = 0x288
+		return
+	}
+	*c.PC = 0x286
+}
+
+func block286(c *chip8.Context) {
+	// 0x286: 0x12aa JP 0x2aa
+	*c.PC = 0x2aa
+}
+
+func block288(c *chip8.Context) {
+	// 0x288: 0xd231 DRW V2, V3, 0x1
+	c.Exec(0x288)
+	// 0x28a: 0xc401 RND V4, byte
+	c.Exec(0x28a)
+	// 0x28c: 0x3401 SE V4, 0x01
+	if c.V[0x4] == 0x01 {
+		*c.PC = 0x290
+		return
+	}
+	*c.PC = 0x28e
+}
+
+func block28e(c *chip8.Context) {
+	// 0x28e: 0x64ff LD V4, 0xff
+	c.V[0x4] = 0xff
+	*c.PC = 0x290
+}
+
+func block290(c *chip8.Context) {
+	// 0x290: 0xc501 RND V5, byte
+	c.Exec(0x290)
+	// 0x292: 0x3501 SE V5, 0x01
+	if c.V[0x5] == 0x01 {
+		*c.PC = 0x296
+		return
+	}
+	*c.PC = 0x294
+}
+
+func block294(c *chip8.Context) {
+	// 0x294: 0x65ff LD V5, 0xff
+	c.V[0x5] = 0xff
+	*c.PC = 0x296
+}
+
+func block296(c *chip8.Context) {
+	// 0x296: 0x1242 JP 0x242
+	*c.PC = 0x242
+}
+
+func block298(c *chip8.Context) {
+	// 0x298: 0x6a03 LD Va, 0x03
+	c.V[0xa] = 0x03
+	// 0x29a: 0xfa18 LD ST, Va
+	*c.ST = c.V[0xa]
+	// 0x29c: 0xa2cb LD I, 0x2cb
+	*c.I = 0x2cb
+	// 0x29e: 0xd231 DRW V2, V3, 0x1
+	c.Exec(0x29e)
+	// 0x2a0: 0x73ff ADD V3, 0xff
+	c.V[0x3] += 0xff
+	// 0x2a2: 0x1236 JP 0x236
+	*c.PC = 0x236
+}
+
+func block2a4(c *chip8.Context) {
+	// 0x2a4: 0xa2cb LD I, 0x2cb
+	*c.I = 0x2cb
+	// 0x2a6: 0xd231 DRW V2, V3, 0x1
+	c.Exec(0x2a6)
+	// 0x2a8: 0x1228 JP 0x228
+	*c.PC = 0x228
+}
+
+func block2aa(c *chip8.Context) {
+	// 0x2aa: 0xa2cd LD I, 0x2cd
+	*c.I = 0x2cd
+	// 0x2ac: 0xd011 DRW V0, V1, 0x1
+	c.Exec(0x2ac)
+	// 0x2ae: 0xa2f0 LD I, 0x2f0
+	*c.I = 0x2f0
+	// 0x2b0: 0xf633 LD B, V6
+	c.Exec(0x2b0)
+	*c.PC = 0x2b2
+}
+
+func block2b2(c *chip8.Context) {
+	// 0x2b2: 0xf265 LD V2, [I]
+	c.Exec(0x2b2)
+	// 0x2b4: 0x6318 LD V3, 0x18
+	c.V[0x3] = 0x18
+	// 0x2b6: 0x641b LD V4, 0x1b
+	c.V[0x4] = 0x1b
+	// 0x2b8: 0xf029 LD F, V0
+	*c.I = uint16(c.V[0x0] * 5)
+	// 0x2ba: 0xd345 DRW V3, V4, 0x5
+	c.Exec(0x2ba)
+	// 0x2bc: 0x7305 ADD V3, 0x05
+	c.V[0x3] += 0x05
+	// 0x2be: 0xf129 LD F, V1
+	*c.I = uint16(c.V[0x1] * 5)
+	// 0x2c0: 0xd345 DRW V3, V4, 0x5
+	c.Exec(0x2c0)
+	// 0x2c2: 0x7305 ADD V3, 0x05
+	c.V[0x3] += 0x05
+	// 0x2c4: 0xf229 LD F, V2
+	*c.I = uint16(c.V[0x2] * 5)
+	*c.PC = 0x2c6
+}
+
+func block2c8(c *chip8.Context) {
+	// 0x2c8: 0x12c8 JP 0x2c8
+	*c.PC = 0x2c8
+}
@@ -1,7 +1,9 @@
 package games
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -140,3 +142,19 @@ func AvailableGames() string {
 	}
 	return strings.Join(desc, ", ")
 }
+
+// Find returns the game with the given id or name.
+func Find(s string) (Game, error) {
+	if i, err := strconv.Atoi(s); err == nil {
+		if i < 0 || len(Games)-1 < i {
+			return Game{}, errors.New("invalid game id")
+		}
+		return Games[i], nil
+	}
+	for _, g := range Games {
+		if strings.EqualFold(g.Name, s) {
+			return g, nil
+		}
+	}
+	return Game{}, fmt.Errorf("unknown game: %s", s)
+}
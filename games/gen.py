@@ -6,7 +6,9 @@ template = '''
 package games
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -26,6 +28,22 @@ func AvailableGames() string {{
 	}}
 	return strings.Join(desc, ", ")
 }}
+
+// Find returns the game with the given id or name.
+func Find(s string) (Game, error) {{
+	if i, err := strconv.Atoi(s); err == nil {{
+		if i < 0 || len(Games)-1 < i {{
+			return Game{{}}, errors.New("invalid game id")
+		}}
+		return Games[i], nil
+	}}
+	for _, g := range Games {{
+		if strings.EqualFold(g.Name, s) {{
+			return g, nil
+		}}
+	}}
+	return Game{{}}, fmt.Errorf("unknown game: %s", s)
+}}
 '''
 
 game_template = '''
@@ -36,7 +54,7 @@ game_template = '''
 '''
 
 games = ''
-filter_func = lambda x: not x.endswith('.py') and not x.endswith('.go')
+filter_func = lambda x: os.path.isfile(x) and not x.endswith('.py') and not x.endswith('.go')
 for game in filter(filter_func, sorted(os.listdir('.'))):
     with open(game, 'rb') as f:
         binary = ', '.join('0x{:02X}'.format(b) for b in f.read())
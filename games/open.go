@@ -0,0 +1,39 @@
+package games
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/morinokami/go-chip8/patch"
+)
+
+// Open returns the game s, which is either a bundled game, as for Find, or
+// the path of a ROM file. The patch at patchPath is applied to the ROM if
+// set; otherwise a ROM file is patched with the .bps or .ips file next to
+// it, if any. A ROM file is named after it, without its extension.
+func Open(s, patchPath string) (Game, error) {
+	var g Game
+	if fi, err := os.Stat(s); err == nil && fi.Mode().IsRegular() {
+		rom, err := ioutil.ReadFile(s)
+		if err != nil {
+			return Game{}, err
+		}
+		name := filepath.Base(s)
+		g = Game{Name: strings.ToUpper(strings.TrimSuffix(name, filepath.Ext(name))), Binary: rom}
+		if patchPath == "" {
+			patchPath = patch.Sibling(s)
+		}
+	} else if g, err = Find(s); err != nil {
+		return Game{}, err
+	}
+	if patchPath != "" {
+		rom, err := patch.ApplyFile(g.Binary, patchPath)
+		if err != nil {
+			return Game{}, err
+		}
+		g.Binary = rom
+	}
+	return g, nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/morinokami/go-chip8/patch"
+	"github.com/urfave/cli/v2"
+)
+
+func mkpatchCommand() *cli.Command {
+	var out string
+	var format string
+	return &cli.Command{
+		Name:      "mkpatch",
+		Usage:     "create an IPS or BPS patch from an original ROM to a modified one",
+		ArgsUsage: "original modified",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "output",
+				Aliases:     []string{"o"},
+				Usage:       "patch file (default: next to the original ROM, so that it is applied when the original is loaded)",
+				Destination: &out,
+			},
+			&cli.StringFlag{
+				Name:        "format",
+				Usage:       "patch format, ips or bps (default: from the extension of the output, or bps)",
+				Destination: &format,
+			},
+		},
+		Action: func(c *cli.Context) error {
+			if c.NArg() != 2 {
+				return errors.New("usage: go-chip8 mkpatch original modified")
+			}
+			original, err := ioutil.ReadFile(c.Args().Get(0))
+			if err != nil {
+				return err
+			}
+			modified, err := ioutil.ReadFile(c.Args().Get(1))
+			if err != nil {
+				return err
+			}
+			if format == "" {
+				format = "bps"
+				if ext := strings.ToLower(filepath.Ext(out)); ext == ".ips" {
+					format = "ips"
+				}
+			}
+			if out == "" {
+				path := c.Args().Get(0)
+				out = strings.TrimSuffix(path, filepath.Ext(path)) + "." + format
+			}
+			p, err := patch.Create(format, original, modified)
+			if err != nil {
+				return err
+			}
+			if err := ioutil.WriteFile(out, p, 0644); err != nil {
+				return err
+			}
+			fmt.Printf("Wrote %s\n", out)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+
+	"github.com/morinokami/go-chip8/chip8"
+	"github.com/morinokami/go-chip8/games"
+	"github.com/morinokami/go-chip8/netplay"
+	"github.com/urfave/cli/v2"
+)
+
+func netplayCommand() *cli.Command {
+	var host string
+	var join string
+	var game string
+	var ui string
+	var mode string
+	var delay int
+	var rollback int
+	var seed int64
+	var hostKeys string
+	var guestKeys string
+	var frames uint
+	return &cli.Command{
+		Name:  "netplay",
+		Usage: "play a two-player game with someone on another machine",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "host",
+				Usage:       "wait for the other player on `ADDR`, such as :7000",
+				Destination: &host,
+			},
+			&cli.StringFlag{
+				Name:        "join",
+				Usage:       "join the game hosted on `ADDR`, such as example.com:7000",
+				Destination: &join,
+			},
+			&cli.StringFlag{
+				Name:        "game",
+				Aliases:     []string{"g"},
+				Value:       "PONG2",
+				Usage:       "enter a ROM file, an Octo .8o or .gif file, a name or one of the following numbers: " + games.AvailableGames(),
+				Destination: &game,
+			},
+			&cli.StringFlag{
+				Name:        "ui",
+				Value:       "window",
+				Usage:       "front end, one of: window, tty, headless",
+				Destination: &ui,
+			},
+			&cli.StringFlag{
+				Name:        "mode",
+				Aliases:     []string{"m"},
+				Value:       "normal",
+				Usage:       "display mode, one of: " + chip8.AvailableDisplayModes(),
+				Destination: &mode,
+			},
+			&cli.IntFlag{
+				Name:        "delay",
+				Value:       2,
+				Usage:       "input delay in frames (host only)",
+				Destination: &delay,
+			},
+			&cli.IntFlag{
+				Name:        "rollback",
+				Usage:       "run up to this many frames ahead of the other player's input, instead of waiting for it",
+				Destination: &rollback,
+			},
+			&cli.Int64Flag{
+				Name:        "seed",
+				Usage:       "seed for the random number generator (host only, default: current time)",
+				Destination: &seed,
+			},
+			&cli.StringFlag{
+				Name:        "keys",
+				Usage:       "keys of the host as hexadecimal digits, such as 14 (host only, default: depends on the game)",
+				Destination: &hostKeys,
+			},
+			&cli.StringFlag{
+				Name:        "guest-keys",
+				Usage:       "keys of the guest as hexadecimal digits, such as CD (host only, default: depends on the game)",
+				Destination: &guestKeys,
+			},
+			&cli.UintFlag{
+				Name:        "frames",
+				Usage:       "stop after this many frames and print the state hash",
+				Destination: &frames,
+			},
+		},
+		Action: func(c *cli.Context) error {
+			if (host == "") == (join == "") {
+				return errors.New("exactly one of --host and --join is required")
+			}
+			g, settings, palette, err := openGame(game, "")
+			if err != nil {
+				return err
+			}
+			m, err := chip8.ParseDisplayMode(mode)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			hotkey := func(h chip8.Hotkey) {
+				// pausing or resetting one side only would desync the game
+				if h == chip8.HotkeyQuit {
+					cancel()
+				}
+			}
+			var frontend chip8.UI
+			switch ui {
+			case "window":
+				display := chip8.NewDisplay()
+				display.SetMode(m)
+				display.SetPalette(palette)
+				display.SetHotkeyHandler(hotkey)
+				frontend = display
+			case "tty":
+				terminal := chip8.NewTerminal()
+				terminal.SetMode(m)
+				terminal.SetHotkeyHandler(hotkey)
+				frontend = terminal
+			case "headless":
+				frontend = chip8.NewHeadless()
+			default:
+				return errors.New("invalid ui: " + ui)
+			}
+
+			opts := []netplay.Option{netplay.WithRollback(rollback), netplay.WithFrames(uint32(frames))}
+			var conn net.Conn
+			var s *netplay.Session
+			if host != "" {
+				layout := netplay.LayoutFor(g.Name)
+				if hostKeys != "" {
+					if layout.Host, err = netplay.ParseKeys(hostKeys); err != nil {
+						return err
+					}
+				}
+				if guestKeys != "" {
+					if layout.Guest, err = netplay.ParseKeys(guestKeys); err != nil {
+						return err
+					}
+				}
+				opts = append(opts, netplay.WithDelay(delay), netplay.WithLayout(layout), netplay.WithSettings(settings))
+				if c.IsSet("seed") {
+					opts = append(opts, netplay.WithSeed(seed))
+				}
+
+				l, err := net.Listen("tcp", host)
+				if err != nil {
+					return err
+				}
+				log.Printf("waiting for the other player on %s", l.Addr())
+				conn, err = l.Accept()
+				l.Close()
+				if err != nil {
+					return err
+				}
+				s, err = netplay.Host(conn, g.Binary, frontend, opts...)
+				if err != nil {
+					conn.Close()
+					return err
+				}
+			} else {
+				conn, err = net.Dial("tcp", join)
+				if err != nil {
+					return err
+				}
+				s, err = netplay.Join(conn, g.Binary, frontend, opts...)
+				if err != nil {
+					conn.Close()
+					return err
+				}
+			}
+			defer s.Close()
+			log.Printf("playing %s with %s", g.Name, conn.RemoteAddr())
+
+			sig := make(chan os.Signal, 1)
+			signal.Notify(sig, os.Interrupt)
+			go func() {
+				select {
+				case <-sig:
+					cancel()
+				case <-ctx.Done():
+				}
+			}()
+			frontend.Run(func() {
+				frontend.Init()
+				err = s.Run(ctx)
+				frontend.Close()
+			})
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if frames > 0 {
+				fmt.Println(s.Emulator().Hash())
+			}
+			return nil
+		},
+	}
+}
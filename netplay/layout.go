@@ -0,0 +1,62 @@
+package netplay
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/morinokami/go-chip8/chip8"
+)
+
+// Layout splits the keypad between the players: each one's keys are masked
+// with their half before being sent.
+type Layout struct {
+	Host, Guest chip8.Keypad
+}
+
+// DefaultLayout gives the host the left half of the keypad and the guest
+// the right half.
+var DefaultLayout = Layout{
+	Host:  keys(0x1, 0x2, 0x4, 0x5, 0x7, 0x8, 0xA, 0x0),
+	Guest: keys(0x3, 0xC, 0x6, 0xD, 0x9, 0xE, 0xB, 0xF),
+}
+
+// Layouts are the layouts of the bundled games made for two players, by
+// name.
+var Layouts = map[string]Layout{
+	// left paddle with 1 and 4, right paddle with C and D
+	"PONG2": {Host: keys(0x1, 0x4), Guest: keys(0xC, 0xD)},
+	// one player drives with 2, 4, 6 and 8, the other fires with 5
+	"TANK": {Host: keys(0x2, 0x4, 0x6, 0x8), Guest: keys(0x5)},
+	// players take turns moving with 4 and 6 and dropping with 5
+	"CONNECT4": {Host: keys(0x4, 0x5, 0x6), Guest: keys(0x4, 0x5, 0x6)},
+}
+
+// LayoutFor returns the layout of the bundled game name, or DefaultLayout.
+func LayoutFor(name string) Layout {
+	if l, ok := Layouts[name]; ok {
+		return l
+	}
+	return DefaultLayout
+}
+
+// ParseKeys parses a set of keys written as hexadecimal digits, such as
+// "14" for keys 1 and 4.
+func ParseKeys(s string) (chip8.Keypad, error) {
+	var k chip8.Keypad
+	for _, c := range strings.ToUpper(s) {
+		i := strings.IndexRune("0123456789ABCDEF", c)
+		if i < 0 {
+			return 0, fmt.Errorf("invalid key: %q", c)
+		}
+		k |= 1 << i
+	}
+	return k, nil
+}
+
+func keys(ks ...byte) chip8.Keypad {
+	var k chip8.Keypad
+	for _, key := range ks {
+		k |= 1 << key
+	}
+	return k
+}
@@ -0,0 +1,226 @@
+package netplay
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/morinokami/go-chip8/chip8"
+	"github.com/morinokami/go-chip8/games"
+)
+
+const testFrames = 600
+
+// script is a UI whose keypad follows input, one call per frame.
+type script struct {
+	*chip8.Headless
+	input func(i int) chip8.Keypad
+	i     int
+}
+
+func (s *script) Keypad() chip8.Keypad {
+	k := s.input(s.i)
+	s.i++
+	return k
+}
+
+// hostInput and guestInput press every key in turn, the guest more slowly.
+func hostInput(i int) chip8.Keypad {
+	if i/7%2 == 1 {
+		return 0
+	}
+	return 1 << (i / 14 % 16)
+}
+
+func guestInput(i int) chip8.Keypad {
+	if i/11%3 == 2 {
+		return 0
+	}
+	return 1 << (i / 33 % 16)
+}
+
+// connect starts a host and a guest session over a loopback connection.
+func connect(t *testing.T, rom []byte, host, guest []Option) (*Session, *Session) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	type result struct {
+		s   *Session
+		err error
+	}
+	hc := make(chan result)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			hc <- result{err: err}
+			return
+		}
+		s, err := Host(conn, rom, &script{Headless: chip8.NewHeadless(), input: hostInput}, host...)
+		hc <- result{s, err}
+	}()
+	conn, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	g, err := Join(conn, rom, &script{Headless: chip8.NewHeadless(), input: guestInput}, guest...)
+	h := <-hc
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.err != nil {
+		t.Fatal(h.err)
+	}
+	t.Cleanup(func() {
+		h.s.Close()
+		g.Close()
+	})
+	return h.s, g
+}
+
+// play ticks the sessions, the host ahead of the guest, until both are done
+// or one fails.
+func play(h, g *Session) error {
+	for i := 0; i < 100*testFrames && !(h.Done() && g.Done()); i++ {
+		for _, s := range []*Session{h, h, h, g, g, g} {
+			if err := s.Tick(); err != nil {
+				return err
+			}
+		}
+		time.Sleep(100 * time.Microsecond)
+	}
+	if !h.Done() || !g.Done() {
+		return errors.New("sessions stalled")
+	}
+	return nil
+}
+
+// replay runs rom on its own with the input both sides would have sent,
+// and returns the state hash after testFrames frames.
+func replay(t *testing.T, rom []byte, delay int, l Layout) string {
+	ui := chip8.NewHeadless()
+	e := chip8.New(ui, chip8.WithSeed(1))
+	e.Load(rom)
+	for f := 0; f < testFrames; f++ {
+		var keys chip8.Keypad
+		if f >= delay {
+			keys = hostInput(f-delay)&l.Host | guestInput(f-delay)&l.Guest
+		}
+		ui.SetKeypad(keys)
+		if err := e.Frame(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return e.Hash()
+}
+
+func TestNetplay(t *testing.T) {
+	for _, name := range []string{"PONG2", "TANK", "CONNECT4"} {
+		g, err := games.Find(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := replay(t, g.Binary, 3, LayoutFor(name))
+		for _, tt := range []struct {
+			name   string
+			window int
+		}{
+			{"lockstep", 0},
+			{"rollback", 8},
+		} {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				h, gs := connect(t, g.Binary,
+					[]Option{WithSeed(1), WithDelay(3), WithHashInterval(10), WithLayout(LayoutFor(name)), WithFrames(testFrames), WithRollback(tt.window)},
+					[]Option{WithFrames(testFrames), WithRollback(tt.window)})
+				if err := play(h, gs); err != nil {
+					t.Fatal(err)
+				}
+				for _, s := range []*Session{h, gs} {
+					if got := s.Emulator().Hash(); got != want {
+						t.Errorf("hash: got=%s, want=%s", got, want)
+					}
+				}
+				if tt.window > 0 && h.Rollbacks() == 0 {
+					t.Error("the host never rolled back")
+				}
+				t.Logf("rollbacks: host %d, guest %d", h.Rollbacks(), gs.Rollbacks())
+			})
+		}
+	}
+}
+
+func TestDesync(t *testing.T) {
+	g, _ := games.Find("PONG2")
+	h, gs := connect(t, g.Binary,
+		[]Option{WithSeed(1), WithHashInterval(30), WithFrames(testFrames)},
+		[]Option{WithFrames(testFrames)})
+	// a cheat on one side only
+	gs.Emulator().WriteMemory(0xF00, []byte{1})
+
+	err := play(h, gs)
+	var desync *DesyncError
+	if !errors.As(err, &desync) {
+		t.Fatalf("got err=%v, want a desync", err)
+	}
+	if desync.Frame != 29 {
+		t.Errorf("desync after frame %d, want %d", desync.Frame, 29)
+	}
+}
+
+func TestHandshake(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	pong, _ := games.Find("PONG")
+	pong2, _ := games.Find("PONG2")
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		Host(conn, pong2.Binary, chip8.NewHeadless())
+	}()
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if _, err := Join(conn, pong.Binary, chip8.NewHeadless()); err == nil || !strings.Contains(err.Error(), "different ROM") {
+		t.Errorf("got err=%v, want a ROM mismatch", err)
+	}
+}
+
+func TestSettings(t *testing.T) {
+	// V0 += 1, loop
+	rom := []byte{0x70, 0x01, 0x12, 0x00}
+	h, gs := connect(t, rom,
+		[]Option{WithSettings(chip8.Settings{CyclesPerFrame: 30}), WithFrames(2)},
+		[]Option{WithFrames(2)})
+	if err := play(h, gs); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []*Session{h, gs} {
+		if got := s.Emulator().State().V[0]; got != 30 {
+			t.Errorf("V0 after 2 frames of 30 instructions: got=%d, want=30", got)
+		}
+	}
+}
+
+func TestParseKeys(t *testing.T) {
+	k, err := ParseKeys("14cD")
+	if err != nil || k != keys(0x1, 0x4, 0xC, 0xD) {
+		t.Errorf("got %016b, %v", k, err)
+	}
+	if _, err := ParseKeys("G"); err == nil {
+		t.Error("no error for key G")
+	}
+}
@@ -0,0 +1,149 @@
+package netplay
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/morinokami/go-chip8/chip8"
+)
+
+// The protocol starts with a handshake: the host sends its hello, and the
+// guest checks it and answers with its own. The host's settings win; the
+// guest's hello only proves that it runs the same ROM.
+//
+// After the handshake, each side sends messages, which start with their
+// kind:
+//
+//	msgInput, frame (uint32), keypad state (uint16): the input of the sender
+//	          on frame; inputs are sent for every frame, in order
+//	msgHash,  frame (uint32), state hash (32 bytes): the state of the sender
+//	          after frame, every HashInterval frames
+//
+// All numbers are big-endian.
+
+const (
+	magic           = "C8NP"
+	protocolVersion = 2
+)
+
+type hello struct {
+	Magic   [4]byte
+	Version uint8
+	// ROM is the SHA-256 hash of the ROM
+	ROM          [sha256.Size]byte
+	Seed         int64
+	Delay        uint8
+	HashInterval uint16
+	Host, Guest  uint16
+	// Settings are the emulator settings, encoded by
+	// chip8.Settings.MarshalBinary
+	Settings [chip8.SettingsSize]byte
+}
+
+func newHello(rom []byte, c *config) hello {
+	h := hello{
+		Version:      protocolVersion,
+		ROM:          sha256.Sum256(rom),
+		Seed:         c.seed,
+		Delay:        uint8(c.delay),
+		HashInterval: uint16(c.hashInterval),
+		Host:         uint16(c.layout.Host),
+		Guest:        uint16(c.layout.Guest),
+	}
+	copy(h.Magic[:], magic)
+	// the settings were checked by Host or decoded by Join
+	data, _ := c.settings.MarshalBinary()
+	copy(h.Settings[:], data)
+	return h
+}
+
+// check returns an error unless h is a valid hello for rom.
+func (h *hello) check(rom []byte) error {
+	if string(h.Magic[:]) != magic {
+		return errors.New("not a netplay peer")
+	}
+	if h.Version != protocolVersion {
+		return fmt.Errorf("unsupported protocol version: %d", h.Version)
+	}
+	if h.ROM != sha256.Sum256(rom) {
+		return errors.New("the peer runs a different ROM")
+	}
+	return nil
+}
+
+func writeHello(w io.Writer, h *hello) error {
+	if err := binary.Write(w, binary.BigEndian, h); err != nil {
+		return fmt.Errorf("handshake: %w", err)
+	}
+	return nil
+}
+
+func readHello(r *bufio.Reader, h *hello, rom []byte) error {
+	if err := binary.Read(r, binary.BigEndian, h); err != nil {
+		return fmt.Errorf("handshake: %w", err)
+	}
+	if err := h.check(rom); err != nil {
+		return fmt.Errorf("handshake: %w", err)
+	}
+	return nil
+}
+
+const (
+	msgInput = 1
+	msgHash  = 2
+)
+
+type message struct {
+	kind  byte
+	frame uint32
+	keys  chip8.Keypad
+	// hash is the hex-encoded state hash, as returned by Emulator.Hash
+	hash string
+}
+
+func writeMessage(w io.Writer, m message) error {
+	buf := []byte{m.kind, 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(buf[1:], m.frame)
+	switch m.kind {
+	case msgInput:
+		buf = append(buf, byte(m.keys>>8), byte(m.keys))
+	case msgHash:
+		h, err := hex.DecodeString(m.hash)
+		if err != nil || len(h) != sha256.Size {
+			return fmt.Errorf("invalid state hash: %q", m.hash)
+		}
+		buf = append(buf, h...)
+	}
+	_, err := w.Write(buf)
+	return err
+}
+
+func readMessage(r *bufio.Reader) (message, error) {
+	var head [5]byte
+	if _, err := io.ReadFull(r, head[:]); err != nil {
+		return message{}, err
+	}
+	m := message{kind: head[0], frame: binary.BigEndian.Uint32(head[1:])}
+	switch m.kind {
+	case msgInput:
+		var keys [2]byte
+		if _, err := io.ReadFull(r, keys[:]); err != nil {
+			return message{}, err
+		}
+		m.keys = chip8.Keypad(binary.BigEndian.Uint16(keys[:]))
+	case msgHash:
+		var h [sha256.Size]byte
+		if _, err := io.ReadFull(r, h[:]); err != nil {
+			return message{}, err
+		}
+		m.hash = hex.EncodeToString(h[:])
+	default:
+		return message{}, fmt.Errorf("unknown message kind: %d", m.kind)
+	}
+	return m, nil
+}
@@ -0,0 +1,505 @@
+// Package netplay lets two players on different machines play a game
+// together. Each side runs its own emulator; since the emulator is
+// deterministic, exchanging the keypad state of every frame over TCP is
+// enough to keep them in step.
+//
+// Local input is applied a few frames late, the input delay, which gives it
+// time to reach the peer. When the peer's input for a frame is still missing,
+// the session either waits for it (lockstep) or, with rollback enabled,
+// predicts it to be the same as its last input and runs ahead; if the
+// prediction turns out wrong, the frames since are run again with the actual
+// input. Both sides exchange state hashes periodically to detect desyncs.
+package netplay
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/morinokami/go-chip8/chip8"
+)
+
+// maxCatchUp is the number of frames a tick runs at most, to catch up
+// after waiting for the peer.
+const maxCatchUp = 4
+
+const handshakeTimeout = 10 * time.Second
+
+// DesyncError is returned when the states of the two sides differ.
+type DesyncError struct {
+	Frame uint32
+}
+
+func (e *DesyncError) Error() string {
+	return fmt.Sprintf("desync detected after frame %d", e.Frame)
+}
+
+type config struct {
+	seed         int64
+	delay        int
+	window       int
+	hashInterval int
+	layout       Layout
+	frames       uint32
+	settings     chip8.Settings
+}
+
+type Option func(*config)
+
+// WithSeed seeds the random number generators. It only matters on the host;
+// by default the seed is the current time.
+func WithSeed(seed int64) Option {
+	return func(c *config) {
+		c.seed = seed
+	}
+}
+
+// WithDelay sets the input delay in frames. It only matters on the host;
+// the default is 2.
+func WithDelay(frames int) Option {
+	return func(c *config) {
+		c.delay = frames
+	}
+}
+
+// WithRollback lets the session run up to frames frames ahead of the peer's
+// input. By default it waits for it.
+func WithRollback(frames int) Option {
+	return func(c *config) {
+		c.window = frames
+	}
+}
+
+// WithHashInterval makes the sides compare their states every frames
+// frames, or never if it is 0. It only matters on the host; the default is
+// 60.
+func WithHashInterval(frames int) Option {
+	return func(c *config) {
+		c.hashInterval = frames
+	}
+}
+
+// WithFrames ends the session once frames frames were run with the input of
+// both sides. By default it runs until it is stopped.
+func WithFrames(frames uint32) Option {
+	return func(c *config) {
+		c.frames = frames
+	}
+}
+
+// WithSettings sets the emulator settings. It only matters on the host; the
+// default is chip8.DefaultSettings.
+func WithSettings(s chip8.Settings) Option {
+	return func(c *config) {
+		c.settings = s
+	}
+}
+
+// WithLayout sets the keys of each player. It only matters on the host; the
+// default is DefaultLayout.
+func WithLayout(l Layout) Option {
+	return func(c *config) {
+		c.layout = l
+	}
+}
+
+type Session struct {
+	conn net.Conn
+	w    *bufio.Writer
+	// msgs is nil once the connection is closed, and err is why
+	msgs   chan message
+	err    error
+	e      *chip8.Emulator
+	ui     *frontend
+	config config
+	// mask is the keys of the local player
+	mask chip8.Keypad
+
+	// frame is the next frame to run; the frames before confirmed were run
+	// with the actual input of both sides
+	frame     uint32
+	confirmed uint32
+	local     map[uint32]chip8.Keypad
+	remote    map[uint32]chip8.Keypad
+	// last input received from the peer, used as the prediction
+	lastRemote chip8.Keypad
+	// predicted inputs of the peer, and states before the frames run with
+	// them
+	predicted map[uint32]chip8.Keypad
+	snapshots map[uint32]chip8.State
+	// first frame whose prediction was wrong, if rollback is set
+	rollbackFrom uint32
+	rollback     bool
+	rollbacks    int
+	// state hashes after frames, by frame
+	hashes       map[uint32]string
+	remoteHashes map[uint32]string
+}
+
+// Host starts a session as the host on conn, a connection from the guest,
+// playing rom with ui as the local front end.
+func Host(conn net.Conn, rom []byte, ui chip8.UI, opts ...Option) (*Session, error) {
+	c := config{seed: time.Now().UnixNano(), delay: 2, hashInterval: 60, layout: DefaultLayout, settings: chip8.DefaultSettings()}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	if c.delay < 0 || c.delay > 255 || c.hashInterval < 0 || c.hashInterval > 0xFFFF {
+		return nil, fmt.Errorf("invalid settings: delay %d, hash interval %d", c.delay, c.hashInterval)
+	}
+	if _, err := c.settings.MarshalBinary(); err != nil {
+		return nil, err
+	}
+
+	conn.SetDeadline(time.Now().Add(handshakeTimeout))
+	r := bufio.NewReader(conn)
+	h := newHello(rom, &c)
+	if err := writeHello(conn, &h); err != nil {
+		return nil, err
+	}
+	var g hello
+	if err := readHello(r, &g, rom); err != nil {
+		return nil, err
+	}
+	conn.SetDeadline(time.Time{})
+	return newSession(conn, r, rom, ui, c, c.layout.Host)
+}
+
+// Join starts a session as the guest on conn, a connection to the host,
+// playing rom with ui as the local front end. Only the rollback and frames
+// options are used; the other settings are the host's.
+func Join(conn net.Conn, rom []byte, ui chip8.UI, opts ...Option) (*Session, error) {
+	var c config
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	conn.SetDeadline(time.Now().Add(handshakeTimeout))
+	r := bufio.NewReader(conn)
+	var h hello
+	if err := readHello(r, &h, rom); err != nil {
+		return nil, err
+	}
+	c = config{
+		seed:         h.Seed,
+		delay:        int(h.Delay),
+		window:       c.window,
+		hashInterval: int(h.HashInterval),
+		layout:       Layout{Host: chip8.Keypad(h.Host), Guest: chip8.Keypad(h.Guest)},
+		frames:       c.frames,
+	}
+	if err := c.settings.UnmarshalBinary(h.Settings[:]); err != nil {
+		return nil, fmt.Errorf("handshake: %w", err)
+	}
+	g := newHello(rom, &c)
+	if err := writeHello(conn, &g); err != nil {
+		return nil, err
+	}
+	conn.SetDeadline(time.Time{})
+	return newSession(conn, r, rom, ui, c, c.layout.Guest)
+}
+
+func newSession(conn net.Conn, r *bufio.Reader, rom []byte, ui chip8.UI, c config, mask chip8.Keypad) (*Session, error) {
+	s := &Session{
+		conn:         conn,
+		w:            bufio.NewWriter(conn),
+		msgs:         make(chan message, 256),
+		ui:           &frontend{UI: ui},
+		config:       c,
+		mask:         mask,
+		local:        map[uint32]chip8.Keypad{},
+		remote:       map[uint32]chip8.Keypad{},
+		predicted:    map[uint32]chip8.Keypad{},
+		snapshots:    map[uint32]chip8.State{},
+		hashes:       map[uint32]string{},
+		remoteHashes: map[uint32]string{},
+	}
+	s.e = chip8.New(s.ui, chip8.WithSeed(c.seed), chip8.WithSettings(c.settings))
+	if err := s.e.Load(rom); err != nil {
+		return nil, err
+	}
+	// nobody presses anything during the input delay
+	for f := 0; f < c.delay; f++ {
+		s.local[uint32(f)] = 0
+		s.remote[uint32(f)] = 0
+	}
+
+	go func() {
+		defer close(s.msgs)
+		for {
+			m, err := readMessage(r)
+			if err != nil {
+				s.err = err
+				return
+			}
+			s.msgs <- m
+		}
+	}()
+	return s, nil
+}
+
+// Emulator returns the emulator of the session.
+func (s *Session) Emulator() *chip8.Emulator {
+	return s.e
+}
+
+// Rollbacks returns the number of times frames were run again because the
+// peer's input was mispredicted.
+func (s *Session) Rollbacks() int {
+	return s.rollbacks
+}
+
+// Close closes the connection to the peer.
+func (s *Session) Close() error {
+	return s.conn.Close()
+}
+
+// Done reports whether the number of frames set by WithFrames were run.
+func (s *Session) Done() bool {
+	return s.config.frames > 0 && s.confirmed >= s.config.frames
+}
+
+// Run runs the session in real time until it is done, ctx is done, the
+// connection fails or a desync is detected.
+func (s *Session) Run(ctx context.Context) error {
+	t := time.NewTicker(chip8.TimerSpeed)
+	defer t.Stop()
+	for !s.Done() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+			if err := s.Tick(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Tick handles the messages received from the peer and runs the frames
+// that can be run, normally one.
+func (s *Session) Tick() error {
+	if err := s.receive(); err != nil {
+		return err
+	}
+	// confirming frames makes room for more speculative ones
+	if err := s.confirm(); err != nil {
+		return err
+	}
+	for i := 0; i < maxCatchUp; i++ {
+		ran, err := s.step()
+		if err != nil {
+			return err
+		}
+		if !ran {
+			break
+		}
+	}
+	if err := s.confirm(); err != nil {
+		return err
+	}
+	return s.w.Flush()
+}
+
+// receive handles the messages received since the last call, then rolls
+// back if the peer's input was mispredicted.
+func (s *Session) receive() error {
+	for {
+		var m message
+		var ok bool
+		select {
+		case m, ok = <-s.msgs:
+		default:
+			if s.rollback {
+				return s.rerun()
+			}
+			return nil
+		}
+		if !ok {
+			// the peer may be done, having sent all the input needed
+			s.msgs = nil
+			continue
+		}
+
+		switch m.kind {
+		case msgInput:
+			if _, known := s.remote[m.frame]; known || (m.frame > 0 && !s.knownRemote(m.frame-1)) {
+				return fmt.Errorf("input for frame %d out of order", m.frame)
+			}
+			s.remote[m.frame] = m.keys
+			s.lastRemote = m.keys
+			if p, ok := s.predicted[m.frame]; ok && p != m.keys && (!s.rollback || m.frame < s.rollbackFrom) {
+				s.rollback = true
+				s.rollbackFrom = m.frame
+			}
+		case msgHash:
+			if m.frame < s.confirmed {
+				if err := s.compare(m.frame, m.hash); err != nil {
+					return err
+				}
+			} else {
+				s.remoteHashes[m.frame] = m.hash
+			}
+		}
+	}
+}
+
+// knownRemote reports whether the peer's input for frame was received,
+// possibly already confirmed and forgotten.
+func (s *Session) knownRemote(frame uint32) bool {
+	_, ok := s.remote[frame]
+	return ok || frame < s.confirmed
+}
+
+// step runs the next frame if the peer's input for it is known or may be
+// predicted, and reports whether it did.
+func (s *Session) step() (bool, error) {
+	f := s.frame
+	if s.config.frames > 0 && f >= s.config.frames {
+		return false, nil
+	}
+	// sample the local input that applies delay frames later
+	if next := f + uint32(s.config.delay); !s.knownLocal(next) {
+		keys := s.ui.UI.Keypad() & s.mask
+		s.local[next] = keys
+		if err := writeMessage(s.w, message{kind: msgInput, frame: next, keys: keys}); err != nil {
+			return false, err
+		}
+	}
+
+	remote, known := s.remote[f]
+	if !known {
+		if s.msgs == nil {
+			return false, fmt.Errorf("connection to the peer lost: %w", s.err)
+		}
+		if int(f-s.confirmed) >= s.config.window {
+			return false, nil
+		}
+		remote = s.lastRemote
+		s.predicted[f] = remote
+		s.snapshots[f] = s.e.State()
+	}
+	if err := s.run(f, s.local[f]|remote, false); err != nil {
+		return false, err
+	}
+	s.frame++
+	return true, nil
+}
+
+func (s *Session) knownLocal(frame uint32) bool {
+	_, ok := s.local[frame]
+	return ok
+}
+
+// run runs frame with keys pressed. Quiet frames are not shown.
+func (s *Session) run(frame uint32, keys chip8.Keypad, quiet bool) error {
+	s.ui.keys = keys
+	s.ui.quiet = quiet
+	if err := s.e.Frame(); err != nil {
+		return err
+	}
+	if n := uint32(s.config.hashInterval); n > 0 && (frame+1)%n == 0 {
+		s.hashes[frame] = s.e.Hash()
+	}
+	return nil
+}
+
+// rerun goes back to the first mispredicted frame and runs the frames since
+// again, with the input received since.
+func (s *Session) rerun() error {
+	s.rollback = false
+	s.rollbacks++
+	if err := s.e.SetState(s.snapshots[s.rollbackFrom]); err != nil {
+		return err
+	}
+	for f := s.rollbackFrom; f < s.frame; f++ {
+		remote, known := s.remote[f]
+		if known {
+			delete(s.predicted, f)
+		} else {
+			remote = s.lastRemote
+			s.predicted[f] = remote
+			s.snapshots[f] = s.e.State()
+		}
+		// only the last frame is shown
+		if err := s.run(f, s.local[f]|remote, f+1 < s.frame); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// confirm moves past the frames that were run with the actual input of both
+// sides, sending and checking their state hashes.
+func (s *Session) confirm() error {
+	for s.confirmed < s.frame {
+		f := s.confirmed
+		if _, ok := s.remote[f]; !ok {
+			break
+		}
+		delete(s.local, f)
+		delete(s.remote, f)
+		delete(s.predicted, f)
+		delete(s.snapshots, f)
+		s.confirmed++
+
+		if h, ok := s.hashes[f]; ok {
+			if err := writeMessage(s.w, message{kind: msgHash, frame: f, hash: h}); err != nil {
+				return err
+			}
+			if rh, ok := s.remoteHashes[f]; ok {
+				if err := s.compare(f, rh); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// compare checks the peer's state hash after the confirmed frame against
+// the local one.
+func (s *Session) compare(frame uint32, hash string) error {
+	h, ok := s.hashes[frame]
+	if !ok {
+		return fmt.Errorf("unexpected state hash for frame %d", frame)
+	}
+	delete(s.hashes, frame)
+	delete(s.remoteHashes, frame)
+	if h != hash {
+		return &DesyncError{Frame: frame}
+	}
+	return nil
+}
+
+// frontend passes the screen to the local UI, and gives the emulator the
+// keypad state of both players.
+type frontend struct {
+	chip8.UI
+	keys chip8.Keypad
+	// quiet frames are not shown; missed is set when one changed the
+	// screen
+	quiet  bool
+	missed bool
+}
+
+func (f *frontend) Keypad() chip8.Keypad {
+	return f.keys
+}
+
+func (f *frontend) Render(buf *[chip8.BufferSize]byte, dirty bool) {
+	if f.quiet {
+		f.missed = f.missed || dirty
+		return
+	}
+	f.UI.Render(buf, dirty || f.missed)
+	f.missed = false
+}
+
+func (f *frontend) Beep() {
+	if !f.quiet {
+		f.UI.Beep()
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/morinokami/go-chip8/chip8"
+	"github.com/morinokami/go-chip8/games"
+	"github.com/morinokami/go-chip8/octo"
+	"github.com/morinokami/go-chip8/patch"
+	"github.com/urfave/cli/v2"
+)
+
+func octoCommand() *cli.Command {
+	var out string
+	return &cli.Command{
+		Name:      "octo",
+		Usage:     "assemble an Octo source file or cartridge into a ROM and print its options",
+		ArgsUsage: "file.8o|file.gif",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "output",
+				Aliases:     []string{"o"},
+				Usage:       "ROM file (default: the input file with the extension .ch8)",
+				Destination: &out,
+			},
+		},
+		Action: func(c *cli.Context) error {
+			if c.NArg() != 1 {
+				return errors.New("usage: go-chip8 octo file.8o|file.gif")
+			}
+			path := c.Args().First()
+			rom, o, err := octo.Load(path)
+			if err != nil {
+				return err
+			}
+			if out == "" {
+				out = strings.TrimSuffix(path, filepath.Ext(path)) + ".ch8"
+			}
+			if err := ioutil.WriteFile(out, rom, 0644); err != nil {
+				return err
+			}
+			fmt.Printf("Wrote %s: %d bytes\n", out, len(rom))
+			s := o.Settings()
+			fmt.Printf("Instructions per frame: %d\nQuirks: %+v\nColors: %s on %s\n",
+				s.CyclesPerFrame, s.Quirks, o.FillColor, o.BackgroundColor)
+			return nil
+		},
+	}
+}
+
+// openGame opens the game s like games.Open, and also loads Octo cartridges
+// and source files. It returns the settings and palette the game is meant
+// to run with: its options for an Octo game, the defaults otherwise.
+func openGame(s, patchPath string) (games.Game, chip8.Settings, chip8.Palette, error) {
+	if !octo.IsOcto(s) {
+		g, err := games.Open(s, patchPath)
+		return g, chip8.DefaultSettings(), chip8.DefaultPalette, err
+	}
+	rom, o, err := octo.Load(s)
+	if err != nil {
+		return games.Game{}, chip8.Settings{}, chip8.Palette{}, err
+	}
+	p, err := o.Palette()
+	if err != nil {
+		return games.Game{}, chip8.Settings{}, chip8.Palette{}, err
+	}
+	if patchPath == "" {
+		patchPath = patch.Sibling(s)
+	}
+	if patchPath != "" {
+		if rom, err = patch.ApplyFile(rom, patchPath); err != nil {
+			return games.Game{}, chip8.Settings{}, chip8.Palette{}, err
+		}
+	}
+	name := filepath.Base(s)
+	g := games.Game{Name: strings.ToUpper(strings.TrimSuffix(name, filepath.Ext(name))), Binary: rom}
+	return g, o.Settings(), p, nil
+}
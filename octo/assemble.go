@@ -0,0 +1,868 @@
+package octo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/morinokami/go-chip8/chip8"
+)
+
+// maxExpansions bounds macro expansion, which may recurse.
+const maxExpansions = 1 << 16
+
+// unsupported are the SCHIP and XO-CHIP words.
+var unsupported = map[string]bool{
+	"hires": true, "lores": true, "scroll-down": true, "scroll-up": true,
+	"scroll-left": true, "scroll-right": true, "exit": true,
+	"saveflags": true, "loadflags": true, "plane": true, "audio": true,
+	"pitch": true, "bighex": true, "long": true,
+}
+
+// negations of the comparisons of if and while
+var negations = map[string]string{
+	"==": "!=", "!=": "==", "key": "-key", "-key": "key",
+	"<": ">=", ">=": "<", ">": "<=", "<=": ">",
+}
+
+type token struct {
+	s    string
+	line int
+}
+
+type macro struct {
+	params []string
+	body   []token
+}
+
+// fixup is a use of a label before its definition.
+type fixup struct {
+	addr  int
+	name  string
+	line  int
+	apply func(rom []byte, i, value int)
+}
+
+type assembler struct {
+	tokens []token
+	pos    int
+	line   int
+
+	rom  [chip8.MaxROMSize]byte
+	here int
+	// address past the last byte written
+	end int
+
+	labels  map[string]int
+	consts  map[string]float64
+	aliases map[string]int
+	macros  map[string]*macro
+	fixups  []fixup
+	// expanded macros
+	expansions int
+
+	// addresses of the jumps to patch at else and end, of the starts of
+	// loops, and of the jumps out of them to patch at again
+	ifs    []int
+	loops  []int
+	whiles [][]int
+}
+
+// Assemble assembles the Octo source src into a ROM loaded at PCStart. The
+// ROM starts with a jump to the label main.
+func Assemble(src string) ([]byte, error) {
+	a := &assembler{
+		here:    chip8.PCStart,
+		labels:  map[string]int{},
+		consts:  map[string]float64{},
+		aliases: map[string]int{},
+		macros:  map[string]*macro{},
+	}
+	for i, line := range strings.Split(src, "\n") {
+		if j := strings.IndexByte(line, '#'); j >= 0 {
+			line = line[:j]
+		}
+		for _, s := range strings.Fields(line) {
+			a.tokens = append(a.tokens, token{s, i + 1})
+		}
+	}
+	if err := a.assemble(); err != nil {
+		return nil, fmt.Errorf("line %d: %w", a.line, err)
+	}
+	for _, f := range a.fixups {
+		v, ok := a.labels[f.name]
+		if !ok {
+			return nil, fmt.Errorf("line %d: undefined name: %s", f.line, f.name)
+		}
+		f.apply(a.rom[:], f.addr-chip8.PCStart, v)
+	}
+	return a.rom[:a.end-chip8.PCStart], nil
+}
+
+func (a *assembler) assemble() error {
+	if err := a.addr(0x1000, "main"); err != nil {
+		return err
+	}
+	for a.pos < len(a.tokens) {
+		if err := a.statement(); err != nil {
+			return err
+		}
+	}
+	switch {
+	case len(a.ifs) > 0:
+		return errors.New("missing end")
+	case len(a.loops) > 0:
+		return errors.New("missing again")
+	}
+	return nil
+}
+
+func (a *assembler) next() (string, error) {
+	if a.pos == len(a.tokens) {
+		return "", errors.New("unexpected end of source")
+	}
+	t := a.tokens[a.pos]
+	a.pos++
+	a.line = t.line
+	return t.s, nil
+}
+
+func (a *assembler) peek() string {
+	if a.pos == len(a.tokens) {
+		return ""
+	}
+	return a.tokens[a.pos].s
+}
+
+func (a *assembler) expect(s string) error {
+	t, err := a.next()
+	if err != nil {
+		return err
+	}
+	if t != s {
+		return fmt.Errorf("expected %s, got %s", s, t)
+	}
+	return nil
+}
+
+func (a *assembler) emitByte(b byte) error {
+	if a.here < chip8.PCStart || a.here >= chip8.MemorySize {
+		return fmt.Errorf("address out of range: 0x%x", a.here)
+	}
+	a.rom[a.here-chip8.PCStart] = b
+	a.here++
+	if a.here > a.end {
+		a.end = a.here
+	}
+	return nil
+}
+
+func (a *assembler) emit(op int) error {
+	if err := a.emitByte(byte(op >> 8)); err != nil {
+		return err
+	}
+	return a.emitByte(byte(op))
+}
+
+// addr emits op with the address of the label name in its low 12 bits,
+// which is patched in later if name is not defined yet.
+func (a *assembler) addr(op int, name string) error {
+	if v, ok := a.labels[name]; ok {
+		return a.emit(op | v)
+	}
+	if v, ok := a.consts[name]; ok {
+		n := int(math.Floor(v))
+		if n < 0 || n > 0xFFF {
+			return fmt.Errorf("address out of range: %s", name)
+		}
+		return a.emit(op | n)
+	}
+	if n, err := strconv.ParseInt(name, 0, 32); err == nil {
+		if n < 0 || n > 0xFFF {
+			return fmt.Errorf("address out of range: %s", name)
+		}
+		return a.emit(op | int(n))
+	}
+	if err := a.checkName(name); err != nil {
+		return err
+	}
+	a.fixups = append(a.fixups, fixup{addr: a.here, name: name, line: a.line, apply: patchAddr})
+	return a.emit(op)
+}
+
+func patchAddr(rom []byte, i, v int) {
+	rom[i] |= byte(v >> 8)
+	rom[i+1] = byte(v)
+}
+
+// patch points the jump at addr to the current address.
+func (a *assembler) patch(addr int) {
+	patchAddr(a.rom[:], addr-chip8.PCStart, a.here)
+}
+
+// checkName reports whether s may name a label.
+func (a *assembler) checkName(s string) error {
+	if _, err := a.register(s); err == nil || s == "" || strings.ContainsAny(s, "{}") {
+		return fmt.Errorf("invalid name: %s", s)
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return fmt.Errorf("invalid name: %s", s)
+	}
+	return nil
+}
+
+func (a *assembler) register(s string) (int, error) {
+	if r, ok := a.aliases[s]; ok {
+		return r, nil
+	}
+	if len(s) == 2 && (s[0] == 'v' || s[0] == 'V') {
+		if r, err := strconv.ParseUint(s[1:], 16, 4); err == nil {
+			return int(r), nil
+		}
+	}
+	return 0, fmt.Errorf("expected a register, got %s", s)
+}
+
+func (a *assembler) nextRegister() (int, error) {
+	t, err := a.next()
+	if err != nil {
+		return 0, err
+	}
+	return a.register(t)
+}
+
+// value returns the number, constant or defined label s.
+func (a *assembler) value(s string) (float64, error) {
+	if v, ok := a.consts[s]; ok {
+		return v, nil
+	}
+	if v, ok := a.labels[s]; ok {
+		return float64(v), nil
+	}
+	if n, err := strconv.ParseInt(s, 0, 64); err == nil {
+		return float64(n), nil
+	}
+	return 0, fmt.Errorf("undefined name: %s", s)
+}
+
+// intValue returns the value of the next token, or of a :calc expression
+// in braces, as an integer in [min, max].
+func (a *assembler) intValue(min, max int) (int, error) {
+	t, err := a.next()
+	if err != nil {
+		return 0, err
+	}
+	var v float64
+	if t == "{" {
+		v, err = a.braces()
+	} else {
+		v, err = a.value(t)
+	}
+	if err != nil {
+		return 0, err
+	}
+	n := int(math.Floor(v))
+	if n < min || n > max {
+		return 0, fmt.Errorf("value out of range: %s", t)
+	}
+	return n, nil
+}
+
+func (a *assembler) byteValue() (int, error) {
+	n, err := a.intValue(-128, 255)
+	return n & 0xFF, err
+}
+
+func (a *assembler) statement() error {
+	t, err := a.next()
+	if err != nil {
+		return err
+	}
+	if unsupported[t] {
+		return fmt.Errorf("unsupported SCHIP or XO-CHIP instruction: %s", t)
+	}
+	if m, ok := a.macros[t]; ok {
+		return a.expand(m)
+	}
+	if r, err := a.register(t); err == nil {
+		return a.registerOp(r)
+	}
+	switch t {
+	case ":":
+		name, err := a.next()
+		if err != nil {
+			return err
+		}
+		return a.define(name, a.here)
+	case ":next":
+		name, err := a.next()
+		if err != nil {
+			return err
+		}
+		return a.define(name, a.here+1)
+	case ":const":
+		name, err := a.next()
+		if err != nil {
+			return err
+		}
+		if err := a.checkName(name); err != nil {
+			return err
+		}
+		t, err := a.next()
+		if err != nil {
+			return err
+		}
+		v, err := a.value(t)
+		if err != nil {
+			return err
+		}
+		a.consts[name] = v
+	case ":calc":
+		name, err := a.next()
+		if err != nil {
+			return err
+		}
+		if err := a.checkName(name); err != nil {
+			return err
+		}
+		if err := a.expect("{"); err != nil {
+			return err
+		}
+		v, err := a.braces()
+		if err != nil {
+			return err
+		}
+		a.consts[name] = v
+	case ":alias":
+		name, err := a.next()
+		if err != nil {
+			return err
+		}
+		if err := a.checkName(name); err != nil {
+			return err
+		}
+		r, err := a.nextRegister()
+		if err != nil {
+			return err
+		}
+		a.aliases[name] = r
+	case ":byte":
+		b, err := a.byteValue()
+		if err != nil {
+			return err
+		}
+		return a.emitByte(byte(b))
+	case ":org":
+		n, err := a.intValue(chip8.PCStart, chip8.MemorySize-1)
+		if err != nil {
+			return err
+		}
+		a.here = n
+	case ":unpack":
+		return a.unpack()
+	case ":macro":
+		return a.macro()
+	case ":call":
+		name, err := a.next()
+		if err != nil {
+			return err
+		}
+		return a.addr(0x2000, name)
+	case ":breakpoint", ":proto":
+		_, err := a.next()
+		return err
+	case ":monitor":
+		if _, err := a.next(); err != nil {
+			return err
+		}
+		_, err := a.next()
+		return err
+	case "clear":
+		return a.emit(0x00E0)
+	case "return", ";":
+		return a.emit(0x00EE)
+	case "jump", "jump0", "native":
+		name, err := a.next()
+		if err != nil {
+			return err
+		}
+		return a.addr(map[string]int{"jump": 0x1000, "jump0": 0xB000, "native": 0x0000}[t], name)
+	case "sprite":
+		x, err := a.nextRegister()
+		if err != nil {
+			return err
+		}
+		y, err := a.nextRegister()
+		if err != nil {
+			return err
+		}
+		n, err := a.intValue(0, 15)
+		if err != nil {
+			return err
+		}
+		return a.emit(0xD000 | x<<8 | y<<4 | n)
+	case "bcd", "save", "load":
+		r, err := a.nextRegister()
+		if err != nil {
+			return err
+		}
+		if a.peek() == "-" {
+			return fmt.Errorf("unsupported SCHIP or XO-CHIP instruction: %s range", t)
+		}
+		return a.emit(map[string]int{"bcd": 0xF033, "save": 0xF055, "load": 0xF065}[t] | r<<8)
+	case "delay", "buzzer":
+		if err := a.expect(":="); err != nil {
+			return err
+		}
+		r, err := a.nextRegister()
+		if err != nil {
+			return err
+		}
+		return a.emit(map[string]int{"delay": 0xF015, "buzzer": 0xF018}[t] | r<<8)
+	case "i":
+		return a.iOp()
+	case "if":
+		cond, err := a.condition()
+		if err != nil {
+			return err
+		}
+		t, err := a.next()
+		if err != nil {
+			return err
+		}
+		switch t {
+		case "then":
+			return cond(false)
+		case "begin":
+			// skip the jump to else when the condition holds
+			if err := cond(true); err != nil {
+				return err
+			}
+			a.ifs = append(a.ifs, a.here)
+			return a.emit(0x1000)
+		default:
+			return fmt.Errorf("expected then or begin, got %s", t)
+		}
+	case "else":
+		if len(a.ifs) == 0 {
+			return errors.New("else without if")
+		}
+		jump := a.here
+		if err := a.emit(0x1000); err != nil {
+			return err
+		}
+		a.patch(a.ifs[len(a.ifs)-1])
+		a.ifs[len(a.ifs)-1] = jump
+	case "end":
+		if len(a.ifs) == 0 {
+			return errors.New("end without if")
+		}
+		a.patch(a.ifs[len(a.ifs)-1])
+		a.ifs = a.ifs[:len(a.ifs)-1]
+	case "loop":
+		a.loops = append(a.loops, a.here)
+		a.whiles = append(a.whiles, nil)
+	case "while":
+		if len(a.loops) == 0 {
+			return errors.New("while outside of a loop")
+		}
+		cond, err := a.condition()
+		if err != nil {
+			return err
+		}
+		if err := cond(true); err != nil {
+			return err
+		}
+		a.whiles[len(a.whiles)-1] = append(a.whiles[len(a.whiles)-1], a.here)
+		return a.emit(0x1000)
+	case "again":
+		if len(a.loops) == 0 {
+			return errors.New("again without loop")
+		}
+		if err := a.emit(0x1000 | a.loops[len(a.loops)-1]); err != nil {
+			return err
+		}
+		for _, addr := range a.whiles[len(a.whiles)-1] {
+			a.patch(addr)
+		}
+		a.loops = a.loops[:len(a.loops)-1]
+		a.whiles = a.whiles[:len(a.whiles)-1]
+	default:
+		// a number or constant is a byte of data, a name a call
+		if _, ok := a.labels[t]; ok {
+			return a.emit(0x2000 | a.labels[t])
+		}
+		if v, err := a.value(t); err == nil {
+			n := int(math.Floor(v))
+			if n < -128 || n > 255 {
+				return fmt.Errorf("value out of range: %s", t)
+			}
+			return a.emitByte(byte(n))
+		}
+		return a.addr(0x2000, t)
+	}
+	return nil
+}
+
+func (a *assembler) define(name string, addr int) error {
+	if err := a.checkName(name); err != nil {
+		return err
+	}
+	if _, ok := a.labels[name]; ok {
+		return fmt.Errorf("label redefined: %s", name)
+	}
+	a.labels[name] = addr
+	return nil
+}
+
+// registerOp assembles an assignment to the register r.
+func (a *assembler) registerOp(r int) error {
+	op, err := a.next()
+	if err != nil {
+		return err
+	}
+	t, err := a.next()
+	if err != nil {
+		return err
+	}
+	if s, err := a.register(t); err == nil {
+		n, ok := map[string]int{":=": 0, "|=": 1, "&=": 2, "^=": 3, "+=": 4, "-=": 5, ">>=": 6, "=-": 7, "<<=": 0xE}[op]
+		if !ok {
+			return fmt.Errorf("invalid operator: %s", op)
+		}
+		return a.emit(0x8000 | r<<8 | s<<4 | n)
+	}
+	switch op {
+	case ":=":
+		switch t {
+		case "key":
+			return a.emit(0xF00A | r<<8)
+		case "delay":
+			return a.emit(0xF007 | r<<8)
+		case "random":
+			n, err := a.byteValue()
+			if err != nil {
+				return err
+			}
+			return a.emit(0xC000 | r<<8 | n)
+		}
+		a.pos--
+		n, err := a.byteValue()
+		if err != nil {
+			return err
+		}
+		return a.emit(0x6000 | r<<8 | n)
+	case "+=", "-=":
+		a.pos--
+		n, err := a.byteValue()
+		if err != nil {
+			return err
+		}
+		if op == "-=" {
+			n = -n & 0xFF
+		}
+		return a.emit(0x7000 | r<<8 | n)
+	default:
+		return fmt.Errorf("invalid operator: %s", op)
+	}
+}
+
+// iOp assembles an assignment to I.
+func (a *assembler) iOp() error {
+	op, err := a.next()
+	if err != nil {
+		return err
+	}
+	t, err := a.next()
+	if err != nil {
+		return err
+	}
+	switch {
+	case op == ":=" && t == "hex":
+		r, err := a.nextRegister()
+		if err != nil {
+			return err
+		}
+		return a.emit(0xF029 | r<<8)
+	case op == ":=" && unsupported[t]:
+		return fmt.Errorf("unsupported SCHIP or XO-CHIP instruction: i := %s", t)
+	case op == ":=" && t == "{":
+		v, err := a.braces()
+		if err != nil {
+			return err
+		}
+		n := int(math.Floor(v))
+		if n < 0 || n > 0xFFF {
+			return errors.New("address out of range")
+		}
+		return a.emit(0xA000 | n)
+	case op == ":=":
+		return a.addr(0xA000, t)
+	case op == "+=":
+		r, err := a.register(t)
+		if err != nil {
+			return err
+		}
+		return a.emit(0xF01E | r<<8)
+	default:
+		return fmt.Errorf("invalid operator: %s", op)
+	}
+}
+
+// condition parses a comparison and returns a function that assembles it,
+// or its negation if negate is set, so that the next instruction is skipped
+// unless it holds. Ordered comparisons use VF and rely on the flag being set
+// last.
+func (a *assembler) condition() (func(negate bool) error, error) {
+	x, err := a.nextRegister()
+	if err != nil {
+		return nil, err
+	}
+	cmp, err := a.next()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := negations[cmp]; !ok {
+		return nil, fmt.Errorf("invalid comparison: %s", cmp)
+	}
+	// the right operand, a register or a byte
+	y, n := -1, 0
+	if cmp != "key" && cmp != "-key" {
+		t, err := a.next()
+		if err != nil {
+			return nil, err
+		}
+		if y, err = a.register(t); err != nil {
+			a.pos--
+			if n, err = a.byteValue(); err != nil {
+				return nil, err
+			}
+			y = -1
+		}
+	}
+	return func(negate bool) error {
+		cmp := cmp
+		if negate {
+			cmp = negations[cmp]
+		}
+		var ops []int
+		switch {
+		case cmp == "key":
+			ops = []int{0xE0A1 | x<<8}
+		case cmp == "-key":
+			ops = []int{0xE09E | x<<8}
+		case y >= 0 && cmp == "==":
+			ops = []int{0x9000 | x<<8 | y<<4}
+		case y >= 0 && cmp == "!=":
+			ops = []int{0x5000 | x<<8 | y<<4}
+		case cmp == "==":
+			ops = []int{0x4000 | x<<8 | n}
+		case cmp == "!=":
+			ops = []int{0x3000 | x<<8 | n}
+		default:
+			// VF := y
+			if y >= 0 {
+				ops = []int{0x8F00 | y<<4}
+			} else {
+				ops = []int{0x6F00 | n}
+			}
+			if cmp == ">" || cmp == "<=" {
+				// VF =- x, so that the flag is x > y
+				ops = append(ops, 0x8F07|x<<4)
+			} else {
+				// VF -= x, so that the flag is y > x
+				ops = append(ops, 0x8F05|x<<4)
+			}
+			if cmp == ">" || cmp == "<" {
+				ops = append(ops, 0x3F00)
+			} else {
+				ops = append(ops, 0x4F00)
+			}
+		}
+		for _, op := range ops {
+			if err := a.emit(op); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, nil
+}
+
+// unpack assembles :unpack, which loads a nibble and a 12-bit address into
+// V0 and V1.
+func (a *assembler) unpack() error {
+	t, err := a.next()
+	if err != nil {
+		return err
+	}
+	if t == "long" {
+		return errors.New("unsupported SCHIP or XO-CHIP instruction: :unpack long")
+	}
+	a.pos--
+	nibble, err := a.intValue(0, 15)
+	if err != nil {
+		return err
+	}
+	name, err := a.next()
+	if err != nil {
+		return err
+	}
+	if v, err := a.value(name); err == nil {
+		n := int(math.Floor(v))
+		if err := a.emit(0x6000 | nibble<<4 | n>>8&0xF); err != nil {
+			return err
+		}
+		return a.emit(0x6100 | n&0xFF)
+	}
+	if err := a.checkName(name); err != nil {
+		return err
+	}
+	a.fixups = append(a.fixups, fixup{addr: a.here, name: name, line: a.line, apply: func(rom []byte, i, v int) {
+		rom[i+1] |= byte(v >> 8 & 0xF)
+		rom[i+3] = byte(v)
+	}})
+	if err := a.emit(0x6000 | nibble<<4); err != nil {
+		return err
+	}
+	return a.emit(0x6100)
+}
+
+// macro parses a :macro definition.
+func (a *assembler) macro() error {
+	name, err := a.next()
+	if err != nil {
+		return err
+	}
+	if err := a.checkName(name); err != nil {
+		return err
+	}
+	m := &macro{}
+	for {
+		t, err := a.next()
+		if err != nil {
+			return err
+		}
+		if t == "{" {
+			break
+		}
+		m.params = append(m.params, t)
+	}
+	for depth := 1; ; {
+		t := a.pos
+		s, err := a.next()
+		if err != nil {
+			return err
+		}
+		switch s {
+		case "{":
+			depth++
+		case "}":
+			depth--
+		}
+		if depth == 0 {
+			break
+		}
+		m.body = append(m.body, a.tokens[t])
+	}
+	a.macros[name] = m
+	return nil
+}
+
+// expand replaces the invocation of m with its body.
+func (a *assembler) expand(m *macro) error {
+	if a.expansions++; a.expansions > maxExpansions {
+		return errors.New("too many macro expansions")
+	}
+	args := map[string]string{}
+	for _, p := range m.params {
+		t, err := a.next()
+		if err != nil {
+			return err
+		}
+		args[p] = t
+	}
+	body := make([]token, len(m.body), len(m.body)+len(a.tokens)-a.pos)
+	for i, t := range m.body {
+		if s, ok := args[t.s]; ok {
+			t.s = s
+		}
+		body[i] = t
+	}
+	a.tokens = append(body, a.tokens[a.pos:]...)
+	a.pos = 0
+	return nil
+}
+
+// braces evaluates the :calc expression up to the closing brace. Like in
+// Octo, binary operators have no precedence and associate to the right.
+func (a *assembler) braces() (float64, error) {
+	v, err := a.expr()
+	if err != nil {
+		return 0, err
+	}
+	return v, a.expect("}")
+}
+
+var binaryOps = map[string]func(x, y float64) float64{
+	"+":   func(x, y float64) float64 { return x + y },
+	"-":   func(x, y float64) float64 { return x - y },
+	"*":   func(x, y float64) float64 { return x * y },
+	"/":   func(x, y float64) float64 { return x / y },
+	"%":   math.Mod,
+	"&":   func(x, y float64) float64 { return float64(int(x) & int(y)) },
+	"|":   func(x, y float64) float64 { return float64(int(x) | int(y)) },
+	"^":   func(x, y float64) float64 { return float64(int(x) ^ int(y)) },
+	"<<":  func(x, y float64) float64 { return float64(int(x) << uint(y)) },
+	">>":  func(x, y float64) float64 { return float64(int(x) >> uint(y)) },
+	"min": math.Min,
+	"max": math.Max,
+}
+
+var unaryOps = map[string]func(x float64) float64{
+	"-":     func(x float64) float64 { return -x },
+	"~":     func(x float64) float64 { return float64(^int(x)) },
+	"floor": math.Floor,
+	"ceil":  math.Ceil,
+	"abs":   math.Abs,
+}
+
+func (a *assembler) expr() (float64, error) {
+	x, err := a.term()
+	if err != nil {
+		return 0, err
+	}
+	op, ok := binaryOps[a.peek()]
+	if !ok {
+		return x, nil
+	}
+	a.pos++
+	y, err := a.expr()
+	if err != nil {
+		return 0, err
+	}
+	return op(x, y), nil
+}
+
+func (a *assembler) term() (float64, error) {
+	t, err := a.next()
+	if err != nil {
+		return 0, err
+	}
+	if op, ok := unaryOps[t]; ok {
+		x, err := a.term()
+		return op(x), err
+	}
+	switch t {
+	case "(":
+		x, err := a.expr()
+		if err != nil {
+			return 0, err
+		}
+		return x, a.expect(")")
+	case "HERE":
+		return float64(a.here), nil
+	}
+	return a.value(t)
+}
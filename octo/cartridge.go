@@ -0,0 +1,48 @@
+package octo
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image/gif"
+	"io"
+)
+
+// DecodeCartridge decodes an Octo cartridge. The payload is stored two bits
+// per pixel in the low bits of the color indices of the frames, most
+// significant bits first: a 4-byte big-endian length followed by that many
+// bytes of JSON holding the program and its options.
+func DecodeCartridge(r io.Reader) (*Cartridge, error) {
+	g, err := gif.DecodeAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var data []byte
+	var b byte
+	var n int
+	for _, img := range g.Image {
+		bounds := img.Bounds()
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			row := img.Pix[(y-bounds.Min.Y)*img.Stride:]
+			for x := 0; x < bounds.Dx(); x++ {
+				b = b<<2 | row[x]&3
+				if n++; n%4 == 0 {
+					data = append(data, b)
+				}
+			}
+		}
+	}
+	if len(data) < 4 {
+		return nil, errors.New("invalid cartridge: no payload")
+	}
+	size := binary.BigEndian.Uint32(data)
+	if uint64(size) > uint64(len(data)-4) {
+		return nil, errors.New("invalid cartridge: truncated payload")
+	}
+	c := &Cartridge{Options: DefaultOptions()}
+	if err := json.Unmarshal(data[4:4+size], c); err != nil {
+		return nil, fmt.Errorf("invalid cartridge: %w", err)
+	}
+	return c, nil
+}
@@ -0,0 +1,112 @@
+// Package octo loads programs in the formats of Octo, the CHIP-8 assembler
+// and IDE: .8o assembly source and cartridges, GIF images that embed the
+// source of a program and the options to run it with.
+//
+// Only CHIP-8 programs are supported, as the emulator does not implement
+// SCHIP and XO-CHIP. Of Octo assembly, the instructions, structured control
+// flow, :const, :alias, :unpack, :next, :org, :byte, :macro and :calc are
+// supported.
+package octo
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/morinokami/go-chip8/chip8"
+)
+
+// Options are the options of an Octo program, with Octo's names.
+type Options struct {
+	// instructions per frame
+	Tickrate        int    `json:"tickrate"`
+	FillColor       string `json:"fillColor"`
+	BackgroundColor string `json:"backgroundColor"`
+	ShiftQuirks     bool   `json:"shiftQuirks"`
+	LoadStoreQuirks bool   `json:"loadStoreQuirks"`
+	VFOrderQuirks   bool   `json:"vfOrderQuirks"`
+	ClipQuirks      bool   `json:"clipQuirks"`
+	JumpQuirks      bool   `json:"jumpQuirks"`
+	LogicQuirks     bool   `json:"logicQuirks"`
+	VBlankQuirks    bool   `json:"vBlankQuirks"`
+}
+
+// DefaultOptions returns the options Octo runs programs with by default.
+func DefaultOptions() Options {
+	return Options{
+		Tickrate:        20,
+		FillColor:       "#FFCC00",
+		BackgroundColor: "#996600",
+	}
+}
+
+// Settings returns the emulator settings that reproduce o. Octo runs 60
+// frames a second and the emulator 50, so programs run at 5/6 of the speed.
+func (o Options) Settings() chip8.Settings {
+	return chip8.Settings{
+		CyclesPerFrame: o.Tickrate,
+		Quirks: chip8.Quirks{
+			ShiftVy:    !o.ShiftQuirks,
+			IncrementI: !o.LoadStoreQuirks,
+			FlagLast:   !o.VFOrderQuirks,
+			ResetVF:    o.LogicQuirks,
+			JumpVx:     o.JumpQuirks,
+			WrapOrigin: true,
+			WrapPixels: !o.ClipQuirks,
+			VBlank:     o.VBlankQuirks,
+		},
+	}
+}
+
+// Palette returns the colors of o.
+func (o Options) Palette() (chip8.Palette, error) {
+	var p chip8.Palette
+	if _, err := fmt.Sscanf(o.FillColor, "#%02x%02x%02x", &p.Foreground.R, &p.Foreground.G, &p.Foreground.B); err != nil {
+		return p, fmt.Errorf("invalid fill color: %s", o.FillColor)
+	}
+	if _, err := fmt.Sscanf(o.BackgroundColor, "#%02x%02x%02x", &p.Background.R, &p.Background.G, &p.Background.B); err != nil {
+		return p, fmt.Errorf("invalid background color: %s", o.BackgroundColor)
+	}
+	p.Foreground.A, p.Background.A = 0xFF, 0xFF
+	return p, nil
+}
+
+// Cartridge is an Octo program and the options to run it with.
+type Cartridge struct {
+	// Octo assembly source
+	Program string  `json:"program"`
+	Options Options `json:"options"`
+}
+
+// IsOcto reports whether path is an Octo cartridge or source file, by its
+// extension.
+func IsOcto(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".gif", ".8o":
+		return true
+	}
+	return false
+}
+
+// Load loads the Octo cartridge or source file at path, and returns the
+// assembled program and its options. A source file is run with the default
+// options.
+func Load(path string) ([]byte, Options, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, Options{}, err
+	}
+	c := &Cartridge{Program: string(data), Options: DefaultOptions()}
+	if strings.ToLower(filepath.Ext(path)) == ".gif" {
+		if c, err = DecodeCartridge(bytes.NewReader(data)); err != nil {
+			return nil, Options{}, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	rom, err := Assemble(c.Program)
+	if err != nil {
+		return nil, Options{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return rom, c.Options, nil
+}
@@ -0,0 +1,224 @@
+package octo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/morinokami/go-chip8/chip8"
+)
+
+func TestAssemble(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want []byte
+	}{
+		{"loop", `
+			: main
+				clear
+				v0 := 5 # comment
+				i := data
+				sprite v0 v1 3
+				loop
+					v0 += 1
+					while v0 != 10
+				again
+				jump main
+			: data 0xFF 0x81 0b11111111`,
+			[]byte{0x12, 0x02, 0x00, 0xE0, 0x60, 0x05, 0xA2, 0x14, 0xD0, 0x13, 0x70, 0x01, 0x40, 0x0A,
+				0x12, 0x12, 0x12, 0x0A, 0x12, 0x02, 0xFF, 0x81, 0xFF}},
+		{"if", `
+			: main
+				if v1 == v2 then v3 := key
+				if v1 key begin
+					v4 -= 1
+				else
+					v4 =- v5
+				end
+				sub
+			: sub
+				delay := v6
+				;`,
+			[]byte{0x12, 0x02, 0x91, 0x20, 0xF3, 0x0A, 0xE1, 0x9E, 0x12, 0x0E, 0x74, 0xFF, 0x12, 0x10,
+				0x84, 0x57, 0x22, 0x12, 0xF6, 0x15, 0x00, 0xEE}},
+		{"metaprogramming", `
+			:macro add-twice reg n { reg += n reg += n }
+			:calc SIX { 2 * 1 + 2 }
+			:alias x v3
+			: main
+				add-twice x SIX
+				:byte { SIX - 1 }
+				:unpack 0xA data
+			: data`,
+			[]byte{0x12, 0x02, 0x73, 0x06, 0x73, 0x06, 0x05, 0x60, 0xA2, 0x61, 0x0B}},
+		{"next", `
+			: main
+				:next target v0 := 0
+				i := target
+				:org 0x300
+				v1 >>= v2`,
+			[]byte{0x12, 0x02, 0x60, 0x00, 0xA2, 0x03}},
+	}
+	tests[3].want = append(tests[3].want, make([]byte, 0x300-0x206)...)
+	tests[3].want = append(tests[3].want, 0x81, 0x26)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rom, err := Assemble(tt.src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(rom, tt.want) {
+				t.Errorf("got=% x, want=% x", rom, tt.want)
+			}
+		})
+	}
+}
+
+func TestAssembleErrors(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{": start clear", "undefined name: main"},
+		{": main\n\nhires", "line 3: unsupported SCHIP or XO-CHIP instruction: hires"},
+		{": main save v0 - v3", "unsupported SCHIP or XO-CHIP instruction"},
+		{": main v0 := 256", "value out of range: 256"},
+		{": main if v0 == 1 begin clear", "missing end"},
+		{": main : main", "label redefined: main"},
+		{":macro loop-forever { :const x 1 loop-forever } : main loop-forever", "too many macro expansions"},
+	}
+	for _, tt := range tests {
+		if _, err := Assemble(tt.src); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%q: got=%v, want=%s", tt.src, err, tt.want)
+		}
+	}
+}
+
+// TestComparisons runs the ordered comparisons with Octo's default options,
+// which they depend on.
+func TestComparisons(t *testing.T) {
+	ops := []string{"==", "!=", "<", ">", "<=", ">="}
+	for _, x := range []int{0, 1, 5, 200, 255} {
+		for _, y := range []int{0, 1, 5, 200, 255} {
+			src := fmt.Sprintf(": main\nv0 := %d\nv1 := %d\n", x, y)
+			for i, op := range ops {
+				// against a register, and against a constant with begin
+				src += fmt.Sprintf("v%x := 0 if v0 %s v1 then v%x := 1\n", i+2, op, i+2)
+				src += fmt.Sprintf("v%x := 0 if v0 %s %d begin v%x := 1 end\n", i+8, op, y, i+8)
+			}
+			src += ": halt jump halt"
+			rom, err := Assemble(src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			e := chip8.New(chip8.NewHeadless(), chip8.WithSettings(DefaultOptions().Settings()))
+			if err := e.Load(rom); err != nil {
+				t.Fatal(err)
+			}
+			for i := 0; i < 10; i++ {
+				if err := e.Frame(); err != nil {
+					t.Fatal(err)
+				}
+			}
+			v := e.State().V
+			for i, want := range []bool{x == y, x != y, x < y, x > y, x <= y, x >= y} {
+				if (v[i+2] == 1) != want || (v[i+8] == 1) != want {
+					t.Errorf("%d %s %d: got=%d and %d, want=%v", x, ops[i], y, v[i+2], v[i+8], want)
+				}
+			}
+		}
+	}
+}
+
+func TestCartridge(t *testing.T) {
+	// spans several frames
+	src := ": main\nv0 := 1\nloop again\n# " + strings.Repeat("-", 1000)
+	options := `{"tickrate": 15, "fillColor": "#112233", "shiftQuirks": true, "screenRotation": 0}`
+	data := cartridge(t, `{"program": `+quote(src)+`, "options": `+options+`}`)
+
+	c, err := DecodeCartridge(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Program != src {
+		t.Errorf("program: got=%q, want=%q", c.Program, src)
+	}
+	want := DefaultOptions()
+	want.Tickrate, want.FillColor, want.ShiftQuirks = 15, "#112233", true
+	if c.Options != want {
+		t.Errorf("options: got=%+v, want=%+v", c.Options, want)
+	}
+	s := c.Options.Settings()
+	if s.CyclesPerFrame != 15 || s.Quirks.ShiftVy || !s.Quirks.IncrementI || !s.Quirks.WrapPixels {
+		t.Errorf("settings: got=%+v", s)
+	}
+	p, err := c.Options.Palette()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Foreground != (color.RGBA{0x11, 0x22, 0x33, 0xFF}) || p.Background != (color.RGBA{0x99, 0x66, 0x00, 0xFF}) {
+		t.Errorf("palette: got=%+v", p)
+	}
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "cart.gif")
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	rom, o, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, _ := Assemble(src); !bytes.Equal(rom, want) || o != c.Options {
+		t.Errorf("Load: got=% x, %+v", rom, o)
+	}
+
+	if _, err := DecodeCartridge(bytes.NewReader(cartridge(t, "{"))); err == nil {
+		t.Error("invalid JSON: got no error")
+	}
+}
+
+// cartridge encodes payload as a cartridge of 32x32 frames with a blank
+// label.
+func cartridge(t *testing.T, payload string) []byte {
+	data := make([]byte, 4, 4+len(payload))
+	binary.BigEndian.PutUint32(data, uint32(len(payload)))
+	data = append(data, payload...)
+
+	palette := color.Palette{color.Black, color.White, color.Gray{0x40}, color.Gray{0x80}}
+	g := &gif.GIF{}
+	for len(data) > 0 {
+		img := image.NewPaletted(image.Rect(0, 0, 32, 32), palette)
+		for i := range img.Pix {
+			if i/4 < len(data) {
+				img.Pix[i] = data[i/4] >> (6 - 2*(i%4)) & 3
+			}
+		}
+		if len(data) > len(img.Pix)/4 {
+			data = data[len(img.Pix)/4:]
+		} else {
+			data = nil
+		}
+		g.Image = append(g.Image, img)
+		g.Delay = append(g.Delay, 0)
+	}
+	var buf bytes.Buffer
+	if err := gif.EncodeAll(&buf, g); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func quote(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
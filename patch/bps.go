@@ -0,0 +1,192 @@
+package patch
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+)
+
+// A BPS patch is the magic, the sizes of the source and target ROMs and of
+// metadata, the metadata, actions that build the target, then the CRC32 of
+// the source, the target and the rest of the patch. Numbers are encoded as
+// variable-length integers, except for the little-endian checksums. Each
+// action is a number holding its kind in the low 2 bits and its length
+// minus 1 above them:
+//
+//	sourceRead  copy bytes from the source at the same offset
+//	targetRead  copy bytes that follow from the patch
+//	sourceCopy  copy bytes from the source at a relative offset that follows
+//	targetCopy  copy bytes from the target built so far, likewise
+const bpsMagic = "BPS1"
+
+const (
+	sourceRead = iota
+	targetRead
+	sourceCopy
+	targetCopy
+)
+
+// ApplyBPS applies the BPS patch p to rom, checking the checksums of the
+// patch and of both ROMs.
+func ApplyBPS(rom, p []byte) ([]byte, error) {
+	if len(p) < len(bpsMagic)+12 || string(p[:len(bpsMagic)]) != bpsMagic {
+		return nil, errors.New("not a BPS patch")
+	}
+	footer := p[len(p)-12:]
+	if crc32.ChecksumIEEE(p[:len(p)-4]) != binary.LittleEndian.Uint32(footer[8:]) {
+		return nil, errors.New("corrupt BPS patch: checksum mismatch")
+	}
+	if crc32.ChecksumIEEE(rom) != binary.LittleEndian.Uint32(footer[:4]) {
+		return nil, errors.New("the patch is for another ROM: source checksum mismatch")
+	}
+
+	r := reader{data: p[:len(p)-12], pos: len(bpsMagic)}
+	sourceSize := r.varint()
+	targetSize := r.varint()
+	r.bytes(int(r.varint())) // metadata
+	if r.err != nil {
+		return nil, r.err
+	}
+	if sourceSize != uint64(len(rom)) {
+		return nil, fmt.Errorf("the patch is for a ROM of %d bytes, not %d", sourceSize, len(rom))
+	}
+	if targetSize > maxIPSSize {
+		return nil, fmt.Errorf("target too large: %d bytes", targetSize)
+	}
+
+	out := make([]byte, 0, targetSize)
+	var sourceOffset, targetOffset int64
+	for r.remaining() > 0 && r.err == nil {
+		action := r.varint()
+		n := int64(action>>2) + 1
+		if uint64(len(out))+uint64(n) > targetSize {
+			return nil, errors.New("corrupt BPS patch: target overflow")
+		}
+		switch action & 3 {
+		case sourceRead:
+			start := int64(len(out))
+			if start+n > int64(len(rom)) {
+				return nil, errors.New("corrupt BPS patch: source read out of range")
+			}
+			out = append(out, rom[start:start+n]...)
+		case targetRead:
+			out = append(out, r.bytes(int(n))...)
+		case sourceCopy:
+			// the offsets stay within the ROMs, so bounding each step keeps
+			// the sums from overflowing
+			d := r.offset()
+			if d < -int64(len(rom)) || d > int64(len(rom)) {
+				return nil, errors.New("corrupt BPS patch: source copy out of range")
+			}
+			sourceOffset += d
+			if sourceOffset < 0 || sourceOffset > int64(len(rom))-n {
+				return nil, errors.New("corrupt BPS patch: source copy out of range")
+			}
+			out = append(out, rom[sourceOffset:sourceOffset+n]...)
+			sourceOffset += n
+		case targetCopy:
+			d := r.offset()
+			if d < -int64(len(out)) || d > int64(len(out)) {
+				return nil, errors.New("corrupt BPS patch: target copy out of range")
+			}
+			targetOffset += d
+			if targetOffset < 0 || targetOffset >= int64(len(out)) {
+				return nil, errors.New("corrupt BPS patch: target copy out of range")
+			}
+			// byte by byte, since the copy may overlap what it writes
+			for i := int64(0); i < n; i++ {
+				out = append(out, out[targetOffset])
+				targetOffset++
+			}
+		}
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	if uint64(len(out)) != targetSize {
+		return nil, errors.New("corrupt BPS patch: target size mismatch")
+	}
+	if crc32.ChecksumIEEE(out) != binary.LittleEndian.Uint32(footer[4:8]) {
+		return nil, errors.New("corrupt BPS patch: target checksum mismatch")
+	}
+	return out, nil
+}
+
+// CreateBPS returns a BPS patch from original to modified, which reads the
+// bytes that did not move from the original and includes the others.
+func CreateBPS(original, modified []byte) []byte {
+	p := []byte(bpsMagic)
+	p = appendVarint(p, uint64(len(original)))
+	p = appendVarint(p, uint64(len(modified)))
+	p = appendVarint(p, 0)
+	same := func(i int) bool {
+		return i < len(original) && original[i] == modified[i]
+	}
+	for i := 0; i < len(modified); {
+		start := i
+		kind := same(i)
+		for i < len(modified) && same(i) == kind {
+			i++
+		}
+		if kind {
+			p = appendVarint(p, uint64(i-start-1)<<2|sourceRead)
+		} else {
+			p = appendVarint(p, uint64(i-start-1)<<2|targetRead)
+			p = append(p, modified[start:i]...)
+		}
+	}
+	p = appendCRC(p, original)
+	p = appendCRC(p, modified)
+	return appendCRC(p, p)
+}
+
+// appendCRC appends the little-endian CRC32 of data to p.
+func appendCRC(p, data []byte) []byte {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], crc32.ChecksumIEEE(data))
+	return append(p, b[:]...)
+}
+
+// appendVarint appends n as a BPS variable-length integer: 7 bits per byte,
+// least significant first, with the last byte flagged by its high bit and
+// each continuation standing for one more than its value.
+func appendVarint(p []byte, n uint64) []byte {
+	for {
+		x := byte(n & 0x7F)
+		n >>= 7
+		if n == 0 {
+			return append(p, 0x80|x)
+		}
+		p = append(p, x)
+		n--
+	}
+}
+
+func (r *reader) varint() uint64 {
+	var n uint64
+	shift := uint64(1)
+	for i := 0; i < 10; i++ {
+		x := r.byte()
+		if r.err != nil {
+			return 0
+		}
+		n += uint64(x&0x7F) * shift
+		if x&0x80 != 0 {
+			return n
+		}
+		shift <<= 7
+		n += shift
+	}
+	r.err = errors.New("corrupt BPS patch: invalid number")
+	return 0
+}
+
+// offset reads a relative offset, whose sign is in the low bit.
+func (r *reader) offset() int64 {
+	d := r.varint()
+	if d&1 != 0 {
+		return -int64(d >> 1)
+	}
+	return int64(d >> 1)
+}
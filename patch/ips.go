@@ -0,0 +1,152 @@
+package patch
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// An IPS patch is the magic, then records that each write bytes at an
+// offset, then "EOF" and an optional size to truncate the ROM to. Records
+// are an offset (3 bytes) and a size (2 bytes) followed by the bytes, or a
+// size of 0 followed by a count (2 bytes) and a byte to repeat. Numbers are
+// big-endian.
+const (
+	ipsMagic = "PATCH"
+	ipsEOF   = "EOF"
+	// maxIPSSize is the size of the ROMs IPS can address
+	maxIPSSize = 1 << 24
+	// maxIPSRecord is the size of the largest record
+	maxIPSRecord = 0xFFFF
+)
+
+// ApplyIPS applies the IPS patch p to rom.
+func ApplyIPS(rom, p []byte) ([]byte, error) {
+	if !bytes.HasPrefix(p, []byte(ipsMagic)) {
+		return nil, errors.New("not an IPS patch")
+	}
+	out := append([]byte(nil), rom...)
+	r := reader{data: p, pos: len(ipsMagic)}
+	for {
+		if string(r.peek(3)) == ipsEOF {
+			r.pos += 3
+			break
+		}
+		offset := int(r.uint(3))
+		size := int(r.uint(2))
+		var data []byte
+		if size == 0 {
+			size = int(r.uint(2))
+			data = bytes.Repeat([]byte{r.byte()}, size)
+		} else {
+			data = r.bytes(size)
+		}
+		if r.err != nil {
+			return nil, r.err
+		}
+		if end := offset + size; end > len(out) {
+			out = append(out, make([]byte, end-len(out))...)
+		}
+		copy(out[offset:], data)
+	}
+	switch r.remaining() {
+	case 0:
+	case 3:
+		size := int(r.uint(3))
+		if size < len(out) {
+			out = out[:size]
+		}
+	default:
+		return nil, errors.New("trailing data after the IPS records")
+	}
+	return out, nil
+}
+
+// CreateIPS returns an IPS patch from original to modified, with a record
+// for every run of differing bytes.
+func CreateIPS(original, modified []byte) ([]byte, error) {
+	if len(original) > maxIPSSize || len(modified) > maxIPSSize {
+		return nil, fmt.Errorf("ROM too large for IPS: %d bytes", len(modified))
+	}
+	p := []byte(ipsMagic)
+	for i := 0; i < len(modified); {
+		if i < len(original) && original[i] == modified[i] {
+			i++
+			continue
+		}
+		start := i
+		// an offset that reads as EOF would end the patch, so the record
+		// starts a byte earlier, which counts towards its length
+		if string(be(uint32(start), 3)) == ipsEOF {
+			start--
+		}
+		for i < len(modified) && i-start < maxIPSRecord && (i >= len(original) || original[i] != modified[i]) {
+			i++
+		}
+		p = append(p, be(uint32(start), 3)...)
+		p = append(p, be(uint32(i-start), 2)...)
+		p = append(p, modified[start:i]...)
+	}
+	p = append(p, ipsEOF...)
+	if len(modified) < len(original) {
+		p = append(p, be(uint32(len(modified)), 3)...)
+	}
+	return p, nil
+}
+
+// be returns n as size big-endian bytes.
+func be(n uint32, size int) []byte {
+	b := make([]byte, size)
+	for i := size - 1; i >= 0; i-- {
+		b[i] = byte(n)
+		n >>= 8
+	}
+	return b
+}
+
+// reader reads a patch, remembering the first error.
+type reader struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+var errTruncated = errors.New("truncated patch")
+
+func (r *reader) remaining() int {
+	return len(r.data) - r.pos
+}
+
+func (r *reader) peek(n int) []byte {
+	if r.remaining() < n {
+		return nil
+	}
+	return r.data[r.pos : r.pos+n]
+}
+
+func (r *reader) bytes(n int) []byte {
+	if r.err != nil || n < 0 || r.remaining() < n {
+		r.err = errTruncated
+		return nil
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b
+}
+
+func (r *reader) byte() byte {
+	b := r.bytes(1)
+	if b == nil {
+		return 0
+	}
+	return b[0]
+}
+
+// uint reads a big-endian number of n bytes.
+func (r *reader) uint(n int) uint32 {
+	var v uint32
+	for _, b := range r.bytes(n) {
+		v = v<<8 | uint32(b)
+	}
+	return v
+}
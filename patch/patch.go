@@ -0,0 +1,63 @@
+// Package patch applies and creates ROM patches in the IPS and BPS formats,
+// in which fan translations and fixes of games are distributed.
+package patch
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Apply applies patch, in either format, to rom and returns the patched ROM.
+func Apply(rom, patch []byte) ([]byte, error) {
+	switch {
+	case bytes.HasPrefix(patch, []byte(ipsMagic)):
+		return ApplyIPS(rom, patch)
+	case bytes.HasPrefix(patch, []byte(bpsMagic)):
+		return ApplyBPS(rom, patch)
+	default:
+		return nil, errors.New("unknown patch format")
+	}
+}
+
+// ApplyFile applies the patch at path to rom.
+func ApplyFile(rom []byte, path string) ([]byte, error) {
+	p, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	patched, err := Apply(rom, p)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return patched, nil
+}
+
+// Sibling returns the path of the patch next to the ROM at path, with the
+// same name and a .bps or .ips extension, or "" if there is none.
+func Sibling(path string) string {
+	base := strings.TrimSuffix(path, filepath.Ext(path))
+	for _, ext := range []string{".bps", ".ips"} {
+		if fi, err := os.Stat(base + ext); err == nil && fi.Mode().IsRegular() {
+			return base + ext
+		}
+	}
+	return ""
+}
+
+// Create returns a patch from original to modified in format, "ips" or
+// "bps".
+func Create(format string, original, modified []byte) ([]byte, error) {
+	switch format {
+	case "ips":
+		return CreateIPS(original, modified)
+	case "bps":
+		return CreateBPS(original, modified), nil
+	default:
+		return nil, fmt.Errorf("unknown patch format: %s", format)
+	}
+}
@@ -0,0 +1,199 @@
+package patch
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	rom := make([]byte, 1024)
+	for i := range rom {
+		rom[i] = byte(i * 7)
+	}
+	lives := append([]byte(nil), rom...)
+	lives[0xD1] = 0x00
+	copy(lives[0x200:], "a translated title")
+	for _, tt := range []struct {
+		name     string
+		modified []byte
+	}{
+		{"same", rom},
+		{"changed", lives},
+		{"longer", append(append([]byte(nil), lives...), 0x12, 0x00)},
+		{"shorter", lives[:100]},
+		{"empty", nil},
+	} {
+		for _, format := range []string{"ips", "bps"} {
+			p, err := Create(format, rom, tt.modified)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := Apply(rom, p)
+			if err != nil {
+				t.Errorf("%s/%s: %v", tt.name, format, err)
+				continue
+			}
+			if !bytes.Equal(got, tt.modified) {
+				t.Errorf("%s/%s: got %d bytes, want %d", tt.name, format, len(got), len(tt.modified))
+			}
+		}
+	}
+}
+
+func TestIPS(t *testing.T) {
+	// write "xy" at 1, repeat "z" 3 times at 6, then truncate to 8 bytes
+	p := []byte("PATCH\x00\x00\x01\x00\x02xy\x00\x00\x06\x00\x00\x00\x03zEOF\x00\x00\x08")
+	got, err := ApplyIPS([]byte("abcd"), p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "axyd\x00\x00zz"; string(got) != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if _, err := ApplyIPS([]byte("abcd"), p[:12]); err == nil {
+		t.Error("no error for a truncated patch")
+	}
+}
+
+func TestBPS(t *testing.T) {
+	source := []byte("ABCDEF")
+	// copy "DEF" from the source, read "xy" from the patch, copy "AB" from
+	// back at the start of the source, then copy the last byte 3 times from
+	// the target as it is written
+	p := []byte(bpsMagic)
+	p = appendVarint(p, uint64(len(source)))
+	p = appendVarint(p, 10)
+	p = appendVarint(p, 4)
+	p = append(p, "meta"...)
+	p = appendVarint(p, 2<<2|sourceCopy)
+	p = appendVarint(p, 3<<1)
+	p = appendVarint(p, 1<<2|targetRead)
+	p = append(p, "xy"...)
+	p = appendVarint(p, 1<<2|sourceCopy)
+	p = appendVarint(p, 6<<1|1)
+	p = appendVarint(p, 2<<2|targetCopy)
+	p = appendVarint(p, 6<<1)
+	want := []byte("DEFxyABBBB")
+	p = appendCRC(p, source)
+	p = appendCRC(p, want)
+	p = appendCRC(p, p)
+
+	got, err := ApplyBPS(source, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	if _, err := ApplyBPS([]byte("ABCDEG"), p); err == nil || !strings.Contains(err.Error(), "another ROM") {
+		t.Errorf("got err=%v for another ROM", err)
+	}
+	corrupt := append([]byte(nil), p...)
+	corrupt[10] ^= 1
+	if _, err := ApplyBPS(source, corrupt); err == nil || !strings.Contains(err.Error(), "checksum") {
+		t.Errorf("got err=%v for a corrupt patch", err)
+	}
+}
+
+// bpsActions wraps actions in a BPS patch for source with valid checksums,
+// so that applying it gets as far as the actions.
+func bpsActions(source []byte, targetSize uint64, actions []byte) []byte {
+	p := []byte(bpsMagic)
+	p = appendVarint(p, uint64(len(source)))
+	p = appendVarint(p, targetSize)
+	p = appendVarint(p, 0)
+	p = append(p, actions...)
+	p = appendCRC(p, source)
+	p = appendCRC(p, nil)
+	return appendCRC(p, p)
+}
+
+func TestBPSOffsetOverflow(t *testing.T) {
+	source := []byte("ABCDEF")
+	far := uint64(1<<63-1) << 1
+	for _, tt := range []struct {
+		name    string
+		actions []uint64
+	}{
+		{"source copy", []uint64{sourceCopy, far}},
+		{"source copy back", []uint64{sourceCopy, 3 << 1, sourceCopy, far | 1}},
+		{"target copy", []uint64{sourceRead, targetCopy, far}},
+		{"target copy back", []uint64{sourceRead, targetCopy, far | 1}},
+	} {
+		var actions []byte
+		for _, n := range tt.actions {
+			actions = appendVarint(actions, n)
+		}
+		if _, err := ApplyBPS(source, bpsActions(source, 4, actions)); err == nil || !strings.Contains(err.Error(), "out of range") {
+			t.Errorf("%s: got err=%v", tt.name, err)
+		}
+	}
+}
+
+// TestIPSEOFRun checks that a run starting at the offset that reads as EOF
+// still fits in a record once it starts a byte earlier.
+func TestIPSEOFRun(t *testing.T) {
+	const eof = 0x454F46
+	original := make([]byte, eof+maxIPSRecord+1)
+	modified := append([]byte(nil), original...)
+	for i := eof; i < eof+maxIPSRecord; i++ {
+		modified[i] = 1
+	}
+	p, err := CreateIPS(original, modified)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ApplyIPS(original, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, modified) {
+		t.Error("patched ROM differs from the modified one")
+	}
+}
+
+func FuzzApplyBPS(f *testing.F) {
+	f.Add([]byte("ABCDEF"), uint64(4), appendVarint(appendVarint(nil, sourceCopy), 1<<63-1<<1))
+	f.Add([]byte("ABCDEF"), uint64(4), appendVarint(appendVarint(appendVarint(nil, sourceRead), targetCopy), 1<<63-1<<1|1))
+	f.Fuzz(func(t *testing.T, source []byte, targetSize uint64, actions []byte) {
+		ApplyBPS(source, bpsActions(source, targetSize, actions))
+	})
+}
+
+func FuzzApplyIPS(f *testing.F) {
+	f.Add([]byte("abcd"), []byte("PATCH\x00\x00\x01\x00\x02xy\x00\x00\x06\x00\x00\x00\x03zEOF\x00\x00\x08"))
+	f.Fuzz(func(t *testing.T, rom, p []byte) {
+		ApplyIPS(rom, p)
+	})
+}
+
+func TestVarint(t *testing.T) {
+	for _, n := range []uint64{0, 1, 127, 128, 255, 16511, 16512, 1 << 40} {
+		r := reader{data: appendVarint(nil, n)}
+		if got := r.varint(); got != n || r.err != nil || r.remaining() != 0 {
+			t.Errorf("%d: got %d, %v", n, got, r.err)
+		}
+	}
+}
+
+func TestSibling(t *testing.T) {
+	dir := t.TempDir()
+	rom := filepath.Join(dir, "game.ch8")
+	if Sibling(rom) != "" {
+		t.Error("found a patch in an empty directory")
+	}
+	ips := filepath.Join(dir, "game.ips")
+	p, _ := CreateIPS([]byte("ab"), []byte("ac"))
+	ioutil.WriteFile(ips, p, 0644)
+	if got := Sibling(rom); got != ips {
+		t.Errorf("got %q, want %q", got, ips)
+	}
+	got, err := ApplyFile([]byte("ab"), ips)
+	if err != nil || string(got) != "ac" {
+		t.Errorf("got %q, %v", got, err)
+	}
+}
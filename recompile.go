@@ -0,0 +1,59 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/morinokami/go-chip8/chip8"
+	"github.com/morinokami/go-chip8/games"
+	"github.com/urfave/cli/v2"
+)
+
+func recompileCommand() *cli.Command {
+	var game string
+	var pkg string
+	return &cli.Command{
+		Name:      "recompile",
+		Usage:     "compile a game ahead of time to a Go package",
+		ArgsUsage: "out.go",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "game",
+				Aliases:     []string{"g"},
+				Value:       "0",
+				Usage:       "enter a ROM file, an Octo .8o or .gif file, a name or one of the following numbers: " + games.AvailableGames(),
+				Destination: &game,
+			},
+			&cli.StringFlag{
+				Name:        "package",
+				Aliases:     []string{"p"},
+				Value:       "rom",
+				Usage:       "name of the generated package",
+				Destination: &pkg,
+			},
+		},
+		Action: func(c *cli.Context) error {
+			if c.NArg() != 1 {
+				return errors.New("missing output file")
+			}
+			g, settings, _, err := openGame(game, "")
+			if err != nil {
+				return err
+			}
+			// compiled code runs with the default settings
+			if settings != chip8.DefaultSettings() {
+				return errors.New("only games that run with the default settings can be recompiled")
+			}
+			src, err := chip8.Recompile(g.Binary, pkg, g.Name)
+			if err != nil {
+				return err
+			}
+			if err := ioutil.WriteFile(c.Args().First(), src, 0644); err != nil {
+				return err
+			}
+			fmt.Printf("Wrote %s\n", c.Args().First())
+			return nil
+		},
+	}
+}
@@ -0,0 +1,320 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/morinokami/go-chip8/cheat"
+	"github.com/morinokami/go-chip8/chip8"
+	"github.com/morinokami/go-chip8/games"
+	"github.com/morinokami/go-chip8/games/aot"
+	"github.com/morinokami/go-chip8/script"
+	"github.com/morinokami/go-chip8/spectate"
+	"github.com/urfave/cli/v2"
+)
+
+func runCommand() *cli.Command {
+	var game string
+	var ui string
+	var mode string
+	var scale int
+	var scaling string
+	var fullscreen bool
+	var cells string
+	var trace bool
+	var record string
+	var recordInput string
+	var replay string
+	var seed int64
+	var compiled bool
+	var broadcast string
+	var scripts cli.StringSlice
+	var cheats bool
+	var patchPath string
+	return &cli.Command{
+		Name:  "run",
+		Usage: "play a game",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "game",
+				Aliases:     []string{"g"},
+				Value:       "0",
+				Usage:       "enter a ROM file, an Octo .8o or .gif file, a name or one of the following numbers: " + games.AvailableGames(),
+				Destination: &game,
+			},
+			&cli.StringFlag{
+				Name:        "ui",
+				Value:       "window",
+				Usage:       "front end, one of: window, tty",
+				Destination: &ui,
+			},
+			&cli.StringFlag{
+				Name:        "mode",
+				Aliases:     []string{"m"},
+				Value:       "normal",
+				Usage:       "display mode, one of: " + chip8.AvailableDisplayModes(),
+				Destination: &mode,
+			},
+			&cli.IntFlag{
+				Name:        "scale",
+				Aliases:     []string{"s"},
+				Value:       chip8.ScalingFactor,
+				Usage:       "initial window size as a multiple of 64x32",
+				Destination: &scale,
+			},
+			&cli.StringFlag{
+				Name:        "scaling",
+				Value:       "integer",
+				Usage:       "scaling on resize, one of: " + chip8.AvailableScaleModes(),
+				Destination: &scaling,
+			},
+			&cli.BoolFlag{
+				Name:        "fullscreen",
+				Aliases:     []string{"f"},
+				Usage:       "start in fullscreen (toggle with F11)",
+				Destination: &fullscreen,
+			},
+			&cli.StringFlag{
+				Name:        "cells",
+				Value:       "half",
+				Usage:       "tty cell type, one of: " + chip8.AvailableCellModes(),
+				Destination: &cells,
+			},
+			&cli.BoolFlag{
+				Name:        "trace",
+				Usage:       "print each executed instruction",
+				Destination: &trace,
+			},
+			&cli.StringFlag{
+				Name:        "record",
+				Usage:       "record the session to a .gif, .y4m or .rgb file",
+				Destination: &record,
+			},
+			&cli.StringFlag{
+				Name:        "record-input",
+				Usage:       "record the keypad input to a movie file; reset is disabled while recording",
+				Destination: &recordInput,
+			},
+			&cli.StringFlag{
+				Name:        "replay",
+				Usage:       "replay the keypad input from a movie file",
+				Destination: &replay,
+			},
+			&cli.Int64Flag{
+				Name:        "seed",
+				Usage:       "seed for the random number generator (default: current time)",
+				Destination: &seed,
+			},
+			&cli.BoolFlag{
+				Name:        "compiled",
+				Usage:       "run the game compiled ahead of time by go-chip8 recompile",
+				Destination: &compiled,
+			},
+			&cli.StringFlag{
+				Name:        "broadcast",
+				Usage:       "let viewers watch the game with go-chip8 watch, on `ADDR` such as :7001",
+				Destination: &broadcast,
+			},
+			&cli.StringSliceFlag{
+				Name:        "script",
+				Usage:       "run a script built as a Go plugin, as described in package script (may be repeated)",
+				Destination: &scripts,
+			},
+			&cli.BoolFlag{
+				Name:        "cheats",
+				Usage:       "apply the enabled cheats of the game, set with go-chip8 cheat (toggle with C)",
+				Destination: &cheats,
+			},
+			&cli.StringFlag{
+				Name:        "patch",
+				Usage:       "apply an IPS or BPS patch to the game (default: the .bps or .ips file next to a ROM file)",
+				Destination: &patchPath,
+			},
+		},
+		Action: func(c *cli.Context) error {
+			var movie *chip8.Movie
+			if replay != "" {
+				var err error
+				if movie, err = chip8.LoadMovie(replay); err != nil {
+					return err
+				}
+				if !c.IsSet("game") && movie.Game != "" {
+					game = movie.Game
+				}
+			}
+			g, settings, palette, err := openGame(game, patchPath)
+			if err != nil {
+				return err
+			}
+			m, err := chip8.ParseDisplayMode(mode)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			var emulator *chip8.Emulator
+			var cheatSet *cheat.Set
+			hotkey := func(h chip8.Hotkey) {
+				switch h {
+				case chip8.HotkeyPause:
+					if emulator.Paused() {
+						emulator.Resume()
+					} else {
+						emulator.Pause()
+					}
+				case chip8.HotkeyReset:
+					emulator.Reset()
+				case chip8.HotkeyQuit:
+					cancel()
+				case chip8.HotkeyCheats:
+					if cheatSet != nil {
+						cheatSet.Toggle()
+					}
+				}
+			}
+
+			var frontend chip8.UI
+			switch ui {
+			case "window":
+				display := chip8.NewDisplay()
+				display.SetMode(m)
+				display.SetPalette(palette)
+				if scale < 1 {
+					return errors.New("invalid scale")
+				}
+				display.SetScale(scale)
+				sm, err := chip8.ParseScaleMode(scaling)
+				if err != nil {
+					return err
+				}
+				display.SetScaleMode(sm)
+				display.SetFullscreen(fullscreen)
+				display.SetHotkeyHandler(hotkey)
+				display.SetStats(func() chip8.Stats {
+					return emulator.Stats()
+				})
+				frontend = display
+			case "tty":
+				if trace {
+					return errors.New("trace cannot be used with the tty ui")
+				}
+				terminal := chip8.NewTerminal()
+				terminal.SetMode(m)
+				cm, err := chip8.ParseCellMode(cells)
+				if err != nil {
+					return err
+				}
+				terminal.SetCellMode(cm)
+				terminal.SetHotkeyHandler(hotkey)
+				frontend = terminal
+			default:
+				return errors.New("invalid ui: " + ui)
+			}
+
+			opts := []chip8.Option{chip8.WithSettings(settings)}
+			// recordings are saved once the emulator stops
+			var closers []func() error
+			if trace {
+				opts = append(opts, chip8.WithTrace(os.Stdout))
+			}
+			if record != "" {
+				r, err := chip8.NewRecorder(record, scale, palette)
+				if err != nil {
+					return err
+				}
+				opts = append(opts, chip8.WithRecorder(r))
+				closers = append(closers, r.Close)
+			}
+			if compiled {
+				opts = append(opts, chip8.WithProgram(aot.Programs[g.Name]))
+			}
+			if c.IsSet("seed") {
+				if movie != nil {
+					return errors.New("seed cannot be used with replay")
+				}
+				opts = append(opts, chip8.WithSeed(seed))
+			}
+			if movie != nil {
+				if err := movie.Check(g.Binary); err != nil {
+					return err
+				}
+				opts = append(opts, chip8.WithSeed(movie.Seed), chip8.WithReplay(movie))
+			}
+			if recordInput != "" {
+				if movie != nil {
+					seed = movie.Seed
+				} else if !c.IsSet("seed") {
+					seed = time.Now().UnixNano()
+				}
+				m := chip8.NewMovie(g.Name, g.Binary, seed)
+				opts = append(opts, chip8.WithSeed(seed), chip8.WithInputRecording(m))
+				closers = append(closers, func() error {
+					return m.Save(recordInput)
+				})
+			}
+			for _, path := range scripts.Value() {
+				h, err := script.Load(path)
+				if err != nil {
+					return err
+				}
+				opts = append(opts, chip8.WithHooks(h))
+			}
+			if cheats {
+				path, err := cheat.DefaultPath()
+				if err != nil {
+					return err
+				}
+				f, err := cheat.Load(path)
+				if err != nil {
+					return err
+				}
+				cheatSet = cheat.NewSet(f[chip8.ROMHash(g.Binary)])
+				opts = append(opts, chip8.WithHooks(cheatSet.Hooks()))
+			}
+			if broadcast != "" {
+				l, err := net.Listen("tcp", broadcast)
+				if err != nil {
+					return err
+				}
+				b := spectate.NewBroadcaster(spectate.WithSettings(settings))
+				go b.Serve(l)
+				opts = append(opts, chip8.WithFrameHook(b.Frame))
+				closers = append(closers, l.Close, b.Close)
+			}
+			sig := make(chan os.Signal, 1)
+			signal.Notify(sig, os.Interrupt)
+			go func() {
+				select {
+				case <-sig:
+					cancel()
+				case <-ctx.Done():
+				}
+			}()
+
+			emulator = chip8.New(frontend, opts...)
+			if err := emulator.Load(g.Binary); err != nil {
+				return err
+			}
+			frontend.Run(func() {
+				frontend.Init()
+				err = emulator.Run(ctx)
+				frontend.Close()
+			})
+
+			for _, f := range closers {
+				if err := f(); err != nil {
+					return err
+				}
+			}
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+			return err
+		},
+	}
+}
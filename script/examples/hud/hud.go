@@ -0,0 +1,22 @@
+// Hud shows the frame count and the keys held in the top left corner.
+package main
+
+import (
+	"fmt"
+
+	"github.com/morinokami/go-chip8/chip8"
+)
+
+func Script() *chip8.Hooks {
+	return &chip8.Hooks{
+		Frame: func(c *chip8.Context) {
+			c.Print(1, 1, fmt.Sprintf("F%d", c.Frames()))
+			if keys := c.Keys(); keys != 0 {
+				c.Print(1, 7, fmt.Sprintf("K%04X", uint16(keys)))
+			}
+		},
+	}
+}
+
+// main is not run; it lets go build ./... build the package.
+func main() {}
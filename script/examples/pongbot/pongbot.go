@@ -0,0 +1,23 @@
+// Pongbot plays the left paddle of PONG: it moves the paddle, whose top is
+// at VB, towards the ball, at V7.
+package main
+
+import "github.com/morinokami/go-chip8/chip8"
+
+func Script() *chip8.Hooks {
+	return &chip8.Hooks{
+		Input: func(c *chip8.Context, keys chip8.Keypad) chip8.Keypad {
+			ball, paddle := int(c.V[0x7]), int(c.V[0xB])+3
+			switch {
+			case ball < paddle-1:
+				keys |= 1 << 0x1
+			case ball > paddle+1:
+				keys |= 1 << 0x4
+			}
+			return keys
+		},
+	}
+}
+
+// main is not run; it lets go build ./... build the package.
+func main() {}
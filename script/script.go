@@ -0,0 +1,40 @@
+// Package script loads scripts, Go plugins that hook into the emulator to
+// play games, show a HUD or cheat without changing the emulator.
+//
+// A script is a main package that declares
+//
+//	func Script() *chip8.Hooks
+//
+// and is built with
+//
+//	go build -buildmode=plugin -o bot.so ./path/to/bot
+//
+// from the same checkout of go-chip8 as the program loading it, since Go
+// only loads plugins built against the same versions of the packages they
+// share. Plugins are supported on Linux, macOS and FreeBSD. The examples
+// directory has a PONG bot and a HUD.
+package script
+
+import (
+	"fmt"
+	"plugin"
+
+	"github.com/morinokami/go-chip8/chip8"
+)
+
+// Load loads the script at path and returns its hooks.
+func Load(path string) (*chip8.Hooks, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	sym, err := p.Lookup("Script")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	f, ok := sym.(func() *chip8.Hooks)
+	if !ok {
+		return nil, fmt.Errorf("%s: Script is a %T, want a func() *chip8.Hooks", path, sym)
+	}
+	return f(), nil
+}
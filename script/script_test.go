@@ -0,0 +1,75 @@
+package script
+
+import (
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/morinokami/go-chip8/chip8"
+)
+
+func TestLoadMissing(t *testing.T) {
+	if _, err := Load(filepath.Join(t.TempDir(), "missing.so")); err == nil {
+		t.Error("no error for a missing script")
+	}
+}
+
+// screenUI is a headless UI that keeps the last rendered screen.
+type screenUI struct {
+	*chip8.Headless
+	screen [chip8.BufferSize]byte
+}
+
+func (u *screenUI) Render(buf *[chip8.BufferSize]byte, dirty bool) {
+	u.screen = *buf
+}
+
+// TestLoad builds the HUD example as a plugin, loads it and checks that it
+// draws over the screen.
+func TestLoad(t *testing.T) {
+	switch runtime.GOOS {
+	case "linux", "darwin", "freebsd":
+	default:
+		t.Skipf("plugins are not supported on %s", runtime.GOOS)
+	}
+	if testing.Short() {
+		t.Skip("builds a plugin")
+	}
+	if testing.CoverMode() != "" {
+		t.Skip("plugins cannot be loaded by a binary built with coverage")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+
+	path := filepath.Join(t.TempDir(), "hud.so")
+	out, err := exec.Command(goTool, "build", "-buildmode=plugin", "-o", path, "./examples/hud").CombinedOutput()
+	if err != nil {
+		t.Fatalf("go build: %v\n%s", err, out)
+	}
+	hooks, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hooks.Frame == nil {
+		t.Fatal("HUD has no frame hook")
+	}
+
+	ui := &screenUI{Headless: chip8.NewHeadless()}
+	e := chip8.New(ui, chip8.WithHooks(hooks))
+	if err := e.Load([]byte{0x12, 0x00}); err != nil { // JP 0x200
+		t.Fatal(err)
+	}
+	if err := e.Frame(); err != nil {
+		t.Fatal(err)
+	}
+	var on int
+	for _, p := range ui.screen {
+		on += int(p)
+	}
+	if on == 0 {
+		t.Error("HUD drew nothing")
+	}
+}
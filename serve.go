@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+
+	"github.com/morinokami/go-chip8/chip8"
+	"github.com/morinokami/go-chip8/games"
+	"github.com/morinokami/go-chip8/server"
+	"github.com/urfave/cli/v2"
+)
+
+func serveCommand() *cli.Command {
+	var addr string
+	var game string
+	var seed int64
+	return &cli.Command{
+		Name:  "serve",
+		Usage: "run a game headlessly and play it in a web browser",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "addr",
+				Value:       "localhost:8080",
+				Usage:       "address to listen on, such as :8080 to accept connections from other machines",
+				Destination: &addr,
+			},
+			&cli.StringFlag{
+				Name:        "game",
+				Aliases:     []string{"g"},
+				Value:       "0",
+				Usage:       "enter a ROM file, an Octo .8o or .gif file, a name or one of the following numbers: " + games.AvailableGames(),
+				Destination: &game,
+			},
+			&cli.Int64Flag{
+				Name:        "seed",
+				Usage:       "seed for the random number generator (default: current time)",
+				Destination: &seed,
+			},
+		},
+		Action: func(c *cli.Context) error {
+			g, settings, _, err := openGame(game, "")
+			if err != nil {
+				return err
+			}
+			opts := []chip8.Option{chip8.WithSettings(settings)}
+			if c.IsSet("seed") {
+				opts = append(opts, chip8.WithSeed(seed))
+			}
+			s := server.New(opts...)
+			if err := s.Emulator().Load(g.Binary); err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer stop()
+			hs := &http.Server{Addr: addr, Handler: s}
+			errc := make(chan error, 1)
+			go func() {
+				errc <- hs.ListenAndServe()
+			}()
+			log.Printf("serving %s on http://%s", g.Name, addr)
+
+			go s.Run(ctx)
+			select {
+			case err := <-errc:
+				return err
+			case <-ctx.Done():
+			}
+			if err := hs.Shutdown(context.Background()); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,89 @@
+package server
+
+import "github.com/morinokami/go-chip8/chip8"
+
+// Frames are streamed to the browser as binary WebSocket messages. The
+// screen is packed to one bit per pixel, row by row, with the leftmost pixel
+// in the most significant bit, which takes packedSize bytes. A message is
+// one of:
+//
+//	frameKey,   followed by the packedSize bytes of the screen
+//	frameDelta, followed by (offset, xor) byte pairs, one for every byte of
+//	            the packed screen that changed since the previous message
+//
+// A delta is only sent when it is smaller than a key frame.
+const (
+	frameKey   = 0
+	frameDelta = 1
+)
+
+const packedSize = chip8.BufferSize / 8
+
+type packed [packedSize]byte
+
+// pack packs buf to one bit per pixel.
+func pack(buf *[chip8.BufferSize]byte) packed {
+	var p packed
+	for i, px := range buf {
+		if px != 0 {
+			p[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+	return p
+}
+
+// encodeFrame returns the message that turns prev into cur, which is a key
+// frame if prev is nil.
+func encodeFrame(prev, cur *packed) []byte {
+	if prev != nil {
+		msg := []byte{frameDelta}
+		for i := range cur {
+			if d := prev[i] ^ cur[i]; d != 0 {
+				msg = append(msg, byte(i), d)
+				if len(msg) > packedSize {
+					break
+				}
+			}
+		}
+		if len(msg) <= packedSize {
+			return msg
+		}
+	}
+	return append([]byte{frameKey}, cur[:]...)
+}
+
+// decodeFrame applies msg to p. It is the inverse of encodeFrame, used by
+// tests and clients written in Go.
+func decodeFrame(p *packed, msg []byte) bool {
+	if len(msg) == 0 {
+		return false
+	}
+	switch msg[0] {
+	case frameKey:
+		if len(msg) != 1+packedSize {
+			return false
+		}
+		copy(p[:], msg[1:])
+	case frameDelta:
+		if len(msg)%2 != 1 {
+			return false
+		}
+		for i := 1; i < len(msg); i += 2 {
+			p[msg[i]] ^= msg[i+1]
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// unpack is the inverse of pack.
+func unpack(p *packed) [chip8.BufferSize]byte {
+	var buf [chip8.BufferSize]byte
+	for i := range buf {
+		if p[i/8]&(0x80>>(i%8)) != 0 {
+			buf[i] = 1
+		}
+	}
+	return buf
+}
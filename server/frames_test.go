@@ -0,0 +1,51 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/morinokami/go-chip8/chip8"
+)
+
+func TestFrames(t *testing.T) {
+	var a, b [chip8.BufferSize]byte
+	a[0], a[9], a[chip8.BufferSize-1] = 1, 1, 1
+	b = a
+	b[9], b[100] = 0, 1
+
+	pa, pb := pack(&a), pack(&b)
+	if got := unpack(&pa); got != a {
+		t.Fatal("unpack(pack(a)) != a")
+	}
+	if pa[0] != 0x80 || pa[1] != 0x40 || pa[packedSize-1] != 0x01 {
+		t.Errorf("bit order: % x ... % x", pa[:2], pa[packedSize-1])
+	}
+
+	key := encodeFrame(nil, &pa)
+	if len(key) != 1+packedSize || key[0] != frameKey {
+		t.Errorf("key frame: %d bytes, type %d", len(key), key[0])
+	}
+	delta := encodeFrame(&pa, &pb)
+	if len(delta) != 5 || delta[0] != frameDelta {
+		t.Errorf("delta: % x", delta)
+	}
+
+	var p packed
+	for _, msg := range [][]byte{key, delta} {
+		if !decodeFrame(&p, msg) {
+			t.Fatalf("invalid message: % x", msg)
+		}
+	}
+	if p != pb {
+		t.Error("decoded screen differs")
+	}
+
+	// a delta that changes every byte is sent as a key frame
+	var full [chip8.BufferSize]byte
+	for i := range full {
+		full[i] = 1
+	}
+	pf := pack(&full)
+	if msg := encodeFrame(&pa, &pf); msg[0] != frameKey {
+		t.Errorf("got a %d-byte delta, want a key frame", len(msg))
+	}
+}
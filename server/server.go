@@ -0,0 +1,407 @@
+// Package server runs an emulator headlessly and serves it over HTTP: a web
+// page that shows the screen and forwards the keyboard, the screen streamed
+// over WebSocket, and a REST API to load ROMs, pause, reset, save and restore
+// the machine state and take screenshots.
+package server
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/morinokami/go-chip8/chip8"
+	"github.com/morinokami/go-chip8/games"
+)
+
+//go:embed web
+var web embed.FS
+
+type Server struct {
+	e   *chip8.Emulator
+	mux *http.ServeMux
+
+	mu sync.Mutex
+	// last rendered screen
+	screen  packed
+	clients map[*client]bool
+	// error that stopped the CPU, until the next load or reset
+	err error
+}
+
+// client is a WebSocket connection watching the screen.
+type client struct {
+	conn *wsConn
+	// keys held in the browser, guarded by Server.mu
+	keys chip8.Keypad
+	// notify is signalled when the screen changes
+	notify chan struct{}
+}
+
+// New creates a server and its emulator, configured with opts.
+func New(opts ...chip8.Option) *Server {
+	s := &Server{clients: map[*client]bool{}}
+	s.e = chip8.New((*ui)(s), opts...)
+
+	root, err := fs.Sub(web, "web")
+	if err != nil {
+		panic(err)
+	}
+	s.mux = http.NewServeMux()
+	s.mux.Handle("/", http.FileServer(http.FS(root)))
+	s.mux.HandleFunc("/ws", s.handleWebSocket)
+	s.mux.HandleFunc("/api/games", s.handleGames)
+	s.mux.HandleFunc("/api/status", s.handleStatus)
+	s.mux.HandleFunc("/api/rom", s.handleROM)
+	s.mux.HandleFunc("/api/pause", s.handlePause)
+	s.mux.HandleFunc("/api/resume", s.handleResume)
+	s.mux.HandleFunc("/api/reset", s.handleReset)
+	s.mux.HandleFunc("/api/state", s.handleState)
+	s.mux.HandleFunc("/api/screenshot", s.handleScreenshot)
+	return s
+}
+
+// Emulator returns the emulator of s.
+func (s *Server) Emulator() *chip8.Emulator {
+	return s.e
+}
+
+// ServeHTTP serves the page, the screen and the API. Requests to the screen
+// and the API from pages of other origins are rejected, so that other web
+// sites cannot drive the emulator from the browser.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if (r.URL.Path == "/ws" || strings.HasPrefix(r.URL.Path, "/api/")) && !sameOrigin(r) {
+		http.Error(w, "cross-origin request", http.StatusForbidden)
+		return
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+// sameOrigin reports whether r comes from a page served by this host or,
+// without an Origin header, from outside a browser. Browsers send the
+// header with WebSocket handshakes and cross-origin requests.
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && strings.EqualFold(u.Host, r.Host)
+}
+
+// Run runs the emulator in real time until ctx is done. If the CPU fails,
+// the emulator is paused until a ROM is loaded or it is reset.
+func (s *Server) Run(ctx context.Context) error {
+	for {
+		err := s.e.Run(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		log.Printf("emulator stopped: %v", err)
+		s.e.Pause()
+		s.mu.Lock()
+		s.err = err
+		s.mu.Unlock()
+	}
+}
+
+// restart clears the error that stopped the CPU and resumes the emulator.
+func (s *Server) restart() {
+	s.mu.Lock()
+	s.err = nil
+	s.mu.Unlock()
+	s.e.Resume()
+}
+
+// ui is the UI of the emulator: it passes the screen to the clients and
+// takes the keypad state from them.
+type ui Server
+
+func (u *ui) Init() {}
+
+func (u *ui) Run(f func()) {
+	f()
+}
+
+func (u *ui) Render(buf *[chip8.BufferSize]byte, dirty bool) {
+	if !dirty {
+		return
+	}
+	p := pack(buf)
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.screen = p
+	for c := range u.clients {
+		select {
+		case c.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Keypad returns the keys held in any of the browsers.
+func (u *ui) Keypad() chip8.Keypad {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	var keys chip8.Keypad
+	for c := range u.clients {
+		keys |= c.keys
+	}
+	return keys
+}
+
+func (u *ui) Beep() {}
+
+func (u *ui) Mode() chip8.DisplayMode {
+	return chip8.ModeNormal
+}
+
+func (u *ui) Close() {}
+
+// keyEvent is a message from the browser: key was pressed or released.
+type keyEvent struct {
+	Key  byte `json:"key"`
+	Down bool `json:"down"`
+}
+
+// handleWebSocket streams the screen to the browser, starting with a key
+// frame, and applies the key events it sends.
+func (s *Server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
+	conn, err := upgrade(w, r)
+	if err != nil {
+		return
+	}
+	c := &client{conn: conn, notify: make(chan struct{}, 1)}
+	c.notify <- struct{}{}
+	s.mu.Lock()
+	s.clients[c] = true
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.stream(c)
+	}()
+
+	for {
+		op, msg, err := conn.readMessage()
+		if err != nil {
+			break
+		}
+		var ev keyEvent
+		if op != opText || json.Unmarshal(msg, &ev) != nil || ev.Key >= 16 {
+			conn.close(1003)
+			break
+		}
+		s.mu.Lock()
+		if ev.Down {
+			c.keys |= 1 << ev.Key
+		} else {
+			c.keys &^= 1 << ev.Key
+		}
+		s.mu.Unlock()
+	}
+
+	s.mu.Lock()
+	delete(s.clients, c)
+	s.mu.Unlock()
+	close(c.notify)
+	conn.conn.Close()
+	<-done
+}
+
+// stream sends the screen to c whenever it changes, until c is closed.
+// Frames rendered while a message is being sent are merged into the next
+// one.
+func (s *Server) stream(c *client) {
+	var sent *packed
+	for range c.notify {
+		s.mu.Lock()
+		cur := s.screen
+		s.mu.Unlock()
+		msg := encodeFrame(sent, &cur)
+		if len(msg) == 1 {
+			// no change
+			continue
+		}
+		if err := c.conn.writeMessage(opBinary, msg); err != nil {
+			c.conn.conn.Close()
+			return
+		}
+		sent = &cur
+	}
+}
+
+// allow replies with 405 Method Not Allowed and returns false unless r uses
+// one of methods.
+func allow(w http.ResponseWriter, r *http.Request, methods ...string) bool {
+	for _, m := range methods {
+		if r.Method == m {
+			return true
+		}
+	}
+	w.Header().Set("Allow", methods[0])
+	http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	return false
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+// handleGames lists the names of the bundled games.
+func (s *Server) handleGames(w http.ResponseWriter, r *http.Request) {
+	if !allow(w, r, http.MethodGet) {
+		return
+	}
+	names := []string{}
+	for _, g := range games.Games {
+		names = append(names, g.Name)
+	}
+	writeJSON(w, names)
+}
+
+type status struct {
+	Paused bool   `json:"paused"`
+	Frames uint64 `json:"frames"`
+	Error  string `json:"error,omitempty"`
+}
+
+func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request) {
+	if !allow(w, r, http.MethodGet) {
+		return
+	}
+	st := status{Paused: s.e.Paused(), Frames: s.e.Frames()}
+	s.mu.Lock()
+	if s.err != nil {
+		st.Error = s.err.Error()
+	}
+	s.mu.Unlock()
+	writeJSON(w, st)
+}
+
+// handleROM loads the bundled game named by the game query parameter, or
+// the ROM in the request body, and starts it.
+func (s *Server) handleROM(w http.ResponseWriter, r *http.Request) {
+	if !allow(w, r, http.MethodPost) {
+		return
+	}
+	var rom []byte
+	if name := r.URL.Query().Get("game"); name != "" {
+		g, err := games.Find(name)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		rom = g.Binary
+	} else {
+		var err error
+		rom, err = ioutil.ReadAll(io.LimitReader(r.Body, chip8.MaxROMSize+1))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	if err := s.e.Load(rom); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.restart()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handlePause(w http.ResponseWriter, r *http.Request) {
+	if !allow(w, r, http.MethodPost) {
+		return
+	}
+	s.e.Pause()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleResume(w http.ResponseWriter, r *http.Request) {
+	if !allow(w, r, http.MethodPost) {
+		return
+	}
+	s.mu.Lock()
+	failed := s.err != nil
+	s.mu.Unlock()
+	if failed {
+		http.Error(w, "the CPU failed; load a ROM or reset", http.StatusConflict)
+		return
+	}
+	s.e.Resume()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleReset(w http.ResponseWriter, r *http.Request) {
+	if !allow(w, r, http.MethodPost) {
+		return
+	}
+	s.e.Reset()
+	s.restart()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleState returns the machine state, encoded by State.MarshalBinary, or
+// restores the one in the request body.
+func (s *Server) handleState(w http.ResponseWriter, r *http.Request) {
+	if !allow(w, r, http.MethodGet, http.MethodPut) {
+		return
+	}
+	if r.Method == http.MethodGet {
+		data, err := s.e.State().MarshalBinary()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Write(data)
+		return
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(r.Body, maxMessageSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var st chip8.State
+	if err := st.UnmarshalBinary(data); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := s.e.SetState(st); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.restart()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleScreenshot returns the screen as a PNG, scaled by the scale query
+// parameter.
+func (s *Server) handleScreenshot(w http.ResponseWriter, r *http.Request) {
+	if !allow(w, r, http.MethodGet) {
+		return
+	}
+	scale := chip8.ScalingFactor
+	if v := r.URL.Query().Get("scale"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > 64 {
+			http.Error(w, "invalid scale", http.StatusBadRequest)
+			return
+		}
+		scale = n
+	}
+	screen := s.e.Screen()
+	w.Header().Set("Content-Type", "image/png")
+	chip8.WritePNG(w, &screen, scale, chip8.DefaultPalette)
+}
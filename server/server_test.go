@@ -0,0 +1,203 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/morinokami/go-chip8/chip8"
+)
+
+// keyROM waits for key 5, then draws the digit 5 at (0, 0) and loops.
+var keyROM = []byte{
+	0x60, 0x05, // LD V0, 5
+	0xE0, 0x9E, // SKP V0
+	0x12, 0x02, // JP 0x202
+	0xF0, 0x29, // LD F, V0
+	0x61, 0x00, // LD V1, 0
+	0xD1, 0x15, // DRW V1, V1, 5
+	0x12, 0x0C, // JP 0x20C
+}
+
+func newTestServer(t *testing.T) (*Server, *httptest.Server) {
+	t.Helper()
+	s := New(chip8.WithSeed(1))
+	ts := httptest.NewServer(s)
+	ctx, cancel := context.WithCancel(context.Background())
+	go s.Run(ctx)
+	t.Cleanup(func() {
+		cancel()
+		ts.Close()
+	})
+	return s, ts
+}
+
+func do(t *testing.T, method, url string, body []byte) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { res.Body.Close() })
+	return res
+}
+
+func expect(t *testing.T, res *http.Response, code int) {
+	t.Helper()
+	if res.StatusCode != code {
+		t.Fatalf("%s %s: got=%s, want=%d", res.Request.Method, res.Request.URL.Path, res.Status, code)
+	}
+}
+
+func getStatus(t *testing.T, url string) status {
+	t.Helper()
+	res := do(t, http.MethodGet, url+"/api/status", nil)
+	expect(t, res, http.StatusOK)
+	var st status
+	if err := json.NewDecoder(res.Body).Decode(&st); err != nil {
+		t.Fatal(err)
+	}
+	return st
+}
+
+func TestStream(t *testing.T) {
+	_, ts := newTestServer(t)
+	expect(t, do(t, http.MethodPost, ts.URL+"/api/rom", keyROM), http.StatusNoContent)
+
+	conn, err := dial(ts.URL + "/ws")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.close(1000)
+	conn.conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	var screen packed
+	_, msg, err := conn.readMessage()
+	if err != nil || len(msg) == 0 || msg[0] != frameKey || !decodeFrame(&screen, msg) {
+		t.Fatalf("first message: % x, err=%v", msg, err)
+	}
+	if screen != (packed{}) {
+		t.Fatal("screen drawn before the key was pressed")
+	}
+
+	if err := conn.writeMessage(opText, []byte(`{"key":5,"down":true}`)); err != nil {
+		t.Fatal(err)
+	}
+	for screen == (packed{}) {
+		_, msg, err := conn.readMessage()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if msg[0] != frameDelta || !decodeFrame(&screen, msg) {
+			t.Fatalf("got % x, want a delta", msg)
+		}
+	}
+	// the top row of the digit 5 is 0xF0
+	if screen[0] != 0xF0 {
+		t.Errorf("got=0x%02x, want=0xf0", screen[0])
+	}
+}
+
+func TestAPI(t *testing.T) {
+	s, ts := newTestServer(t)
+	expect(t, do(t, http.MethodPost, ts.URL+"/api/rom?game=PONG", nil), http.StatusNoContent)
+	expect(t, do(t, http.MethodPost, ts.URL+"/api/rom?game=NOPE", nil), http.StatusNotFound)
+	expect(t, do(t, http.MethodGet, ts.URL+"/api/pause", nil), http.StatusMethodNotAllowed)
+
+	expect(t, do(t, http.MethodPost, ts.URL+"/api/pause", nil), http.StatusNoContent)
+	if st := getStatus(t, ts.URL); !st.Paused {
+		t.Error("not paused")
+	}
+	expect(t, do(t, http.MethodPost, ts.URL+"/api/resume", nil), http.StatusNoContent)
+	if st := getStatus(t, ts.URL); st.Paused {
+		t.Error("still paused")
+	}
+
+	res := do(t, http.MethodGet, ts.URL+"/api/state", nil)
+	expect(t, res, http.StatusOK)
+	var buf bytes.Buffer
+	buf.ReadFrom(res.Body)
+	expect(t, do(t, http.MethodPut, ts.URL+"/api/state", buf.Bytes()), http.StatusNoContent)
+	expect(t, do(t, http.MethodPut, ts.URL+"/api/state", []byte("junk")), http.StatusBadRequest)
+
+	res = do(t, http.MethodGet, ts.URL+"/api/screenshot?scale=2", nil)
+	expect(t, res, http.StatusOK)
+	img, err := png.Decode(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := img.Bounds(); b.Dx() != 2*chip8.BaseWidth || b.Dy() != 2*chip8.BaseHeight {
+		t.Errorf("screenshot size: %v", b)
+	}
+
+	expect(t, do(t, http.MethodPost, ts.URL+"/api/reset", nil), http.StatusNoContent)
+	if f := s.Emulator().Frames(); f > 10 {
+		t.Errorf("frames after reset: %d", f)
+	}
+
+	res = do(t, http.MethodGet, ts.URL+"/", nil)
+	expect(t, res, http.StatusOK)
+	buf.Reset()
+	buf.ReadFrom(res.Body)
+	if !bytes.Contains(buf.Bytes(), []byte("<canvas")) {
+		t.Error("index page has no canvas")
+	}
+}
+
+func TestOrigin(t *testing.T) {
+	_, ts := newTestServer(t)
+	request := func(method, path, origin string) *http.Response {
+		req, err := http.NewRequest(method, ts.URL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Origin", origin)
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { res.Body.Close() })
+		return res
+	}
+
+	expect(t, request(http.MethodPost, "/api/pause", ts.URL), http.StatusNoContent)
+	expect(t, request(http.MethodPost, "/api/resume", "http://evil.example"), http.StatusForbidden)
+	expect(t, request(http.MethodPost, "/api/reset", "null"), http.StatusForbidden)
+	expect(t, request(http.MethodGet, "/ws", "http://evil.example"), http.StatusForbidden)
+	if st := getStatus(t, ts.URL); !st.Paused {
+		t.Error("resumed by a cross-origin request")
+	}
+	// the page itself may be embedded anywhere
+	expect(t, request(http.MethodGet, "/", "http://evil.example"), http.StatusOK)
+}
+
+func TestCPUFailure(t *testing.T) {
+	_, ts := newTestServer(t)
+	// RET with an empty stack
+	expect(t, do(t, http.MethodPost, ts.URL+"/api/rom", []byte{0x00, 0xEE}), http.StatusNoContent)
+
+	deadline := time.Now().Add(5 * time.Second)
+	st := getStatus(t, ts.URL)
+	for st.Error == "" && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+		st = getStatus(t, ts.URL)
+	}
+	if st.Error == "" || !st.Paused {
+		t.Fatalf("got status %+v, want a paused emulator with an error", st)
+	}
+	expect(t, do(t, http.MethodPost, ts.URL+"/api/resume", nil), http.StatusConflict)
+
+	expect(t, do(t, http.MethodPost, ts.URL+"/api/rom", keyROM), http.StatusNoContent)
+	if st := getStatus(t, ts.URL); st.Error != "" || st.Paused {
+		t.Errorf("got status %+v after loading a ROM", st)
+	}
+}
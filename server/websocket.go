@@ -0,0 +1,249 @@
+package server
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// The subset of WebSocket (RFC 6455) the server needs: the opening
+// handshake, unfragmented and fragmented messages, ping, pong and close.
+// Extensions and subprotocols are not supported.
+
+const (
+	opContinuation = 0x0
+	opText         = 0x1
+	opBinary       = 0x2
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xA
+)
+
+// maxMessageSize is the size of the largest message a connection reads.
+const maxMessageSize = 1 << 16
+
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+var errMessageTooLarge = errors.New("websocket: message too large")
+
+type wsConn struct {
+	conn net.Conn
+	r    *bufio.Reader
+	// client connections mask the frames they send, servers expect masked
+	// frames
+	client bool
+
+	// wmu serializes writes, which come from both the reader, answering
+	// pings, and the writer
+	wmu sync.Mutex
+}
+
+// acceptKey returns the Sec-WebSocket-Accept header for key.
+func acceptKey(key string) string {
+	h := sha1.Sum([]byte(key + websocketGUID))
+	return base64.StdEncoding.EncodeToString(h[:])
+}
+
+// headerContains reports whether the comma-separated header name of h
+// contains token, ignoring case.
+func headerContains(h http.Header, name, token string) bool {
+	for _, v := range h.Values(name) {
+		for _, t := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(t), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// upgrade completes the WebSocket handshake of r and takes over its
+// connection. On failure it replies with an HTTP error.
+func upgrade(w http.ResponseWriter, r *http.Request) (*wsConn, error) {
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if r.Method != http.MethodGet ||
+		!headerContains(r.Header, "Connection", "upgrade") ||
+		!headerContains(r.Header, "Upgrade", "websocket") ||
+		key == "" {
+		http.Error(w, "expected a WebSocket handshake", http.StatusBadRequest)
+		return nil, errors.New("websocket: not a handshake")
+	}
+	if r.Header.Get("Sec-WebSocket-Version") != "13" {
+		w.Header().Set("Sec-WebSocket-Version", "13")
+		http.Error(w, "unsupported WebSocket version", http.StatusUpgradeRequired)
+		return nil, errors.New("websocket: unsupported version")
+	}
+	h, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "connection cannot be upgraded", http.StatusInternalServerError)
+		return nil, errors.New("websocket: response cannot be hijacked")
+	}
+	conn, rw, err := h.Hijack()
+	if err != nil {
+		return nil, err
+	}
+	_, err = rw.WriteString("HTTP/1.1 101 Switching Protocols\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + acceptKey(key) + "\r\n\r\n")
+	if err == nil {
+		err = rw.Flush()
+	}
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &wsConn{conn: conn, r: rw.Reader}, nil
+}
+
+// readFrame reads one frame and returns its FIN bit, opcode and unmasked
+// payload.
+func (c *wsConn) readFrame() (bool, byte, []byte, error) {
+	var head [2]byte
+	if _, err := io.ReadFull(c.r, head[:]); err != nil {
+		return false, 0, nil, err
+	}
+	fin := head[0]&0x80 != 0
+	opcode := head[0] & 0x0F
+	masked := head[1]&0x80 != 0
+	if head[0]&0x70 != 0 {
+		return false, 0, nil, errors.New("websocket: reserved bits set")
+	}
+	if masked == c.client {
+		return false, 0, nil, errors.New("websocket: wrong masking")
+	}
+
+	n := uint64(head[1] & 0x7F)
+	switch n {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(c.r, ext[:]); err != nil {
+			return false, 0, nil, err
+		}
+		n = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(c.r, ext[:]); err != nil {
+			return false, 0, nil, err
+		}
+		n = binary.BigEndian.Uint64(ext[:])
+	}
+	if n > maxMessageSize {
+		return false, 0, nil, errMessageTooLarge
+	}
+
+	var mask [4]byte
+	if masked {
+		if _, err := io.ReadFull(c.r, mask[:]); err != nil {
+			return false, 0, nil, err
+		}
+	}
+	payload := make([]byte, n)
+	if _, err := io.ReadFull(c.r, payload); err != nil {
+		return false, 0, nil, err
+	}
+	if masked {
+		for i := range payload {
+			payload[i] ^= mask[i%4]
+		}
+	}
+	return fin, opcode, payload, nil
+}
+
+// readMessage returns the next text or binary message, answering pings on
+// the way. It returns io.EOF once the peer closes the connection.
+func (c *wsConn) readMessage() (byte, []byte, error) {
+	var opcode byte
+	var msg []byte
+	for {
+		fin, op, payload, err := c.readFrame()
+		if err != nil {
+			if err == errMessageTooLarge {
+				c.close(1009)
+			}
+			return 0, nil, err
+		}
+		switch op {
+		case opPing:
+			if err := c.writeMessage(opPong, payload); err != nil {
+				return 0, nil, err
+			}
+			continue
+		case opPong:
+			continue
+		case opClose:
+			c.close(1000)
+			return 0, nil, io.EOF
+		case opContinuation:
+			if opcode == 0 {
+				return 0, nil, errors.New("websocket: unexpected continuation")
+			}
+		case opText, opBinary:
+			if opcode != 0 {
+				return 0, nil, errors.New("websocket: interleaved message")
+			}
+			opcode = op
+		default:
+			return 0, nil, errors.New("websocket: unknown opcode")
+		}
+		if len(msg)+len(payload) > maxMessageSize {
+			c.close(1009)
+			return 0, nil, errMessageTooLarge
+		}
+		msg = append(msg, payload...)
+		if fin {
+			return opcode, msg, nil
+		}
+	}
+}
+
+// writeMessage sends data as a single frame.
+func (c *wsConn) writeMessage(opcode byte, data []byte) error {
+	frame := []byte{0x80 | opcode}
+	var mask byte
+	if c.client {
+		mask = 0x80
+	}
+	switch n := len(data); {
+	case n < 126:
+		frame = append(frame, mask|byte(n))
+	case n <= 0xFFFF:
+		frame = append(frame, mask|126, byte(n>>8), byte(n))
+	default:
+		var ext [8]byte
+		binary.BigEndian.PutUint64(ext[:], uint64(n))
+		frame = append(frame, mask|127)
+		frame = append(frame, ext[:]...)
+	}
+	if c.client {
+		var key [4]byte
+		if _, err := rand.Read(key[:]); err != nil {
+			return err
+		}
+		frame = append(frame, key[:]...)
+		for i, b := range data {
+			frame = append(frame, b^key[i%4])
+		}
+	} else {
+		frame = append(frame, data...)
+	}
+
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	_, err := c.conn.Write(frame)
+	return err
+}
+
+// close sends a close frame with code and closes the connection.
+func (c *wsConn) close(code uint16) error {
+	c.writeMessage(opClose, []byte{byte(code >> 8), byte(code)})
+	return c.conn.Close()
+}
@@ -0,0 +1,75 @@
+package server
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// dial opens a WebSocket connection to url, an http:// URL.
+func dial(url string) (*wsConn, error) {
+	addr := strings.TrimPrefix(url, "http://")
+	path := "/"
+	if i := strings.Index(addr, "/"); i >= 0 {
+		addr, path = addr[:i], addr[i:]
+	}
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	key := "dGhlIHNhbXBsZSBub25jZQ=="
+	req, _ := http.NewRequest(http.MethodGet, "http://"+addr+path, nil)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Sec-WebSocket-Key", key)
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	r := bufio.NewReader(conn)
+	res, err := http.ReadResponse(r, req)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if res.StatusCode != http.StatusSwitchingProtocols || res.Header.Get("Sec-WebSocket-Accept") != acceptKey(key) {
+		conn.Close()
+		return nil, errors.New("handshake failed: " + res.Status)
+	}
+	return &wsConn{conn: conn, r: r, client: true}, nil
+}
+
+func TestAcceptKey(t *testing.T) {
+	// the example of RFC 6455
+	if got, want := acceptKey("dGhlIHNhbXBsZSBub25jZQ=="), "s3pPLMBiTxaQ9kYGzzhZRbK+xOo="; got != want {
+		t.Errorf("got=%s, want=%s", got, want)
+	}
+}
+
+func TestWebSocket(t *testing.T) {
+	c, s := net.Pipe()
+	client := &wsConn{conn: c, r: bufio.NewReader(c), client: true}
+	server := &wsConn{conn: s, r: bufio.NewReader(s)}
+
+	msgs := [][]byte{[]byte("hi"), make([]byte, 200), make([]byte, 70000)}
+	go func() {
+		for _, m := range msgs {
+			client.writeMessage(opBinary, m)
+		}
+	}()
+	for _, m := range msgs[:2] {
+		op, got, err := server.readMessage()
+		if err != nil || op != opBinary || len(got) != len(m) {
+			t.Fatalf("got op=%d, %d bytes, err=%v, want %d bytes", op, len(got), err, len(m))
+		}
+	}
+	// too large; the server closes the connection
+	go client.readMessage()
+	if _, _, err := server.readMessage(); err != errMessageTooLarge {
+		t.Errorf("got err=%v, want=%v", err, errMessageTooLarge)
+	}
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/morinokami/go-chip8/chip8"
+	"github.com/morinokami/go-chip8/games"
+	"github.com/morinokami/go-chip8/octo"
+	"github.com/urfave/cli/v2"
+)
+
+func snapshotCommand() *cli.Command {
+	var game string
+	var frames int
+	var scale int
+	var fg string
+	var bg string
+	var record string
+	var seed int64
+	return &cli.Command{
+		Name:      "snapshot",
+		Usage:     "run a game headlessly for a number of frames and save the screen",
+		ArgsUsage: "out.png|out.txt",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "game",
+				Aliases:     []string{"g"},
+				Value:       "0",
+				Usage:       "enter a ROM file, an Octo .8o or .gif file, a name or one of the following numbers: " + games.AvailableGames(),
+				Destination: &game,
+			},
+			&cli.IntFlag{
+				Name:        "frames",
+				Aliases:     []string{"n"},
+				Value:       60,
+				Usage:       "number of frames to run",
+				Destination: &frames,
+			},
+			&cli.IntFlag{
+				Name:        "scale",
+				Aliases:     []string{"s"},
+				Value:       chip8.ScalingFactor,
+				Usage:       "size of a pixel in the PNG",
+				Destination: &scale,
+			},
+			&cli.StringFlag{
+				Name:        "fg",
+				Value:       "#ffc0cb",
+				Usage:       "foreground color of the PNG (Octo games default to their own)",
+				Destination: &fg,
+			},
+			&cli.StringFlag{
+				Name:        "bg",
+				Value:       "#000000",
+				Usage:       "background color of the PNG (Octo games default to their own)",
+				Destination: &bg,
+			},
+			&cli.StringFlag{
+				Name:        "record",
+				Usage:       "also record all frames to a .gif, .y4m or .rgb file",
+				Destination: &record,
+			},
+			&cli.Int64Flag{
+				Name:        "seed",
+				Usage:       "seed for the random number generator (default: current time)",
+				Destination: &seed,
+			},
+		},
+		Action: func(c *cli.Context) error {
+			if c.NArg() != 1 {
+				return errors.New("missing output file")
+			}
+			out := c.Args().First()
+			g, settings, p, err := openGame(game, "")
+			if err != nil {
+				return err
+			}
+			if scale < 1 {
+				return errors.New("invalid scale")
+			}
+
+			if c.IsSet("fg") || !octo.IsOcto(game) {
+				if p.Foreground, err = parseColor(fg); err != nil {
+					return err
+				}
+			}
+			if c.IsSet("bg") || !octo.IsOcto(game) {
+				if p.Background, err = parseColor(bg); err != nil {
+					return err
+				}
+			}
+
+			opts := []chip8.Option{chip8.WithSettings(settings)}
+			if c.IsSet("seed") {
+				opts = append(opts, chip8.WithSeed(seed))
+			}
+			var r chip8.Recorder
+			if record != "" {
+				if r, err = chip8.NewRecorder(record, scale, p); err != nil {
+					return err
+				}
+				opts = append(opts, chip8.WithRecorder(r))
+			}
+
+			emulator := chip8.New(chip8.NewHeadless(), opts...)
+			if err := emulator.Load(g.Binary); err != nil {
+				return err
+			}
+			for i := 0; i < frames; i++ {
+				if err := emulator.Frame(); err != nil {
+					return err
+				}
+			}
+			if r != nil {
+				if err := r.Close(); err != nil {
+					return err
+				}
+			}
+
+			screen := emulator.Screen()
+			if filepath.Ext(out) == ".txt" {
+				return ioutil.WriteFile(out, []byte(chip8.ASCII(&screen)), 0644)
+			}
+			return chip8.SavePNG(out, &screen, scale, p)
+		},
+	}
+}
+
+// parseColor parses a color in #rrggbb form.
+func parseColor(s string) (color.RGBA, error) {
+	c := color.RGBA{A: 0xFF}
+	if _, err := fmt.Sscanf(s, "#%02x%02x%02x", &c.R, &c.G, &c.B); err != nil {
+		return c, fmt.Errorf("invalid color: %s", s)
+	}
+	return c, nil
+}
@@ -0,0 +1,198 @@
+// Package spectate broadcasts a running game to read-only viewers, which
+// run it again on their own emulator. Since the emulator is deterministic,
+// the broadcaster only sends a keyframe, a saved state, every few seconds,
+// and the keypad state of every frame in between; a viewer that joins late
+// starts from the last keyframe and catches up with the input since.
+//
+// Keyframes also bring viewers back in step when the game changes without
+// running frames, as when it is reset or its memory is written to.
+package spectate
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"sync"
+
+	"github.com/morinokami/go-chip8/chip8"
+)
+
+// viewerBuffer is the number of messages queued for a viewer; a viewer that
+// falls further behind is dropped rather than slowing down the game.
+const viewerBuffer = 1024
+
+// Broadcaster sends the game run by an emulator to the viewers connected to
+// it. It receives the frames through a hook set with
+// chip8.WithFrameHook(b.Frame).
+type Broadcaster struct {
+	interval uint64
+	settings chip8.Settings
+
+	mu sync.Mutex
+	// last keyframe, the frame it was taken after, and the input since
+	keyframe []byte
+	frame    uint64
+	inputs   []byte
+	viewers  map[*viewer]bool
+	closed   bool
+}
+
+type Option func(*Broadcaster)
+
+// WithKeyframeInterval sends a keyframe every frames frames; the default is
+// 300, five seconds.
+func WithKeyframeInterval(frames int) Option {
+	return func(b *Broadcaster) {
+		b.interval = uint64(frames)
+	}
+}
+
+// WithSettings sets the settings of the emulator the frames come from,
+// which viewers run with; the default is chip8.DefaultSettings.
+func WithSettings(s chip8.Settings) Option {
+	return func(b *Broadcaster) {
+		b.settings = s
+	}
+}
+
+func NewBroadcaster(opts ...Option) *Broadcaster {
+	b := &Broadcaster{interval: 300, viewers: map[*viewer]bool{}, settings: chip8.DefaultSettings()}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+type viewer struct {
+	conn net.Conn
+	msgs chan []byte
+}
+
+// Frame is the chip8.FrameHook that feeds the broadcast.
+func (b *Broadcaster) Frame(frame uint64, keys chip8.Keypad, state func() chip8.State) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var msg []byte
+	// a frame out of sequence means the game was loaded, reset or restored
+	if b.keyframe == nil || frame != b.frame+uint64(len(b.inputs)/3)+1 || (b.interval > 0 && frame%b.interval == 0) {
+		msg = keyframe(state())
+		b.keyframe = msg
+		b.frame = frame
+		b.inputs = b.inputs[:0]
+	} else {
+		msg = input(keys)
+		b.inputs = append(b.inputs, msg...)
+	}
+	for v := range b.viewers {
+		select {
+		case v.msgs <- msg:
+		default:
+			b.drop(v)
+		}
+	}
+}
+
+// Serve sends the broadcast to the viewers connecting to l until l is
+// closed.
+func (b *Broadcaster) Serve(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		if err := b.Add(conn); err != nil {
+			conn.Close()
+		}
+	}
+}
+
+// Add sends the broadcast to the viewer on conn, until it disconnects or b
+// is closed.
+func (b *Broadcaster) Add(conn net.Conn) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return errors.New("broadcast closed")
+	}
+	v := &viewer{conn: conn, msgs: make(chan []byte, viewerBuffer)}
+	b.viewers[v] = true
+	// catch up from the last keyframe
+	if b.keyframe != nil {
+		v.msgs <- b.keyframe
+		if len(b.inputs) > 0 {
+			v.msgs <- append([]byte(nil), b.inputs...)
+		}
+	}
+	go b.send(v)
+	go b.watch(v)
+	return nil
+}
+
+// send writes the messages queued for v, then closes its connection.
+func (b *Broadcaster) send(v *viewer) {
+	defer v.conn.Close()
+	w := bufio.NewWriter(v.conn)
+	h, err := header(b.settings)
+	if err == nil {
+		_, err = v.conn.Write(h)
+	}
+	if err != nil {
+		b.remove(v)
+		return
+	}
+	for msg := range v.msgs {
+		_, err := w.Write(msg)
+		// write what is queued at once
+		if err == nil && len(v.msgs) == 0 {
+			err = w.Flush()
+		}
+		if err != nil {
+			b.remove(v)
+			// drain until the channel is closed
+			for range v.msgs {
+			}
+			return
+		}
+	}
+	w.Flush()
+}
+
+// watch removes v when it disconnects. Viewers send nothing.
+func (b *Broadcaster) watch(v *viewer) {
+	var buf [1]byte
+	v.conn.Read(buf[:])
+	b.remove(v)
+}
+
+func (b *Broadcaster) remove(v *viewer) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.drop(v)
+}
+
+// drop stops sending to v. b.mu must be held.
+func (b *Broadcaster) drop(v *viewer) {
+	if b.viewers[v] {
+		delete(b.viewers, v)
+		close(v.msgs)
+	}
+}
+
+// Viewers returns the number of connected viewers.
+func (b *Broadcaster) Viewers() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.viewers)
+}
+
+// Close ends the broadcast: the viewers are disconnected once they received
+// the frames so far.
+func (b *Broadcaster) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for v := range b.viewers {
+		b.drop(v)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package spectate
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/morinokami/go-chip8/chip8"
+)
+
+// A broadcast starts with the magic, the version and the emulator settings,
+// encoded by chip8.Settings.MarshalBinary, followed by messages that start
+// with their kind:
+//
+//	msgKeyframe, length (uint32), state: the machine state after a frame,
+//	             encoded by chip8.State.MarshalBinary
+//	msgInput,    keypad state (uint16): the input of the next frame
+//
+// A viewer restores the first keyframe, then runs a frame for every input.
+// All numbers are big-endian.
+
+const (
+	magic           = "C8SP"
+	protocolVersion = 2
+)
+
+const (
+	msgKeyframe = 1
+	msgInput    = 2
+)
+
+// maxKeyframeSize bounds the keyframes a viewer accepts.
+const maxKeyframeSize = 1 << 16
+
+func header(s chip8.Settings) ([]byte, error) {
+	data, err := s.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte(magic), protocolVersion), data...), nil
+}
+
+func readHeader(r io.Reader) (chip8.Settings, error) {
+	var s chip8.Settings
+	h := make([]byte, len(magic)+1+chip8.SettingsSize)
+	if _, err := io.ReadFull(r, h); err != nil {
+		return s, err
+	}
+	if string(h[:len(magic)]) != magic {
+		return s, errors.New("not a broadcast")
+	}
+	if h[len(magic)] != protocolVersion {
+		return s, fmt.Errorf("unsupported protocol version: %d", h[len(magic)])
+	}
+	return s, s.UnmarshalBinary(h[len(magic)+1:])
+}
+
+func keyframe(s chip8.State) []byte {
+	data, _ := s.MarshalBinary()
+	buf := make([]byte, 5, 5+len(data))
+	buf[0] = msgKeyframe
+	binary.BigEndian.PutUint32(buf[1:], uint32(len(data)))
+	return append(buf, data...)
+}
+
+func input(keys chip8.Keypad) []byte {
+	return []byte{msgInput, byte(keys >> 8), byte(keys)}
+}
+
+// message is a decoded message: state is set for keyframes.
+type message struct {
+	state *chip8.State
+	keys  chip8.Keypad
+}
+
+func readMessage(r *bufio.Reader) (message, error) {
+	kind, err := r.ReadByte()
+	if err != nil {
+		return message{}, err
+	}
+	switch kind {
+	case msgKeyframe:
+		var n [4]byte
+		if _, err := io.ReadFull(r, n[:]); err != nil {
+			return message{}, err
+		}
+		size := binary.BigEndian.Uint32(n[:])
+		if size > maxKeyframeSize {
+			return message{}, fmt.Errorf("keyframe too large: %d bytes", size)
+		}
+		data := make([]byte, size)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return message{}, err
+		}
+		var s chip8.State
+		if err := s.UnmarshalBinary(data); err != nil {
+			return message{}, err
+		}
+		return message{state: &s}, nil
+	case msgInput:
+		var k [2]byte
+		if _, err := io.ReadFull(r, k[:]); err != nil {
+			return message{}, err
+		}
+		return message{keys: chip8.Keypad(binary.BigEndian.Uint16(k[:]))}, nil
+	default:
+		return message{}, fmt.Errorf("unknown message kind: %d", kind)
+	}
+}
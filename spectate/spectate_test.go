@@ -0,0 +1,101 @@
+package spectate
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/morinokami/go-chip8/chip8"
+	"github.com/morinokami/go-chip8/games"
+)
+
+func TestBroadcast(t *testing.T) {
+	g, err := games.Find("TANK")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// viewers run with the settings of the broadcaster
+	settings := chip8.Settings{CyclesPerFrame: 13, Quirks: chip8.Quirks{WrapOrigin: true, WrapPixels: true}}
+	b := NewBroadcaster(WithKeyframeInterval(50), WithSettings(settings))
+	ui := chip8.NewHeadless()
+	e := chip8.New(ui, chip8.WithSeed(1), chip8.WithSettings(settings), chip8.WithFrameHook(b.Frame))
+	if err := e.Load(g.Binary); err != nil {
+		t.Fatal(err)
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go b.Serve(l)
+
+	type result struct {
+		v   *Viewer
+		err error
+	}
+	results := make(chan result)
+	watch := func() {
+		conn, err := net.Dial("tcp", l.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		v, err := NewViewer(conn, chip8.NewHeadless())
+		if err != nil {
+			t.Fatal(err)
+		}
+		go func() {
+			results <- result{v, v.Run(context.Background())}
+		}()
+	}
+	waitViewers := func(n int) {
+		for b.Viewers() != n {
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	frames := func(n int) {
+		for i := 0; i < n; i++ {
+			ui.SetKeypad(1 << (e.Frames() / 16 % 16))
+			if err := e.Frame(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	// one viewer from the start, another that joins between keyframes
+	// after a reset
+	watch()
+	waitViewers(1)
+	frames(80)
+	e.Reset()
+	frames(30)
+	watch()
+	waitViewers(2)
+	frames(100)
+	b.Close()
+
+	for i := 0; i < 2; i++ {
+		r := <-results
+		if r.err != nil {
+			t.Fatal(r.err)
+		}
+		v := r.v.Emulator()
+		if v.Frames() != e.Frames() || v.Hash() != e.Hash() {
+			t.Errorf("viewer %d: got frame %d %s, want frame %d %s", i, v.Frames(), v.Hash(), e.Frames(), e.Hash())
+		}
+	}
+}
+
+func TestNotABroadcast(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	go func() {
+		server.Write([]byte("HTTP/1.1 200 OK\r\n"))
+		server.Close()
+	}()
+	if _, err := NewViewer(client, chip8.NewHeadless()); err == nil || !strings.Contains(err.Error(), "not a broadcast") {
+		t.Errorf("got err=%v, want not a broadcast", err)
+	}
+}
@@ -0,0 +1,102 @@
+package spectate
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net"
+
+	"github.com/morinokami/go-chip8/chip8"
+)
+
+// Viewer runs a broadcast game on its own emulator, shown on a UI whose
+// keypad is ignored.
+type Viewer struct {
+	conn net.Conn
+	r    *bufio.Reader
+	e    *chip8.Emulator
+	ui   *frontend
+	// synced is set once the first keyframe was restored
+	synced bool
+}
+
+// NewViewer starts watching the broadcast received on conn with ui as the
+// front end.
+func NewViewer(conn net.Conn, ui chip8.UI) (*Viewer, error) {
+	r := bufio.NewReader(conn)
+	s, err := readHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	v := &Viewer{conn: conn, r: r, ui: &frontend{UI: ui}}
+	v.e = chip8.New(v.ui, chip8.WithSettings(s))
+	return v, nil
+}
+
+// Emulator returns the emulator running the game.
+func (v *Viewer) Emulator() *chip8.Emulator {
+	return v.e
+}
+
+// Close closes the connection to the broadcaster.
+func (v *Viewer) Close() error {
+	return v.conn.Close()
+}
+
+// Run runs the frames as they are received until the broadcast ends or ctx
+// is done. The frames received while catching up are run at once.
+func (v *Viewer) Run(ctx context.Context) error {
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			v.conn.Close()
+		case <-done:
+		}
+	}()
+	for {
+		err := v.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+	}
+}
+
+// Next handles the next message of the broadcast: it restores a keyframe
+// or runs a frame.
+func (v *Viewer) Next() error {
+	m, err := readMessage(v.r)
+	if err != nil {
+		return err
+	}
+	if m.state != nil {
+		if err := v.e.SetState(*m.state); err != nil {
+			return err
+		}
+		v.synced = true
+		return nil
+	}
+	if !v.synced {
+		return errors.New("input received before the first keyframe")
+	}
+	v.ui.keys = m.keys
+	return v.e.Frame()
+}
+
+// frontend gives the emulator the keypad state of the broadcast.
+type frontend struct {
+	chip8.UI
+	keys chip8.Keypad
+}
+
+func (f *frontend) Keypad() chip8.Keypad {
+	return f.keys
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/morinokami/go-chip8/chip8"
+	"github.com/urfave/cli/v2"
+)
+
+func verifyCommand() *cli.Command {
+	var game string
+	return &cli.Command{
+		Name:      "verify",
+		Usage:     "replay a movie headlessly and check that it ends in the recorded state",
+		ArgsUsage: "movie",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "game",
+				Aliases:     []string{"g"},
+				Usage:       "game the movie was recorded with, if not stored in the movie",
+				Destination: &game,
+			},
+		},
+		Action: func(c *cli.Context) error {
+			if c.NArg() != 1 {
+				return errors.New("missing movie file")
+			}
+			movie, err := chip8.LoadMovie(c.Args().First())
+			if err != nil {
+				return err
+			}
+			if game == "" {
+				game = movie.Game
+			}
+			g, _, _, err := openGame(game, "")
+			if err != nil {
+				return err
+			}
+			if err := movie.Check(g.Binary); err != nil {
+				return err
+			}
+
+			emulator := chip8.New(chip8.NewHeadless(), chip8.WithSeed(movie.Seed), chip8.WithReplay(movie))
+			if err := emulator.Load(g.Binary); err != nil {
+				return err
+			}
+			for range movie.Keys {
+				if err := emulator.Frame(); err != nil {
+					return err
+				}
+			}
+			if err := movie.Verify(emulator); err != nil {
+				return err
+			}
+
+			fmt.Printf("OK: %d frames, state hash %s\n", len(movie.Keys), movie.Hash)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+
+	"github.com/morinokami/go-chip8/chip8"
+	"github.com/morinokami/go-chip8/spectate"
+	"github.com/urfave/cli/v2"
+)
+
+func watchCommand() *cli.Command {
+	var ui string
+	var mode string
+	return &cli.Command{
+		Name:      "watch",
+		Usage:     "watch a game broadcast with go-chip8 run --broadcast",
+		ArgsUsage: "ADDR",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "ui",
+				Value:       "window",
+				Usage:       "front end, one of: window, tty",
+				Destination: &ui,
+			},
+			&cli.StringFlag{
+				Name:        "mode",
+				Aliases:     []string{"m"},
+				Value:       "normal",
+				Usage:       "display mode, one of: " + chip8.AvailableDisplayModes(),
+				Destination: &mode,
+			},
+		},
+		Action: func(c *cli.Context) error {
+			if c.NArg() != 1 {
+				return errors.New("usage: go-chip8 watch ADDR")
+			}
+			m, err := chip8.ParseDisplayMode(mode)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			hotkey := func(h chip8.Hotkey) {
+				if h == chip8.HotkeyQuit {
+					cancel()
+				}
+			}
+			var frontend chip8.UI
+			switch ui {
+			case "window":
+				display := chip8.NewDisplay()
+				display.SetMode(m)
+				display.SetHotkeyHandler(hotkey)
+				frontend = display
+			case "tty":
+				terminal := chip8.NewTerminal()
+				terminal.SetMode(m)
+				terminal.SetHotkeyHandler(hotkey)
+				frontend = terminal
+			default:
+				return errors.New("invalid ui: " + ui)
+			}
+
+			conn, err := net.Dial("tcp", c.Args().First())
+			if err != nil {
+				return err
+			}
+			v, err := spectate.NewViewer(conn, frontend)
+			if err != nil {
+				conn.Close()
+				return err
+			}
+			defer v.Close()
+
+			sig := make(chan os.Signal, 1)
+			signal.Notify(sig, os.Interrupt)
+			go func() {
+				select {
+				case <-sig:
+					cancel()
+				case <-ctx.Done():
+				}
+			}()
+			frontend.Run(func() {
+				frontend.Init()
+				err = v.Run(ctx)
+				frontend.Close()
+			})
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+			if err == nil {
+				log.Print("the broadcast ended")
+			}
+			return err
+		},
+	}
+}